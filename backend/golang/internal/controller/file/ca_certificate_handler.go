@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const CACertificateFileName string = "cursed-frame-ca.crt"
+
+// 自己署名の証明書を端末に信頼させるために、ローカルCAの証明書を配布する
+type CACertificateHandler struct {
+	der []byte
+}
+
+func (cch *CACertificateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	// iOSとAndroidのどちらもDER形式の.crtであればそのままインストール画面に進める
+	w.Header().Set("Content-Type", "application/x-x509-ca-cert")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+CACertificateFileName+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(cch.der)))
+	_, _ = w.Write(cch.der)
+}
+
+func NewCACertificateHandler(der []byte) *CACertificateHandler {
+	return &CACertificateHandler{der: der}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"connectrpc.com/connect"
 )
@@ -26,6 +27,17 @@ func (acm *AdminCheckMiddleware) checkAdmin(ctx context.Context) error {
 	return nil
 }
 
+// RESTの管理者向けの処理に使う、AuthorizeMiddlewareの後に置く
+func (acm *AdminCheckMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := acm.checkAdmin(r.Context()); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (acm *AdminCheckMiddleware) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
 		if err := acm.checkAdmin(ctx); err != nil {
@@ -2,16 +2,37 @@ package middleware
 
 import (
 	"net/http"
+	"sync"
 
 	"golang.org/x/time/rate"
 )
 
 type RateLimitMiddleware struct {
-	limiter *rate.Limiter
+	limiter      *rate.Limiter
+	perUser      float64
+	burstPerUser int
+	minUserNum   int
+	// 現在の参加者数を返す、参加者の増減に合わせて上限を変える
+	rosterSize func() int
+	mu         sync.Mutex
+	sizedFor   int
+}
+
+func (rlm *RateLimitMiddleware) resize() {
+	userNum := max(rlm.rosterSize(), rlm.minUserNum)
+	rlm.mu.Lock()
+	defer rlm.mu.Unlock()
+	if userNum == rlm.sizedFor {
+		return
+	}
+	rlm.limiter.SetLimit(rate.Limit(float64(userNum) * rlm.perUser))
+	rlm.limiter.SetBurst(userNum * rlm.burstPerUser)
+	rlm.sizedFor = userNum
 }
 
 func (rlm *RateLimitMiddleware) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rlm.resize()
 		if !rlm.limiter.Allow() {
 			http.Error(w, "too many requests", http.StatusTooManyRequests)
 			return
@@ -21,8 +42,16 @@ func (rlm *RateLimitMiddleware) Handle(next http.Handler) http.Handler {
 	})
 }
 
-func NewRateLimitMiddleware(userNum int) *RateLimitMiddleware {
+// perUserとburstPerUserは参加者一人あたりの値で、参加者数を掛けたものを全体の上限にする
+// 参加者が集まる前の登録が集中する時間帯のために、minUserNum人分は常に許容する
+func NewRateLimitMiddleware(rosterSize func() int, minUserNum int, perUser float64, burstPerUser int) *RateLimitMiddleware {
 	return &RateLimitMiddleware{
-		limiter: rate.NewLimiter(rate.Limit(userNum*5), userNum*20),
+		limiter:      rate.NewLimiter(rate.Limit(float64(minUserNum)*perUser), minUserNum*burstPerUser),
+		perUser:      perUser,
+		burstPerUser: burstPerUser,
+		minUserNum:   minUserNum,
+		rosterSize:   rosterSize,
+		mu:           sync.Mutex{},
+		sizedFor:     minUserNum,
 	}
 }
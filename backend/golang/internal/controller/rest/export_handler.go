@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"log"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+// 書き始めたかどうかを覚えておき、失敗した時にエラーを返せるかを判断する
+type trackingWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (tw *trackingWriter) Write(p []byte) (int, error) {
+	tw.written = true
+	return tw.ResponseWriter.Write(p)
+}
+
+// ゲームのデータをまとめたZIPを、サーバで溜めずにそのままダウンロードさせる
+type ExportHandler struct {
+	esu *usecase.ExportSessionUsecase
+}
+
+func (eh *ExportHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": eh.esu.FileName(time.Now())}))
+	w.Header().Set("Cache-Control", "no-store")
+	tw := &trackingWriter{ResponseWriter: w}
+	if err := eh.esu.Execute(tw); err != nil {
+		if !tw.written {
+			w.Header().Del("Content-Disposition")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// 書き始めた後はステータスを変えられないので、接続を切って壊れたZIPを正常に受け取ったと思わせない
+		log.Printf("failed to export session: %v", err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func NewExportHandler(esu *usecase.ExportSessionUsecase) *ExportHandler {
+	return &ExportHandler{
+		esu: esu,
+	}
+}
@@ -0,0 +1,58 @@
+package controller_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+
+	restcontroller "github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/rest"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/infra"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+func TestExportHandlerStreamsArchive(t *testing.T) {
+	db, err := infra.NewSQLiteDB(t.TempDir(), t.TempDir(), fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+	esu := usecase.NewExportSessionUsecase(
+		core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules()),
+		repository.NewUserRepository(cache.New(time.Minute, time.Minute), db),
+		repository.NewUserImageRepository(db),
+		repository.NewUserProfileRepository(db),
+		repository.NewProfileQuestionRepository(db),
+		infra.NewMemoryImageStore(),
+	)
+
+	rec := httptest.NewRecorder()
+	restcontroller.NewExportHandler(esu).Handle(rec, httptest.NewRequest(http.MethodGet, "/export", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/zip" {
+		t.Errorf("Content-Type = %q, want application/zip", got)
+	}
+	_, params, err := mime.ParseMediaType(rec.Header().Get("Content-Disposition"))
+	if err != nil || !strings.HasPrefix(params["filename"], usecase.SessionArchivePrefix) {
+		t.Errorf("Content-Disposition = %q, want a session archive file name", rec.Header().Get("Content-Disposition"))
+	}
+	zr, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len()))
+	if err != nil {
+		t.Fatalf("response is not a zip: %v", err)
+	}
+	if _, err := zr.Open("session.json"); err != nil {
+		t.Errorf("session.json is missing: %v", err)
+	}
+}
@@ -1,19 +1,90 @@
 package controller
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/fs"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/middleware"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
 )
 
 const UploadImageFormID string = "image"
 
+// サーバで切り抜く場合のフォームの項目
+// crop_x、crop_y、crop_width、crop_heightで範囲を指定するか、zoomとcrop_center_x、crop_center_yで指定する
+const (
+	CropXFormID       string = "crop_x"
+	CropYFormID       string = "crop_y"
+	CropWidthFormID   string = "crop_width"
+	CropHeightFormID  string = "crop_height"
+	CropZoomFormID    string = "zoom"
+	CropCenterXFormID string = "crop_center_x"
+	CropCenterYFormID string = "crop_center_y"
+)
+
+// 署名付きURLのパスに含める画像IDの名前
+const ImageIDPathValue string = "imageID"
+
+// thumbnail、medium、largeのどれを返すかを指定するクエリパラメータ
+const ImageSizeQueryKey string = usecase.ImageURLSizeKey
+
+const ImageCacheMaxAge time.Duration = time.Hour
+
 type ImageHandler struct {
-	iuu        *usecase.ImageUploadUsecase
-	idu        *usecase.ImageDownloadUsecase
-	fileServer http.Handler
+	iuu  *usecase.ImageUploadUsecase
+	idu  *usecase.ImageDownloadUsecase
+	sidu *usecase.SignedImageDownloadUsecase
+	diu  *usecase.DeleteImageUsecase
+}
+
+const multipartOverheadBytes int64 = 1 << 20
+
+var imageErrorStatuses = map[core.ImageErrorCode]int{
+	core.ImageErrorMissing:            http.StatusBadRequest,
+	core.ImageErrorFileTooLarge:       http.StatusRequestEntityTooLarge,
+	core.ImageErrorUnsupportedFormat:  http.StatusUnsupportedMediaType,
+	core.ImageErrorDimensionsTooLarge: http.StatusUnprocessableEntity,
+	core.ImageErrorCorrupted:          http.StatusUnprocessableEntity,
+}
+
+// クライアントが理由ごとに案内を出し分けられるように、種類と説明をJSONで返す
+func writeImageError(w http.ResponseWriter, imageErr *core.ImageError) {
+	status, ok := imageErrorStatuses[imageErr.Code]
+	if !ok {
+		status = http.StatusBadRequest
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    core.ImageErrorCode `json:"code"`
+		Message string              `json:"message"`
+	}{
+		Code:    imageErr.Code,
+		Message: imageErr.Message,
+	})
+}
+
+func imageErrorStatus(err error) int {
+	if errors.Is(err, usecase.ErrImageForbidden) {
+		return http.StatusForbidden
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
 }
 
 func (ih *ImageHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -25,47 +96,205 @@ func (ih *ImageHandler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	switch r.Method {
 	case "GET":
-		ih.download(w, r, reqUser.GetUserID())
+		ih.download(w, r, reqUser)
 	case "POST":
 		ih.upload(w, r, reqUser.GetUserID())
+	case "DELETE":
+		ih.delete(w, reqUser.GetUserID())
 	default:
 		http.Error(w, r.Method+" is not allowed", http.StatusMethodNotAllowed)
 	}
 }
 
 func (ih *ImageHandler) upload(w http.ResponseWriter, r *http.Request, reqUserID uuid.UUID) {
+	// multipartの境界やヘッダの分だけ余裕を持たせる
+	r.Body = http.MaxBytesReader(w, r.Body, ih.iuu.MaxUploadBytes()+multipartOverheadBytes)
 	if err := r.ParseMultipartForm(32 << 20); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeImageError(w, core.NewImageError(core.ImageErrorFileTooLarge, "Image must be at most %d bytes", ih.iuu.MaxUploadBytes()))
+			return
+		}
+		writeImageError(w, core.NewImageError(core.ImageErrorMissing, "Invalid form: %s", err.Error()))
 		return
 	}
 
 	fileSrc, _, err := r.FormFile(UploadImageFormID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeImageError(w, core.NewImageError(core.ImageErrorMissing, "Form field %q is required", UploadImageFormID))
 		return
 	}
 	defer fileSrc.Close()
 
-	if err = ih.iuu.Execute(fileSrc, reqUserID); err != nil {
+	crop, err := parseCropForm(r)
+	if err != nil {
+		writeImageError(w, core.NewImageError(core.ImageErrorInvalidCrop, "%s", err.Error()))
+		return
+	}
+
+	if err = ih.iuu.Execute(fileSrc, reqUserID, crop); err != nil {
+		var imageErr *core.ImageError
+		if errors.As(err, &imageErr) {
+			writeImageError(w, imageErr)
+			return
+		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 }
 
-func (ih *ImageHandler) download(w http.ResponseWriter, r *http.Request, reqUserID uuid.UUID) {
-	imagePath, err := ih.idu.Execute(r.URL.Path, reqUserID)
+// react-easy-cropの範囲は小数になることがあるので、小数も受け付けて丸める
+func parseCropInt(r *http.Request, key string) (int, bool, error) {
+	value := r.FormValue(key)
+	if value == "" {
+		return 0, false, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false, fmt.Errorf("%s must be a number, but got %q", key, value)
+	}
+	return int(math.Round(f)), true, nil
+}
+
+// 切り抜きの項目が一つも無い場合はnilを返す
+func parseCropForm(r *http.Request) (*core.ImageCrop, error) {
+	rectKeys := []string{CropXFormID, CropYFormID, CropWidthFormID, CropHeightFormID}
+	rectValues := make([]int, 0, len(rectKeys))
+	for _, key := range rectKeys {
+		v, ok, err := parseCropInt(r, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rectValues = append(rectValues, v)
+		}
+	}
+	centerX, hasCenterX, err := parseCropInt(r, CropCenterXFormID)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, err
+	}
+	centerY, hasCenterY, err := parseCropInt(r, CropCenterYFormID)
+	if err != nil {
+		return nil, err
+	}
+	zoomValue := r.FormValue(CropZoomFormID)
+
+	switch {
+	case len(rectValues) == len(rectKeys):
+		x, y, width, height := rectValues[0], rectValues[1], rectValues[2], rectValues[3]
+		if width <= 0 || height <= 0 {
+			return nil, fmt.Errorf("%s and %s must be positive", CropWidthFormID, CropHeightFormID)
+		}
+		return &core.ImageCrop{Rect: image.Rect(x, y, x+width, y+height)}, nil
+	case len(rectValues) > 0:
+		return nil, fmt.Errorf("%s, %s, %s and %s must be given together", CropXFormID, CropYFormID, CropWidthFormID, CropHeightFormID)
+	case hasCenterX != hasCenterY:
+		return nil, fmt.Errorf("%s and %s must be given together", CropCenterXFormID, CropCenterYFormID)
+	case zoomValue == "" && !hasCenterX:
+		return nil, nil
+	}
+
+	crop := &core.ImageCrop{Zoom: 1}
+	if zoomValue != "" {
+		zoom, err := strconv.ParseFloat(zoomValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number, but got %q", CropZoomFormID, zoomValue)
+		}
+		crop.Zoom = zoom
+	}
+	if hasCenterX {
+		crop.Center = &image.Point{X: centerX, Y: centerY}
+	}
+	return crop, nil
+}
+
+func (ih *ImageHandler) delete(w http.ResponseWriter, reqUserID uuid.UUID) {
+	if err := ih.diu.Execute(reqUserID); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (ih *ImageHandler) download(w http.ResponseWriter, r *http.Request, reqUser *model.User) {
+	variant, err := core.ParseImageVariant(r.URL.Query().Get(ImageSizeQueryKey))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	img, err := ih.idu.Execute(r.URL.Path, reqUser, variant)
+	if err != nil {
+		http.Error(w, err.Error(), imageErrorStatus(err))
+		return
+	}
+	serveImage(w, r, img)
+}
+
+// <img>タグなどから認証ヘッダ無しで取得される、署名付きURLの画像を返す
+func (ih *ImageHandler) HandleSigned(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	variant, err := core.ParseImageVariant(query.Get(ImageSizeQueryKey))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	img, err := ih.sidu.Execute(
+		r.PathValue(ImageIDPathValue),
+		query.Get(usecase.ImageURLExpiresKey),
+		query.Get(usecase.ImageURLSignatureKey),
+		variant,
+	)
+	if err != nil {
+		http.Error(w, err.Error(), imageErrorStatus(err))
+		return
+	}
+	serveImage(w, r, img)
+}
+
+// If-None-Matchにetagが含まれているか、弱いETagとして比較する
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// 画像は再アップロードされると別のIDになり、同じファイル名の中身は変わらないので、ファイル名をそのままETagにする
+// 出題中は毎秒同じ画像のURLが届くので、If-None-Matchが一致すれば304を返して再ダウンロードさせない
+// 保存先がS3の場合もメモリに読み込まずに、取り出した画像をそのまま流す
+func serveImage(w http.ResponseWriter, r *http.Request, img *model.StoredImage) {
+	defer img.Close()
+	etag := `"` + img.GetKey() + `"`
+	w.Header().Set("ETag", etag)
+	// 参加者を弾いた後に共有のキャッシュに残らないようにprivateにする
+	w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(ImageCacheMaxAge.Seconds())))
+	if !img.GetModTime().IsZero() {
+		w.Header().Set("Last-Modified", img.GetModTime().UTC().Format(http.TimeFormat))
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if img.GetSize() >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(img.GetSize(), 10))
+	}
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
 		return
 	}
-	r.URL.Path = imagePath
-	ih.fileServer.ServeHTTP(w, r)
+	_, _ = io.Copy(w, img)
 }
 
-func NewImageHandler(iuu *usecase.ImageUploadUsecase, idu *usecase.ImageDownloadUsecase, dirName string) *ImageHandler {
+func NewImageHandler(iuu *usecase.ImageUploadUsecase, idu *usecase.ImageDownloadUsecase, sidu *usecase.SignedImageDownloadUsecase, diu *usecase.DeleteImageUsecase) *ImageHandler {
 	return &ImageHandler{
-		iuu:        iuu,
-		idu:        idu,
-		fileServer: http.FileServer(http.Dir(dirName)),
+		iuu:  iuu,
+		idu:  idu,
+		sidu: sidu,
+		diu:  diu,
 	}
 }
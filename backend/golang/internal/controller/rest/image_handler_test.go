@@ -0,0 +1,117 @@
+package controller_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	restcontroller "github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/rest"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/infra"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+// 画像IDと持ち主の組を１つだけ持つ画像リポジトリ
+type singleImageRepository struct {
+	imageID string
+	owner   uuid.UUID
+}
+
+func (sir singleImageRepository) Save(uuid.UUID, string) error { return nil }
+
+func (sir singleImageRepository) Delete(uuid.UUID) error { return nil }
+
+func (sir singleImageRepository) FetchByUserID(uid uuid.UUID) (string, error) {
+	if uid != sir.owner {
+		return "", errors.New("Image not found")
+	}
+	return sir.imageID, nil
+}
+
+func (sir singleImageRepository) FetchUserIDByImageID(imageID string) (uuid.UUID, error) {
+	if imageID != sir.imageID {
+		return uuid.Nil, errors.New("Image not found")
+	}
+	return sir.owner, nil
+}
+
+// imageIDの全サイズの画像を置いた保存先から、署名付きURLで画像を返すサーバを作る
+func newSignedImageServer(t *testing.T, imageID string) (http.Handler, *usecase.ImageURLSigner) {
+	t.Helper()
+	store := infra.NewMemoryImageStore()
+	for _, name := range []string{imageID + ".jpg", imageID + ".medium.jpg", imageID + ".thumbnail.jpg"} {
+		if err := store.Put(name, []byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	gm := core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules())
+	if _, err := gm.OpenLobby(); err != nil {
+		t.Fatal(err)
+	}
+	owner := uuid.New()
+	if _, err := gm.JoinLobby(owner); err != nil {
+		t.Fatal(err)
+	}
+	uir := singleImageRepository{imageID: imageID, owner: owner}
+	signer := usecase.NewImageURLSigner([]byte("secret"), time.Minute, "/images/")
+	as := usecase.NewAvatarStore(store, core.NewImageProcessor(core.DefaultMaxUploadBytes, 100))
+	ih := restcontroller.NewImageHandler(nil, usecase.NewImageDownloadUsecase(gm, uir, as), usecase.NewSignedImageDownloadUsecase(gm, uir, signer, as), nil)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /images/{"+restcontroller.ImageIDPathValue+"}", ih.HandleSigned)
+	return mux, signer
+}
+
+func getImage(handler http.Handler, url string, ifNoneMatch string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, url, nil)
+	if ifNoneMatch != "" {
+		r.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestImageHandlerServesVariantsWithETag(t *testing.T) {
+	handler, signer := newSignedImageServer(t, "abc")
+	wantCacheControl := fmt.Sprintf("private, max-age=%d", int(restcontroller.ImageCacheMaxAge.Seconds()))
+	for variant, file := range map[core.ImageVariant]string{core.ImageLarge: "abc.jpg", core.ImageMedium: "abc.medium.jpg", core.ImageThumbnail: "abc.thumbnail.jpg"} {
+		w := getImage(handler, signer.SignedURL("abc", variant), "")
+		if w.Code != http.StatusOK || w.Body.String() != file {
+			t.Errorf("%s: got %d %q, want 200 %q", variant, w.Code, w.Body.String(), file)
+		}
+		if etag := w.Header().Get("ETag"); etag != `"`+file+`"` {
+			t.Errorf("%s: ETag = %s, want the file name", variant, etag)
+		}
+		if cc := w.Header().Get("Cache-Control"); cc != wantCacheControl {
+			t.Errorf("%s: Cache-Control = %q, want %q", variant, cc, wantCacheControl)
+		}
+	}
+}
+
+// 同じ画像を毎秒取得し直しても、ETagが一致すれば中身は送らない
+func TestImageHandlerNotModified(t *testing.T) {
+	handler, signer := newSignedImageServer(t, "abc")
+	url := signer.SignedURL("abc", core.ImageMedium)
+	etag := getImage(handler, url, "").Header().Get("ETag")
+
+	w := getImage(handler, url, etag)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("with a matching ETag: got %d with %d bytes, want 304 without a body", w.Code, w.Body.Len())
+	}
+	// 別のサイズのETagでは304にならない
+	if w := getImage(handler, signer.SignedURL("abc", core.ImageThumbnail), etag); w.Code != http.StatusOK {
+		t.Errorf("with the ETag of another size: status = %d, want 200", w.Code)
+	}
+}
+
+func TestImageHandlerRejectsUnknownSize(t *testing.T) {
+	handler, signer := newSignedImageServer(t, "abc")
+	if w := getImage(handler, signer.SignedURL("abc", core.ImageLarge)+"&size=huge", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+const QRCodeSizeQueryKey string = "size"
+
+// 参加者向けURLを映すためのQRコード画像を返す
+type QRCodeHandler struct {
+	giqu        *usecase.GetInvitationQRCodeUsecase
+	defaultSize int
+	minSize     int
+	maxSize     int
+}
+
+func (qh *QRCodeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	size := qh.defaultSize
+	if s := r.URL.Query().Get(QRCodeSizeQueryKey); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < qh.minSize || n > qh.maxSize {
+			http.Error(w, "size must be an integer between "+strconv.Itoa(qh.minSize)+" and "+strconv.Itoa(qh.maxSize), http.StatusBadRequest)
+			return
+		}
+		size = n
+	}
+	png, err := qh.giqu.Execute(size)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	// URLは起動中に変わらないのでキャッシュさせて良い
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	w.Header().Set("X-Invitation-URL", qh.giqu.GetInvitationURL())
+	_, _ = w.Write(png)
+}
+
+func NewQRCodeHandler(giqu *usecase.GetInvitationQRCodeUsecase, defaultSize int, minSize int, maxSize int) *QRCodeHandler {
+	return &QRCodeHandler{
+		giqu:        giqu,
+		defaultSize: defaultSize,
+		minSize:     minSize,
+		maxSize:     maxSize,
+	}
+}
@@ -0,0 +1,63 @@
+package controller_test
+
+import (
+	"bytes"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	restcontroller "github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/rest"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/infra"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+const testInvitationURL = "https://example.com/abc/guest"
+
+func serveQRCode(query string) *httptest.ResponseRecorder {
+	qh := restcontroller.NewQRCodeHandler(
+		usecase.NewGetInvitationQRCodeUsecase(testInvitationURL, infra.EncodeQRCodePNG),
+		infra.DefaultQRCodeSize, infra.MinQRCodeSize, infra.MaxQRCodeSize,
+	)
+	w := httptest.NewRecorder()
+	qh.Handle(w, httptest.NewRequest(http.MethodGet, "/qrcode"+query, nil))
+	return w
+}
+
+func TestQRCodeHandlerSize(t *testing.T) {
+	tests := map[string]int{
+		"":           infra.DefaultQRCodeSize,
+		"?size=64":   infra.MinQRCodeSize,
+		"?size=1024": infra.MaxQRCodeSize,
+		"?size=300":  300,
+	}
+	for query, wantSize := range tests {
+		w := serveQRCode(query)
+		if w.Code != http.StatusOK {
+			t.Errorf("GET /qrcode%s status = %d, want %d", query, w.Code, http.StatusOK)
+			continue
+		}
+		if ct := w.Header().Get("Content-Type"); ct != "image/png" {
+			t.Errorf("GET /qrcode%s Content-Type = %q, want image/png", query, ct)
+		}
+		if u := w.Header().Get("X-Invitation-URL"); u != testInvitationURL {
+			t.Errorf("GET /qrcode%s X-Invitation-URL = %q, want %q", query, u, testInvitationURL)
+		}
+		img, err := png.Decode(bytes.NewReader(w.Body.Bytes()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b := img.Bounds(); b.Dx() != wantSize {
+			t.Errorf("GET /qrcode%s width = %d, want %d", query, b.Dx(), wantSize)
+		}
+	}
+}
+
+// 範囲外の大きさを指定して巨大な画像を作らせることはできない
+func TestQRCodeHandlerRejectsSizeOutOfBounds(t *testing.T) {
+	for _, query := range []string{"?size=63", "?size=1025", "?size=100000000", "?size=-1", "?size=big"} {
+		if w := serveQRCode(query); w.Code != http.StatusBadRequest {
+			t.Errorf("GET /qrcode%s status = %d, want %d", query, w.Code, http.StatusBadRequest)
+		}
+	}
+}
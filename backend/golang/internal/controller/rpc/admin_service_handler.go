@@ -1,9 +1,14 @@
 package controller
 
 import (
+	"bufio"
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	adminv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1"        // generated by protoc-gen-go
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1/adminv1connect" // generated by protoc-gen-connect-go
@@ -15,22 +20,55 @@ import (
 
 type AdminServiceHandler struct {
 	adminv1connect.UnimplementedAdminServiceHandler
-	oeu     *usecase.OpenEntryUsecase
-	ceu     *usecase.CloseEntryUsecase
-	ruu     *usecase.RejectUserUsecase
-	ctu     *usecase.ChangeTeamUsecase
-	asqu    *usecase.AdminStartQuestUsecase
-	rqu     *usecase.ReadyQuizUsecase
-	cau     *usecase.CheckAnswersUsecase
-	nqu     *usecase.NextQuizUsecase
-	equ     *usecase.EndQuestUsecase
-	userNum int32
+	oeu  *usecase.OpenEntryUsecase
+	ceu  *usecase.CloseEntryUsecase
+	ruu  *usecase.RejectUserUsecase
+	ctu  *usecase.ChangeTeamUsecase
+	asqu *usecase.AdminStartQuestUsecase
+	rqu  *usecase.ReadyQuizUsecase
+	cau  *usecase.CheckAnswersUsecase
+	nqu  *usecase.NextQuizUsecase
+	equ  *usecase.EndQuestUsecase
+	lqu  *usecase.ListQuestionsUsecase
+	cqu  *usecase.CreateQuestionUsecase
+	uqu  *usecase.UpdateQuestionUsecase
+	dqu  *usecase.DeleteQuestionUsecase
+	roqu *usecase.ReorderQuestionsUsecase
+	lfau *usecase.ListFlaggedAnswersUsecase
+	euau *usecase.EditUserAnswerUsecase
+	ruau *usecase.RemoveUserAnswerUsecase
+	ruiu *usecase.RemoveUserImageUsecase
+	gru  *usecase.GetRulesUsecase
+	sru  *usecase.SetRulesUsecase
+	selu *usecase.SetEntryLimitsUsecase
+	esu  *usecase.ExportSessionUsecase
+	ghu  *usecase.GetHistoryUsecase
+	gphu *usecase.GetPlayerHistoryUsecase
+	gau  *usecase.GetAnalyticsUsecase
+}
+
+func toProtoQuestion(question *model.ProfileQuestion) *adminv1.ProfileQuestion {
+	return &adminv1.ProfileQuestion{
+		QuestionId:    uint32(question.GetQuestionID()),
+		QuestionText:  question.GetQuestionText(),
+		QuizText:      question.GetQuizText(),
+		SampleAnswers: question.GetSampleAnswers(),
+		IsOptional:    question.IsOptional(),
+	}
+}
+
+func toProtoQuestions(questions []model.ProfileQuestion) []*adminv1.ProfileQuestion {
+	res := make([]*adminv1.ProfileQuestion, 0, len(questions))
+	for i := range questions {
+		res = append(res, toProtoQuestion(&questions[i]))
+	}
+	return res
 }
 
 func (ash *AdminServiceHandler) OpenEntry(ctx context.Context, r *connect.Request[emptypb.Empty], stream *connect.ServerStream[adminv1.OpenEntryResponse]) error {
 	if err := ash.oeu.Execute(
 		ctx,
-		func(users []model.User) error {
+		func(users []model.User, limits core.EntryLimits) error {
 			enteredUsers := make([]*adminv1.User, 0, len(users))
 			for _, u := range users {
 				enteredUsers = append(enteredUsers, &adminv1.User{
@@ -42,7 +80,8 @@ func (ash *AdminServiceHandler) OpenEntry(ctx context.Context, r *connect.Reques
 			}
 			return stream.Send(&adminv1.OpenEntryResponse{
 				EnteredUsers:    enteredUsers,
-				ExpectedUserNum: ash.userNum,
+				ExpectedUserNum: int32(limits.ExpectedUserNum),
+				MaxUserNum:      int32(limits.MaxUserNum),
 			})
 		},
 		func() { /*** DO NOTHING ***/ },
@@ -91,13 +130,14 @@ func (ash *AdminServiceHandler) StartQuest(ctx context.Context, r *connect.Reque
 				})
 			}
 			return stream.Send(&adminv1.StartQuestResponse{
-				TargetUserImageId: quiz.ImageID,
-				TargetTeamId:      uint32(quiz.TeamID),
-				QuestionId:        uint32(quiz.QuestionID),
-				Question:          quiz.QuestionText,
-				Choices:           choices,
-				LastTime:          int32(quiz.RemainedTime),
-				HintText:          hint,
+				TargetUserImageId:  quiz.ImageID,
+				TargetTeamId:       uint32(quiz.TeamID),
+				QuestionId:         uint32(quiz.QuestionID),
+				Question:           quiz.QuestionText,
+				Choices:            choices,
+				LastTime:           int32(quiz.RemainedTime),
+				HintText:           hint,
+				TargetUserImageUrl: quiz.ImageURL,
 			})
 		},
 		func(err error) error {
@@ -149,8 +189,24 @@ func (ash *AdminServiceHandler) NextQuiz(ctx context.Context, r *connect.Request
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func toProtoAwards(awards []usecase.AwardDTO) []*commonv1.Award {
+	res := make([]*commonv1.Award, 0, len(awards))
+	for _, a := range awards {
+		res = append(res, &commonv1.Award{
+			Kind:           commonv1.AwardKind(a.Kind),
+			UserName:       a.UserName,
+			TeamId:         uint32(a.TeamID),
+			TeamColor:      model.TeamColor(uint32(a.TeamID)).String(),
+			Count:          uint32(a.Count),
+			CorrectRate:    a.CorrectRate,
+			ResponseTimeMs: a.ResponseTime.Milliseconds(),
+		})
+	}
+	return res
+}
+
 func (ash *AdminServiceHandler) EndQuest(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[adminv1.EndQuestResponse], error) {
-	resultState, teamStats, err := ash.equ.Execute()
+	resultState, teamStats, awards, err := ash.equ.Execute()
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
@@ -177,6 +233,411 @@ func (ash *AdminServiceHandler) EndQuest(ctx context.Context, r *connect.Request
 	return connect.NewResponse(&adminv1.EndQuestResponse{
 		Result: commonv1.Result(resultState),
 		Stats:  wholeStats,
+		Awards: toProtoAwards(awards),
+	}), nil
+}
+
+func (ash *AdminServiceHandler) ListQuestions(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[adminv1.ListQuestionsResponse], error) {
+	questions, err := ash.lqu.Execute()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(&adminv1.ListQuestionsResponse{
+		Questions: toProtoQuestions(questions),
+	}), nil
+}
+
+func (ash *AdminServiceHandler) CreateQuestion(ctx context.Context, r *connect.Request[adminv1.ProfileQuestion]) (*connect.Response[adminv1.ProfileQuestion], error) {
+	question, err := ash.cqu.Execute(usecase.QuestionDTO{
+		QuestionText:  r.Msg.QuestionText,
+		QuizText:      r.Msg.QuizText,
+		SampleAnswers: r.Msg.SampleAnswers,
+		IsOptional:    r.Msg.IsOptional,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(toProtoQuestion(question)), nil
+}
+
+func (ash *AdminServiceHandler) UpdateQuestion(ctx context.Context, r *connect.Request[adminv1.ProfileQuestion]) (*connect.Response[adminv1.ProfileQuestion], error) {
+	question, err := ash.uqu.Execute(usecase.QuestionDTO{
+		QuestionID:    uint(r.Msg.QuestionId),
+		QuestionText:  r.Msg.QuestionText,
+		QuizText:      r.Msg.QuizText,
+		SampleAnswers: r.Msg.SampleAnswers,
+		IsOptional:    r.Msg.IsOptional,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(toProtoQuestion(question)), nil
+}
+
+func (ash *AdminServiceHandler) DeleteQuestion(ctx context.Context, r *connect.Request[adminv1.DeleteQuestionRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := ash.dqu.Execute(uint(r.Msg.QuestionId)); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (ash *AdminServiceHandler) ReorderQuestions(ctx context.Context, r *connect.Request[adminv1.ReorderQuestionsRequest]) (*connect.Response[adminv1.ListQuestionsResponse], error) {
+	questionIDs := make([]uint, 0, len(r.Msg.QuestionIds))
+	for _, qid := range r.Msg.QuestionIds {
+		questionIDs = append(questionIDs, uint(qid))
+	}
+	questions, err := ash.roqu.Execute(questionIDs)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&adminv1.ListQuestionsResponse{
+		Questions: toProtoQuestions(questions),
+	}), nil
+}
+
+func (ash *AdminServiceHandler) ListFlaggedAnswers(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[adminv1.ListFlaggedAnswersResponse], error) {
+	flagged, err := ash.lfau.Execute()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	answers := make([]*adminv1.FlaggedAnswer, 0, len(flagged))
+	for _, f := range flagged {
+		answers = append(answers, &adminv1.FlaggedAnswer{
+			UserId:       f.UserID.String(),
+			UserName:     f.UserName,
+			QuestionId:   uint32(f.QuestionID),
+			QuestionText: f.QuestionText,
+			Answer:       f.Answer,
+			MatchedTerm:  f.MatchedTerm,
+		})
+	}
+	return connect.NewResponse(&adminv1.ListFlaggedAnswersResponse{
+		Answers: answers,
+	}), nil
+}
+
+func (ash *AdminServiceHandler) EditUserAnswer(ctx context.Context, r *connect.Request[adminv1.EditUserAnswerRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := ash.euau.Execute(r.Msg.UserId, uint(r.Msg.QuestionId), r.Msg.Answer); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (ash *AdminServiceHandler) RemoveUserAnswer(ctx context.Context, r *connect.Request[adminv1.RemoveUserAnswerRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := ash.ruau.Execute(r.Msg.UserId, uint(r.Msg.QuestionId)); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// 不適切な画像を消して、本人に撮り直しを求める
+func (ash *AdminServiceHandler) RemoveUserImage(ctx context.Context, r *connect.Request[adminv1.RemoveUserImageRequest]) (*connect.Response[emptypb.Empty], error) {
+	if err := ash.ruiu.Execute(r.Msg.UserId); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func toProtoAwardRule(rule core.AwardRule) *adminv1.AwardRule {
+	return &adminv1.AwardRule{
+		Enabled:  rule.Enabled,
+		MinCount: int32(rule.MinCount),
+	}
+}
+
+func fromProtoAwardRule(rule *adminv1.AwardRule) core.AwardRule {
+	return core.AwardRule{
+		Enabled:  rule.GetEnabled(),
+		MinCount: int(rule.GetMinCount()),
+	}
+}
+
+func toProtoRules(rules core.GameRules) *adminv1.GameRules {
+	return &adminv1.GameRules{
+		CountdownSeconds: int32(rules.CountdownSeconds),
+		HintBonusSeconds: int32(rules.HintBonusSeconds),
+		MaxHintLength:    int32(rules.MaxHintLength),
+		AnswerTimeoutMs:  rules.AnswerTimeout.Milliseconds(),
+		MaxChoiceNum:     int32(rules.MaxChoiceNum),
+		LobbyTickMs:      rules.LobbyTick.Milliseconds(),
+		MinTeamUser:      int32(rules.MinTeamUser),
+		ResultThresholds: &adminv1.ResultThresholds{
+			Excellent: rules.ResultThresholds.Excellent,
+			Great:     rules.ResultThresholds.Great,
+			GoodJob:   rules.ResultThresholds.GoodJob,
+			Clear:     rules.ResultThresholds.Clear,
+		},
+		Awards: &adminv1.AwardRules{
+			FastestAnswerer: toProtoAwardRule(rules.Awards.FastestAnswerer),
+			MostMysterious:  toProtoAwardRule(rules.Awards.MostMysterious),
+			BestHintGiver:   toProtoAwardRule(rules.Awards.BestHintGiver),
+			ComebackTeam:    toProtoAwardRule(rules.Awards.ComebackTeam),
+			PerfectStreak:   toProtoAwardRule(rules.Awards.PerfectStreak),
+		},
+	}
+}
+
+// SetRulesは全ての項目を置き換えるので、入れ子のメッセージが欠けていると閾値や賞が全て0になってしまう
+// protovalidateでも弾いているが、ここでも欠けたメッセージは受け付けない
+func fromProtoRules(msg *adminv1.GameRules) (core.GameRules, error) {
+	awards := msg.GetAwards()
+	if msg.GetResultThresholds() == nil || awards == nil {
+		return core.GameRules{}, errors.New("Result thresholds and award rules are required")
+	}
+	if awards.GetFastestAnswerer() == nil || awards.GetMostMysterious() == nil || awards.GetBestHintGiver() == nil || awards.GetComebackTeam() == nil || awards.GetPerfectStreak() == nil {
+		return core.GameRules{}, errors.New("Every award rule is required")
+	}
+	return core.GameRules{
+		CountdownSeconds: int(msg.GetCountdownSeconds()),
+		HintBonusSeconds: int(msg.GetHintBonusSeconds()),
+		MaxHintLength:    int(msg.GetMaxHintLength()),
+		AnswerTimeout:    time.Duration(msg.GetAnswerTimeoutMs()) * time.Millisecond,
+		MaxChoiceNum:     int(msg.GetMaxChoiceNum()),
+		LobbyTick:        time.Duration(msg.GetLobbyTickMs()) * time.Millisecond,
+		MinTeamUser:      int(msg.GetMinTeamUser()),
+		ResultThresholds: core.ResultThresholds{
+			Excellent: msg.GetResultThresholds().GetExcellent(),
+			Great:     msg.GetResultThresholds().GetGreat(),
+			GoodJob:   msg.GetResultThresholds().GetGoodJob(),
+			Clear:     msg.GetResultThresholds().GetClear(),
+		},
+		Awards: core.AwardRules{
+			FastestAnswerer: fromProtoAwardRule(awards.GetFastestAnswerer()),
+			MostMysterious:  fromProtoAwardRule(awards.GetMostMysterious()),
+			BestHintGiver:   fromProtoAwardRule(awards.GetBestHintGiver()),
+			ComebackTeam:    fromProtoAwardRule(awards.GetComebackTeam()),
+			PerfectStreak:   fromProtoAwardRule(awards.GetPerfectStreak()),
+		},
+	}, nil
+}
+
+func (ash *AdminServiceHandler) GetRules(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[adminv1.GameRules], error) {
+	return connect.NewResponse(toProtoRules(ash.gru.Execute())), nil
+}
+
+func (ash *AdminServiceHandler) SetRules(ctx context.Context, r *connect.Request[adminv1.GameRules]) (*connect.Response[adminv1.GameRules], error) {
+	rules, err := fromProtoRules(r.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	rules, err = ash.sru.Execute(rules)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(toProtoRules(rules)), nil
+}
+
+func (ash *AdminServiceHandler) SetEntryLimits(ctx context.Context, r *connect.Request[adminv1.SetEntryLimitsRequest]) (*connect.Response[adminv1.EntryLimits], error) {
+	var expectedUserNum, maxUserNum *int
+	if r.Msg.ExpectedUserNum != nil {
+		n := int(r.Msg.GetExpectedUserNum())
+		expectedUserNum = &n
+	}
+	if r.Msg.MaxUserNum != nil {
+		n := int(r.Msg.GetMaxUserNum())
+		maxUserNum = &n
+	}
+	limits, err := ash.selu.Execute(expectedUserNum, maxUserNum)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(&adminv1.EntryLimits{
+		ExpectedUserNum: int32(limits.ExpectedUserNum),
+		MaxUserNum:      int32(limits.MaxUserNum),
+	}), nil
+}
+
+// ZIPを分割して送る１メッセージ分の大きさ
+const ExportChunkSize int = 64 * 1024
+
+// 書き込まれたデータをExportChunkSize毎に区切ってストリームに送る
+type exportStreamWriter struct {
+	stream   *connect.ServerStream[adminv1.ExportSessionResponse]
+	fileName string
+}
+
+func (esw *exportStreamWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p[:min(len(p), ExportChunkSize)]
+		if err := esw.stream.Send(&adminv1.ExportSessionResponse{
+			FileName: esw.fileName,
+			Chunk:    chunk,
+		}); err != nil {
+			return written, err
+		}
+		// ファイル名は最初のメッセージにだけ付ける
+		esw.fileName = ""
+		written += len(chunk)
+		p = p[len(chunk):]
+	}
+	return written, nil
+}
+
+// 画像が多くてもサーバで溜めないように、書いた分から送る
+// 途中で失敗した場合もエラーで終わるので、受け取った側は壊れたZIPを正常と取り違えない
+func (ash *AdminServiceHandler) ExportSession(ctx context.Context, r *connect.Request[emptypb.Empty], stream *connect.ServerStream[adminv1.ExportSessionResponse]) error {
+	// ZIPは細かく書き込まれるので、まとめてから送る
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream, fileName: ash.esu.FileName(time.Now())}, ExportChunkSize)
+	if err := ash.esu.Execute(w); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if err := w.Flush(); err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+func correctRate(correctCount int, quizCount int) float32 {
+	if quizCount == 0 {
+		return 0
+	}
+	return float32(correctCount) / float32(quizCount)
+}
+
+func (ash *AdminServiceHandler) GetHistory(ctx context.Context, r *connect.Request[adminv1.GetHistoryRequest]) (*connect.Response[adminv1.GetHistoryResponse], error) {
+	history, err := ash.ghu.Execute(int(r.Msg.Limit), int(r.Msg.Offset))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	games := make([]*adminv1.GameHistory, 0, len(history))
+	for _, game := range history {
+		teams := make([]*adminv1.HistoryTeam, 0, len(game.Teams))
+		for _, t := range game.Teams {
+			teams = append(teams, &adminv1.HistoryTeam{
+				TeamId:       t.TeamID,
+				TeamColor:    model.TeamColor(t.TeamID).String(),
+				CorrectCount: uint32(t.CorrectCount),
+				Points:       uint32(t.Points),
+				CorrectRate:  correctRate(t.CorrectCount, game.QuizCount),
+				TeamOrder:    uint32(t.Order),
+			})
+		}
+		players := make([]*adminv1.HistoryPlayer, 0, len(game.Players))
+		for _, p := range game.Players {
+			players = append(players, &adminv1.HistoryPlayer{
+				PlayerId:              p.PlayerID.String(),
+				PlayerName:            p.Name,
+				TeamId:                p.TeamID,
+				AnsweredCount:         uint32(p.AnsweredCount),
+				CorrectCount:          uint32(p.CorrectCount),
+				CorrectRate:           correctRate(p.CorrectCount, game.QuizCount),
+				AverageResponseTimeMs: p.AverageResponseTime().Milliseconds(),
+				PersonalOrder:         uint32(p.Order),
+			})
+		}
+		games = append(games, &adminv1.GameHistory{
+			GameId:      game.GameID.String(),
+			StartedAtMs: game.StartedAt.UnixMilli(),
+			EndedAtMs:   game.EndedAt.UnixMilli(),
+			Rules:       toProtoRules(game.Rules),
+			QuizCount:   uint32(game.QuizCount),
+			Teams:       teams,
+			Players:     players,
+		})
+	}
+	return connect.NewResponse(&adminv1.GetHistoryResponse{
+		Games: games,
+	}), nil
+}
+
+func (ash *AdminServiceHandler) GetPlayerHistory(ctx context.Context, r *connect.Request[adminv1.GetPlayerHistoryRequest]) (*connect.Response[adminv1.GetPlayerHistoryResponse], error) {
+	pid, err := uuid.Parse(r.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	history, err := ash.gphu.Execute(pid)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("Player is not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	games := make([]*adminv1.PlayerGameHistory, 0, len(history.Games))
+	for _, game := range history.Games {
+		games = append(games, &adminv1.PlayerGameHistory{
+			GameId:                game.GameID.String(),
+			StartedAtMs:           game.StartedAt.UnixMilli(),
+			PlayerName:            game.Name,
+			TeamId:                game.TeamID,
+			QuizCount:             uint32(game.QuizCount),
+			AnsweredCount:         uint32(game.AnsweredCount),
+			CorrectCount:          uint32(game.CorrectCount),
+			CorrectRate:           correctRate(game.CorrectCount, game.QuizCount),
+			AverageResponseTimeMs: game.AverageResponseTime().Milliseconds(),
+			PersonalOrder:         uint32(game.Order),
+			PlayerCount:           uint32(game.PlayerCount),
+			TeamOrder:             uint32(game.TeamOrder),
+			TeamNum:               uint32(game.TeamNum),
+		})
+	}
+	return connect.NewResponse(&adminv1.GetPlayerHistoryResponse{
+		PlayerId:   history.PlayerID.String(),
+		PlayerName: history.Name,
+		Games:      games,
+	}), nil
+}
+
+func toProtoMemberKnowledge(members []usecase.MemberKnowledgeDTO) []*adminv1.MemberKnowledge {
+	protoMembers := make([]*adminv1.MemberKnowledge, 0, len(members))
+	for _, m := range members {
+		protoMembers = append(protoMembers, &adminv1.MemberKnowledge{
+			UserId:        m.UserID,
+			UserName:      m.UserName,
+			TeamId:        m.TeamID,
+			TeamColor:     m.TeamColor,
+			QuizCount:     uint32(m.QuizCount),
+			AnsweredCount: uint32(m.AnsweredCount),
+			CorrectCount:  uint32(m.CorrectCount),
+			CorrectRate:   m.CorrectRate,
+		})
+	}
+	return protoMembers
+}
+
+func (ash *AdminServiceHandler) GetAnalytics(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[adminv1.GetAnalyticsResponse], error) {
+	analytics, err := ash.gau.Execute()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	graphJSON, err := analytics.Graph.JSON()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	questions := make([]*adminv1.QuestionDifficulty, 0, len(analytics.HardestQuestions))
+	for _, q := range analytics.HardestQuestions {
+		questions = append(questions, &adminv1.QuestionDifficulty{
+			QuestionId:    uint32(q.QuestionID),
+			QuestionText:  q.QuestionText,
+			QuizCount:     uint32(q.QuizCount),
+			AnsweredCount: uint32(q.AnsweredCount),
+			CorrectCount:  uint32(q.CorrectCount),
+			CorrectRate:   q.CorrectRate,
+		})
+	}
+	names := make(map[string]string, len(analytics.Graph.Nodes))
+	for _, n := range analytics.Graph.Nodes {
+		names[n.UserID] = n.UserName
+	}
+	relationships := make([]*adminv1.Relationship, 0, len(analytics.Graph.Edges))
+	for _, e := range analytics.Graph.Edges {
+		relationships = append(relationships, &adminv1.Relationship{
+			AnswererId:    e.AnswererID,
+			AnswererName:  names[e.AnswererID],
+			TargetId:      e.TargetID,
+			TargetName:    names[e.TargetID],
+			AnsweredCount: uint32(e.AnsweredCount),
+			CorrectCount:  uint32(e.CorrectCount),
+			CorrectRate:   e.CorrectRate,
+		})
+	}
+	return connect.NewResponse(&adminv1.GetAnalyticsResponse{
+		MostKnown:        toProtoMemberKnowledge(analytics.MostKnown),
+		LeastKnown:       toProtoMemberKnowledge(analytics.LeastKnown),
+		HardestQuestions: questions,
+		Relationships:    relationships,
+		GraphJson:        string(graphJSON),
+		GraphDot:         analytics.Graph.DOT(),
 	}), nil
 }
 
@@ -190,18 +651,48 @@ func NewAdminServiceHandler(
 	cau *usecase.CheckAnswersUsecase,
 	nqu *usecase.NextQuizUsecase,
 	equ *usecase.EndQuestUsecase,
-	userNum int,
+	lqu *usecase.ListQuestionsUsecase,
+	cqu *usecase.CreateQuestionUsecase,
+	uqu *usecase.UpdateQuestionUsecase,
+	dqu *usecase.DeleteQuestionUsecase,
+	roqu *usecase.ReorderQuestionsUsecase,
+	lfau *usecase.ListFlaggedAnswersUsecase,
+	euau *usecase.EditUserAnswerUsecase,
+	ruau *usecase.RemoveUserAnswerUsecase,
+	ruiu *usecase.RemoveUserImageUsecase,
+	gru *usecase.GetRulesUsecase,
+	sru *usecase.SetRulesUsecase,
+	selu *usecase.SetEntryLimitsUsecase,
+	esu *usecase.ExportSessionUsecase,
+	ghu *usecase.GetHistoryUsecase,
+	gphu *usecase.GetPlayerHistoryUsecase,
+	gau *usecase.GetAnalyticsUsecase,
 ) *AdminServiceHandler {
 	return &AdminServiceHandler{
-		oeu:     oeu,
-		ceu:     ceu,
-		ruu:     ruu,
-		ctu:     ctu,
-		asqu:    asqu,
-		rqu:     rqu,
-		cau:     cau,
-		nqu:     nqu,
-		equ:     equ,
-		userNum: int32(userNum),
+		oeu:  oeu,
+		ceu:  ceu,
+		ruu:  ruu,
+		ctu:  ctu,
+		asqu: asqu,
+		rqu:  rqu,
+		cau:  cau,
+		nqu:  nqu,
+		equ:  equ,
+		lqu:  lqu,
+		cqu:  cqu,
+		uqu:  uqu,
+		dqu:  dqu,
+		roqu: roqu,
+		lfau: lfau,
+		euau: euau,
+		ruau: ruau,
+		ruiu: ruiu,
+		gru:  gru,
+		sru:  sru,
+		selu: selu,
+		esu:  esu,
+		ghu:  ghu,
+		gphu: gphu,
+		gau:  gau,
 	}
 }
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	adminv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1"
+)
+
+func TestFromProtoRules(t *testing.T) {
+	defaults := core.DefaultGameRules()
+	tests := []struct {
+		name    string
+		modify  func(msg *adminv1.GameRules)
+		wantErr bool
+	}{
+		{name: "round trip", modify: func(msg *adminv1.GameRules) {}},
+		{name: "missing result thresholds", modify: func(msg *adminv1.GameRules) { msg.ResultThresholds = nil }, wantErr: true},
+		{name: "missing awards", modify: func(msg *adminv1.GameRules) { msg.Awards = nil }, wantErr: true},
+		{name: "missing one award rule", modify: func(msg *adminv1.GameRules) { msg.Awards.ComebackTeam = nil }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := toProtoRules(defaults)
+			tt.modify(msg)
+			got, err := fromProtoRules(msg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fromProtoRules() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != defaults {
+				t.Errorf("fromProtoRules() = %+v, want %+v", got, defaults)
+			}
+		})
+	}
+}
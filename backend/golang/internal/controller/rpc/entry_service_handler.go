@@ -20,13 +20,14 @@ type EntryServiceHandler struct {
 func (esh *EntryServiceHandler) Entry(
 	_ context.Context, req *connect.Request[entryv1.EntryRequest],
 ) (*connect.Response[entryv1.EntryResponse], error) {
-	entryDto, err := esh.eu.Execute(req.Msg.UserName)
+	entryDto, err := esh.eu.Execute(req.Msg.UserName, req.Msg.PlayerKey)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	res := connect.NewResponse(&entryv1.EntryResponse{
 		AccessToken:  entryDto.AccessToken,
 		ReconnectKey: entryDto.ReconnectKey,
+		PlayerKey:    entryDto.PlayerKey,
 	})
 	return res, nil
 }
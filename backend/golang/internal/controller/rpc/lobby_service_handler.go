@@ -20,6 +20,20 @@ type LobbyServiceHandler struct {
 	rpu *usecase.RegistProfileUsecase
 	sru *usecase.SetReadyUsecase
 	gtu *usecase.GetTeamInfoUsecase
+	gnu *usecase.GetNextQuestionUsecase
+	lmu *usecase.ListMyProfileUsecase
+	upu *usecase.UpdateProfileAnswerUsecase
+}
+
+func toProtoMyProfileAnswer(answer usecase.MyProfileDTO) *lobbyv1.MyProfileAnswer {
+	return &lobbyv1.MyProfileAnswer{
+		QuestionId:   uint32(answer.QuestionID),
+		QuestionText: answer.QuestionText,
+		Answer:       answer.Answer,
+		IsAnswered:   answer.IsAnswered,
+		IsOptional:   answer.IsOptional,
+		IsSkipped:    answer.IsSkipped,
+	}
 }
 
 func (lsh *LobbyServiceHandler) JoinLobby(ctx context.Context, r *connect.Request[emptypb.Empty], stream *connect.ServerStream[lobbyv1.LobbyStatus]) error {
@@ -30,9 +44,10 @@ func (lsh *LobbyServiceHandler) JoinLobby(ctx context.Context, r *connect.Reques
 
 	return lsh.jlu.Execute(
 		ctx, user.GetUserID(),
-		func() error {
+		func(imageUploadRequested bool) error {
 			return stream.Send(&lobbyv1.LobbyStatus{
-				IsAllReady: false,
+				IsAllReady:           false,
+				ImageUploadRequested: imageUploadRequested,
 			})
 		},
 		func() {
@@ -55,7 +70,7 @@ func (lsh *LobbyServiceHandler) RegistProfile(ctx context.Context, r *connect.Re
 		UserID:    user.GetUserID(),
 		ProfileID: uint(r.Msg.QuestionId),
 		Answer:    r.Msg.Answer,
-	})
+	}, r.Msg.Skip)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
@@ -63,10 +78,67 @@ func (lsh *LobbyServiceHandler) RegistProfile(ctx context.Context, r *connect.Re
 		NextQuestionId:   uint32(nextQuestion.QuestionID),
 		NextQuestionText: nextQuestion.QuestionText,
 		NoMoreAnswer:     nextQuestion.NoMoreAnswer,
+		IsOptional:       nextQuestion.IsOptional,
+		AnsweredCount:    uint32(nextQuestion.AnsweredCount),
+		TotalCount:       uint32(nextQuestion.TotalCount),
 	})
 	return res, nil
 }
 
+func (lsh *LobbyServiceHandler) GetNextQuestion(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[lobbyv1.GetNextQuestionResponse], error) {
+	user := middleware.GetUserFromCtx(ctx)
+	if user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
+	}
+	nextQuestion, err := lsh.gnu.Execute(user)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, err)
+	}
+	return connect.NewResponse(&lobbyv1.GetNextQuestionResponse{
+		NextQuestionId:   uint32(nextQuestion.QuestionID),
+		NextQuestionText: nextQuestion.QuestionText,
+		NoMoreAnswer:     nextQuestion.NoMoreAnswer,
+		IsOptional:       nextQuestion.IsOptional,
+		AnsweredCount:    uint32(nextQuestion.AnsweredCount),
+		TotalCount:       uint32(nextQuestion.TotalCount),
+	}), nil
+}
+
+func (lsh *LobbyServiceHandler) ListMyProfile(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[lobbyv1.ListMyProfileResponse], error) {
+	user := middleware.GetUserFromCtx(ctx)
+	if user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
+	}
+	answers, isEditable, err := lsh.lmu.Execute(user)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnknown, err)
+	}
+	res := &lobbyv1.ListMyProfileResponse{
+		Answers:    make([]*lobbyv1.MyProfileAnswer, 0, len(answers)),
+		IsEditable: isEditable,
+	}
+	for _, answer := range answers {
+		res.Answers = append(res.Answers, toProtoMyProfileAnswer(answer))
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (lsh *LobbyServiceHandler) UpdateProfileAnswer(ctx context.Context, r *connect.Request[lobbyv1.UpdateProfileAnswerRequest]) (*connect.Response[lobbyv1.MyProfileAnswer], error) {
+	user := middleware.GetUserFromCtx(ctx)
+	if user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
+	}
+	answer, err := lsh.upu.Execute(usecase.UserProfileDTO{
+		UserID:    user.GetUserID(),
+		ProfileID: uint(r.Msg.QuestionId),
+		Answer:    r.Msg.Answer,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return connect.NewResponse(toProtoMyProfileAnswer(answer)), nil
+}
+
 func (lsh *LobbyServiceHandler) IsReady(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
 	user := middleware.GetUserFromCtx(ctx)
 	if user == nil {
@@ -90,14 +162,27 @@ func (lsh *LobbyServiceHandler) GetTeamInfo(ctx context.Context, r *connect.Requ
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	return connect.NewResponse(&lobbyv1.GetTeamInfoResponse{TeamId: tid, TeamColor: teamColor, Members: members}), nil
+	memberNames := make([]string, 0, len(members))
+	memberDetails := make([]*lobbyv1.TeamMember, 0, len(members))
+	for _, member := range members {
+		memberNames = append(memberNames, member.UserName)
+		memberDetails = append(memberDetails, &lobbyv1.TeamMember{
+			UserName: member.UserName,
+			ImageUrl: member.ImageURL,
+		})
+	}
+
+	return connect.NewResponse(&lobbyv1.GetTeamInfoResponse{TeamId: tid, TeamColor: teamColor, Members: memberNames, MemberDetails: memberDetails}), nil
 }
 
-func NewLobbyServiceHandler(jlu *usecase.JoinLobbyUsecase, rpu *usecase.RegistProfileUsecase, sru *usecase.SetReadyUsecase, gtu *usecase.GetTeamInfoUsecase) *LobbyServiceHandler {
+func NewLobbyServiceHandler(jlu *usecase.JoinLobbyUsecase, rpu *usecase.RegistProfileUsecase, sru *usecase.SetReadyUsecase, gtu *usecase.GetTeamInfoUsecase, gnu *usecase.GetNextQuestionUsecase, lmu *usecase.ListMyProfileUsecase, upu *usecase.UpdateProfileAnswerUsecase) *LobbyServiceHandler {
 	return &LobbyServiceHandler{
 		jlu: jlu,
 		rpu: rpu,
 		sru: sru,
 		gtu: gtu,
+		gnu: gnu,
+		lmu: lmu,
+		upu: upu,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"html"
+	"slices"
 
 	"connectrpc.com/connect"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/middleware"
@@ -11,6 +12,7 @@ import (
 	commonv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/common/v1"
 	questv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/quest/v1"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/quest/v1/questv1connect"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -21,6 +23,29 @@ type QuestServiceHandler struct {
 	au   *usecase.AnswerUsecase
 	thu  *usecase.TakeHintUsecase
 	gru  *usecase.GetResultUsecase
+	ulu  *usecase.UseLifelineUsecase
+	gpru *usecase.GetPersonalReportUsecase
+}
+
+// 選択肢のID-1番目にその選択肢の投票数を入れる
+// 長さはクイズの選択肢の数に揃え、50:50で消された選択肢は0のままにする
+func toAnswerCount(answerMap map[uint]int, choiceNum int) []int32 {
+	answerCount := make([]int32, choiceNum)
+	for cid, cnt := range answerMap {
+		if cid == 0 || int(cid) > choiceNum {
+			continue
+		}
+		answerCount[cid-1] = int32(cnt)
+	}
+	return answerCount
+}
+
+func toProtoLifelines(lifelines []core.Lifeline) []questv1.Lifeline {
+	res := make([]questv1.Lifeline, 0, len(lifelines))
+	for _, l := range lifelines {
+		res = append(res, questv1.Lifeline(l))
+	}
+	return res
 }
 
 func (qsh *QuestServiceHandler) StartQuest(ctx context.Context, r *connect.Request[emptypb.Empty], stream *connect.ServerStream[questv1.StartQuestResponse]) error {
@@ -41,13 +66,15 @@ func (qsh *QuestServiceHandler) StartQuest(ctx context.Context, r *connect.Reque
 				})
 			}
 			return stream.Send(&questv1.StartQuestResponse{
-				TargetUserImageId: quiz.ImageID,
-				TargetTeamId:      uint32(quiz.TeamID),
-				QuestionId:        uint32(quiz.QuestionID),
-				Question:          quiz.QuestionText,
-				Choices:           choices,
-				CanAnswer:         user.GetTeamID() != uint32(quiz.TeamID),
-				LastTime:          int32(quiz.RemainedTime),
+				TargetUserImageId:  quiz.ImageID,
+				TargetTeamId:       uint32(quiz.TeamID),
+				QuestionId:         uint32(quiz.QuestionID),
+				Question:           quiz.QuestionText,
+				Choices:            choices,
+				CanAnswer:          user.GetTeamID() != uint32(quiz.TeamID),
+				LastTime:           int32(quiz.RemainedTime),
+				AvailableLifelines: toProtoLifelines(quiz.AvailableLifelines),
+				TargetUserImageUrl: quiz.ImageURL,
 			})
 		},
 		func(err error) error {
@@ -65,25 +92,24 @@ func (qsh *QuestServiceHandler) Answer(ctx context.Context, r *connect.Request[q
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
 	}
 
-	teamAnswer, answerMap, err := qsh.au.Execute(user, usecase.AnswerDTO{
+	teamAnswer, answerWithMap, err := qsh.au.Execute(user, usecase.AnswerDTO{
 		ChoiceID:   uint(r.Msg.Answer.ChoiceId),
 		ChoiceText: r.Msg.Answer.ChoiceText,
 	})
+	if errors.Is(err, core.ErrInvalidChoice) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	answerCount := make([]int32, len(answerMap))
-	for cid, cnt := range answerMap {
-		answerCount[cid-1] = int32(cnt)
-	}
 	return connect.NewResponse(&questv1.AnswerResponse{
 		IsCorrect: teamAnswer.IsCorrect,
 		TeamAnswer: &commonv1.Choice{
 			ChoiceId:   uint32(teamAnswer.Answer.ChoiceID),
 			ChoiceText: teamAnswer.Answer.ChoiceText,
 		},
-		AnswerCount: answerCount,
+		AnswerCount: toAnswerCount(answerWithMap.AnswerMap, answerWithMap.ChoiceNum),
 	}), nil
 }
 
@@ -106,15 +132,118 @@ func (qsh *QuestServiceHandler) GetResult(ctx context.Context, r *connect.Reques
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
 	}
 
-	resultState, personalStats, teamStats, err := qsh.gru.Execute(user.GetUserID(), user.GetTeamID())
+	resultState, personalStats, teamStats, awards, err := qsh.gru.Execute(user.GetUserID(), user.GetTeamID())
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	myAwards := slices.DeleteFunc(slices.Clone(awards), func(a usecase.AwardDTO) bool {
+		return !a.IsFor(user.GetUserID(), core.TeamID(user.GetTeamID()))
+	})
 	return connect.NewResponse(&questv1.GetResultResponse{
 		Result:        commonv1.Result(resultState),
 		TeamOrder:     uint32(teamStats.Order),
 		PersonalOrder: uint32(personalStats.Order),
 		PersonalRate:  personalStats.CorrectRate,
+		Awards:        toProtoAwards(awards),
+		MyAwards:      toProtoAwards(myAwards),
+	}), nil
+}
+
+func (qsh *QuestServiceHandler) UseLifeline(ctx context.Context, r *connect.Request[questv1.UseLifelineRequest]) (*connect.Response[questv1.UseLifelineResponse], error) {
+	user := middleware.GetUserFromCtx(ctx)
+	if user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
+	}
+
+	result, err := qsh.ulu.Execute(user, uint(r.Msg.QuestionId), core.Lifeline(r.Msg.Lifeline))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	choices := make([]*commonv1.Choice, 0, len(result.Choices))
+	for _, c := range result.Choices {
+		choices = append(choices, &commonv1.Choice{
+			ChoiceId:   uint32(c.ChoiceID),
+			ChoiceText: c.ChoiceText,
+		})
+	}
+	return connect.NewResponse(&questv1.UseLifelineResponse{
+		Choices:            choices,
+		AnswerCount:        toAnswerCount(result.AnswerMap, result.ChoiceNum),
+		IsDoublePoints:     result.IsDoublePoints,
+		AvailableLifelines: toProtoLifelines(result.AvailableLifelines),
+	}), nil
+}
+
+func toProtoChoice(c core.Choice) *commonv1.Choice {
+	return &commonv1.Choice{
+		ChoiceId:   uint32(c.ChoiceID),
+		ChoiceText: c.ChoiceText,
+	}
+}
+
+func (qsh *QuestServiceHandler) GetPersonalReport(ctx context.Context, r *connect.Request[emptypb.Empty]) (*connect.Response[questv1.GetPersonalReportResponse], error) {
+	user := middleware.GetUserFromCtx(ctx)
+	if user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Unauthenticated Access"))
+	}
+
+	report, err := qsh.gpru.Execute(user.GetUserID())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	quizzesAboutMe := make([]*questv1.QuizAboutMe, 0, len(report.QuizzesAboutMe))
+	for _, q := range report.QuizzesAboutMe {
+		answers := make([]*questv1.OthersAnswer, 0, len(q.Answers))
+		for _, a := range q.Answers {
+			answers = append(answers, &questv1.OthersAnswer{
+				UserName:  a.UserName,
+				TeamId:    a.TeamID,
+				TeamColor: model.TeamColor(a.TeamID).String(),
+				Answer:    toProtoChoice(a.Answer),
+				IsCorrect: a.IsCorrect,
+			})
+		}
+		quizzesAboutMe = append(quizzesAboutMe, &questv1.QuizAboutMe{
+			Number:        uint32(q.Number),
+			Question:      q.Question,
+			CorrectChoice: toProtoChoice(q.CorrectChoice),
+			Hint:          q.Hint,
+			AnsweredCount: uint32(q.AnsweredCount),
+			CorrectCount:  uint32(q.CorrectCount),
+			Answers:       answers,
+		})
+	}
+	myAnswers := make([]*questv1.MyAnswer, 0, len(report.MyAnswers))
+	for _, a := range report.MyAnswers {
+		myAnswers = append(myAnswers, &questv1.MyAnswer{
+			Number:         uint32(a.Number),
+			TargetUserName: a.TargetUserName,
+			Question:       a.Question,
+			Answer:         toProtoChoice(a.Answer),
+			CorrectChoice:  toProtoChoice(a.CorrectChoice),
+			IsCorrect:      a.IsCorrect,
+			ResponseTimeMs: a.ResponseTime.Milliseconds(),
+		})
+	}
+	knownPeople := make([]*questv1.KnownPerson, 0, len(report.KnownPeople))
+	for _, p := range report.KnownPeople {
+		knownPeople = append(knownPeople, &questv1.KnownPerson{
+			UserName:      p.UserName,
+			TeamId:        p.TeamID,
+			TeamColor:     model.TeamColor(p.TeamID).String(),
+			AnsweredCount: uint32(p.AnsweredCount),
+			CorrectCount:  uint32(p.CorrectCount),
+			CorrectRate:   p.CorrectRate,
+		})
+	}
+	return connect.NewResponse(&questv1.GetPersonalReportResponse{
+		QuizzesAboutMe:        quizzesAboutMe,
+		MyAnswers:             myAnswers,
+		KnownPeople:           knownPeople,
+		AnsweredCount:         uint32(report.AnsweredCount),
+		CorrectCount:          uint32(report.CorrectCount),
+		AverageResponseTimeMs: report.AverageResponseTime.Milliseconds(),
 	}), nil
 }
 
@@ -123,11 +252,15 @@ func NewQuestServiceHandler(
 	au *usecase.AnswerUsecase,
 	thu *usecase.TakeHintUsecase,
 	gru *usecase.GetResultUsecase,
+	ulu *usecase.UseLifelineUsecase,
+	gpru *usecase.GetPersonalReportUsecase,
 ) *QuestServiceHandler {
 	return &QuestServiceHandler{
 		gsqu: gsqu,
 		au:   au,
 		thu:  thu,
 		gru:  gru,
+		ulu:  ulu,
+		gpru: gpru,
 	}
 }
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestToAnswerCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		answerMap map[uint]int
+		choiceNum int
+		want      []int32
+	}{
+		{name: "empty", answerMap: map[uint]int{}, choiceNum: 0, want: []int32{}},
+		{name: "all choices", answerMap: map[uint]int{1: 2, 2: 0, 3: 1}, choiceNum: 3, want: []int32{2, 0, 1}},
+		// 50:50で消された選択肢の分は0で埋める
+		{name: "missing choices", answerMap: map[uint]int{1: 1, 4: 3}, choiceNum: 4, want: []int32{1, 0, 0, 3}},
+		{name: "zero id is ignored", answerMap: map[uint]int{0: 5, 2: 1}, choiceNum: 2, want: []int32{0, 1}},
+		// どんなIDが来てもクイズの選択肢の数より長くしない
+		{name: "huge id is ignored", answerMap: map[uint]int{1: 1, 4294967295: 1}, choiceNum: 2, want: []int32{1, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := toAnswerCount(tt.answerMap, tt.choiceNum); !slices.Equal(got, tt.want) {
+				t.Errorf("toAnswerCount(%v, %d) = %v, want %v", tt.answerMap, tt.choiceNum, got, tt.want)
+			}
+		})
+	}
+}
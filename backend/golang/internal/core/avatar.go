@@ -0,0 +1,85 @@
+package core
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"image"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// 写真が無い参加者の画像IDの接頭辞
+// アップロードされた画像のIDは11文字なので、UUIDを含むこの形式と被ることは無い
+const AvatarImageIDPrefix string = "avatar_"
+
+// identiconの一辺のマス数、左右対称にするので奇数にする
+const avatarGridSize int = 5
+
+// チームが変わると色も変わるので、画像IDにチームIDを含めて別の画像として扱う
+func AvatarImageID(uid uuid.UUID, teamID uint32) string {
+	return fmt.Sprintf("%s%d_%s", AvatarImageIDPrefix, teamID, uid.String())
+}
+
+// AvatarImageIDで作った形そのままのIDだけを受け付ける
+// 大文字のUUIDや0埋めしたチームIDなど、同じ参加者を指す別の書き方で画像を作らせないため
+func ParseAvatarImageID(imageID string) (uuid.UUID, uint32, bool) {
+	rest, ok := strings.CutPrefix(imageID, AvatarImageIDPrefix)
+	if !ok {
+		return uuid.Nil, 0, false
+	}
+	teamIDStr, uidStr, ok := strings.Cut(rest, "_")
+	if !ok {
+		return uuid.Nil, 0, false
+	}
+	teamID, err := strconv.ParseUint(teamIDStr, 10, 32)
+	if err != nil {
+		return uuid.Nil, 0, false
+	}
+	uid, err := uuid.Parse(uidStr)
+	if err != nil {
+		return uuid.Nil, 0, false
+	}
+	if AvatarImageID(uid, uint32(teamID)) != imageID {
+		return uuid.Nil, 0, false
+	}
+	return uid, uint32(teamID), true
+}
+
+// ユーザIDから毎回同じ模様になるidenticonを、チームの色で描く
+func GenerateAvatar(uid uuid.UUID, foreground color.RGBA, size int) *image.RGBA {
+	// 白や黄色のチームでも模様が見えるように、明るい色の場合は背景を暗くする
+	background := color.RGBA{R: 0xF0, G: 0xF0, B: 0xF0, A: 0xFF}
+	if luminance := 299*int(foreground.R) + 587*int(foreground.G) + 114*int(foreground.B); luminance > 180_000 {
+		background = color.RGBA{R: 0x30, G: 0x30, B: 0x30, A: 0xFF}
+	}
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = background.R, background.G, background.B, background.A
+	}
+
+	hash := sha256.Sum256(uid[:])
+	// 周囲に半マス分の余白を取る
+	cell := size / (avatarGridSize + 1)
+	margin := (size - cell*avatarGridSize) / 2
+	half := (avatarGridSize + 1) / 2
+	for row := range avatarGridSize {
+		for col := range half {
+			bit := row*half + col
+			if hash[bit/8]&(1<<(bit%8)) == 0 {
+				continue
+			}
+			for _, c := range []int{col, avatarGridSize - 1 - col} {
+				rect := image.Rect(margin+c*cell, margin+row*cell, margin+(c+1)*cell, margin+(row+1)*cell)
+				for y := rect.Min.Y; y < rect.Max.Y; y++ {
+					for x := rect.Min.X; x < rect.Max.X; x++ {
+						img.SetRGBA(x, y, foreground)
+					}
+				}
+			}
+		}
+	}
+	return img
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseAvatarImageIDRoundTrip(t *testing.T) {
+	uid := uuid.New()
+	for _, teamID := range []uint32{0, 3} {
+		gotUID, gotTeamID, ok := ParseAvatarImageID(AvatarImageID(uid, teamID))
+		if !ok || gotUID != uid || gotTeamID != teamID {
+			t.Errorf("ParseAvatarImageID(AvatarImageID(%s, %d)) = (%s, %d, %v)", uid, teamID, gotUID, gotTeamID, ok)
+		}
+	}
+}
+
+// 同じ参加者を指していても、AvatarImageIDと違う書き方のIDは受け付けない
+func TestParseAvatarImageIDRejectsNonCanonical(t *testing.T) {
+	uid := uuid.MustParse("0f8fad5b-d9cb-469f-a165-70867728950e")
+	for _, imageID := range []string{
+		"avatar_1_" + strings.ToUpper(uid.String()),
+		"avatar_01_" + uid.String(),
+		"avatar_+1_" + uid.String(),
+		"avatar_1_{" + uid.String() + "}",
+		"avatar_1_urn:uuid:" + uid.String(),
+		"avatar_1_" + strings.ReplaceAll(uid.String(), "-", ""),
+		"avatar_1",
+		"photo_1_" + uid.String(),
+	} {
+		if _, _, ok := ParseAvatarImageID(imageID); ok {
+			t.Errorf("ParseAvatarImageID(%q) accepted a non-canonical ID", imageID)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package core
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResultStateMapperの評価とは別に、ゲームの記録から決める称号
+type AwardKind int
+
+const (
+	NoAward AwardKind = iota
+	// 正解した回答の平均回答時間が一番短い人
+	FastestAnswerer
+	// 出題対象として一番正解されなかった人
+	MostMysterious
+	// ヒントを出した後の回答の正解率が一番高い人
+	BestHintGiver
+	// 一番大きな点差をひっくり返して首位で終えたチーム
+	ComebackTeam
+	// 一番長く連続で正解した人
+	PerfectStreak
+)
+
+func (k AwardKind) String() string {
+	switch k {
+	case FastestAnswerer:
+		return "fastest_answerer"
+	case MostMysterious:
+		return "most_mysterious"
+	case BestHintGiver:
+		return "best_hint_giver"
+	case ComebackTeam:
+		return "comeback_team"
+	case PerfectStreak:
+		return "perfect_streak"
+	default:
+		return "none"
+	}
+}
+
+// 賞毎の設定
+// MinCountは賞によって意味が変わり、受賞に必要な回答数、点差、連続正解数になる
+type AwardRule struct {
+	Enabled  bool `yaml:"enabled" toml:"enabled" json:"enabled"`
+	MinCount int  `yaml:"min_count" toml:"min_count" json:"min_count"`
+}
+
+type AwardRules struct {
+	// 正解した回答の数
+	FastestAnswerer AwardRule `yaml:"fastest_answerer" toml:"fastest_answerer" json:"fastest_answerer"`
+	// 出題対象として受けた回答の数
+	MostMysterious AwardRule `yaml:"most_mysterious" toml:"most_mysterious" json:"most_mysterious"`
+	// ヒントを出した後に受けた回答の数
+	BestHintGiver AwardRule `yaml:"best_hint_giver" toml:"best_hint_giver" json:"best_hint_giver"`
+	// 首位から離された最大の点差
+	ComebackTeam AwardRule `yaml:"comeback_team" toml:"comeback_team" json:"comeback_team"`
+	// 連続正解数
+	PerfectStreak AwardRule `yaml:"perfect_streak" toml:"perfect_streak" json:"perfect_streak"`
+}
+
+func DefaultAwardRules() AwardRules {
+	return AwardRules{
+		FastestAnswerer: AwardRule{Enabled: true, MinCount: 3},
+		MostMysterious:  AwardRule{Enabled: true, MinCount: 3},
+		BestHintGiver:   AwardRule{Enabled: true, MinCount: 2},
+		ComebackTeam:    AwardRule{Enabled: true, MinCount: 2},
+		PerfectStreak:   AwardRule{Enabled: true, MinCount: 3},
+	}
+}
+
+type awardEvaluator struct {
+	kind     AwardKind
+	rule     AwardRule
+	evaluate func(log []QuizRecord, minCount int) []Award
+}
+
+// この順に評価して並べる
+func (r AwardRules) evaluators() []awardEvaluator {
+	return []awardEvaluator{
+		{FastestAnswerer, r.FastestAnswerer, evaluateFastestAnswerer},
+		{MostMysterious, r.MostMysterious, evaluateMostMysterious},
+		{BestHintGiver, r.BestHintGiver, evaluateBestHintGiver},
+		{ComebackTeam, r.ComebackTeam, evaluateComebackTeam},
+		{PerfectStreak, r.PerfectStreak, evaluatePerfectStreak},
+	}
+}
+
+func (r AwardRules) Validate() error {
+	errs := make([]error, 0)
+	for _, ae := range r.evaluators() {
+		if ae.rule.MinCount < 1 || ae.rule.MinCount > 100 {
+			errs = append(errs, fmt.Errorf("awards.%s.min_count must be between 1 and 100, but got %d", ae.kind, ae.rule.MinCount))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type Award struct {
+	Kind AwardKind
+	// 個人の賞の受賞者、チームの賞の場合はuuid.Nil
+	UserID uuid.UUID
+	TeamID TeamID
+	// 連続正解数、ひっくり返した点差、正解率の分母になった回答数のいずれか
+	Count int
+	// 最も謎な人とヒント名人の正解率
+	CorrectRate float32
+	// 最速回答者の平均回答時間
+	ResponseTime time.Duration
+}
+
+// 答え合わせまで終わったクイズの記録から、有効な賞を全て評価する
+// 同点の場合は全員が受賞し、条件を満たす人がいない賞は含めない
+func EvaluateAwards(log []QuizRecord, rules AwardRules) []Award {
+	checked := slices.DeleteFunc(slices.Clone(log), func(record QuizRecord) bool { return !record.IsChecked() })
+	awards := make([]Award, 0)
+	for _, ae := range rules.evaluators() {
+		if !ae.rule.Enabled {
+			continue
+		}
+		awards = append(awards, ae.evaluate(checked, ae.rule.MinCount)...)
+	}
+	return awards
+}
+
+// compareで比べて最も良いものを全て選ぶ、記録に出てきた順を保つ
+func bestBy[T any](items []T, compare func(a, b T) int) []T {
+	best := make([]T, 0)
+	for _, item := range items {
+		if len(best) == 0 {
+			best = append(best, item)
+			continue
+		}
+		switch c := compare(item, best[0]); {
+		case c > 0:
+			best = append(best[:0], item)
+		case c == 0:
+			best = append(best, item)
+		}
+	}
+	return best
+}
+
+func evaluateFastestAnswerer(log []QuizRecord, minCount int) []Award {
+	candidates := make([]Award, 0)
+	total := make(map[uuid.UUID]time.Duration)
+	for _, record := range log {
+		for _, answer := range record.Answers {
+			if !answer.IsCorrect {
+				continue
+			}
+			idx := slices.IndexFunc(candidates, func(a Award) bool { return a.UserID == answer.UserID })
+			if idx < 0 {
+				candidates = append(candidates, Award{Kind: FastestAnswerer, UserID: answer.UserID, TeamID: answer.TeamID})
+				idx = len(candidates) - 1
+			}
+			candidates[idx].Count++
+			total[answer.UserID] += answer.ResponseTime
+		}
+	}
+	candidates = slices.DeleteFunc(candidates, func(a Award) bool { return a.Count < minCount })
+	for i := range candidates {
+		candidates[i].ResponseTime = total[candidates[i].UserID] / time.Duration(candidates[i].Count)
+	}
+	return bestBy(candidates, func(a, b Award) int {
+		return cmp.Compare(b.ResponseTime, a.ResponseTime)
+	})
+}
+
+func evaluateMostMysterious(log []QuizRecord, minCount int) []Award {
+	candidates := make([]Award, 0)
+	for _, ts := range AnalyzeRelationships(log).Targets {
+		if ts.AnsweredCount < minCount {
+			continue
+		}
+		idx := slices.IndexFunc(log, func(record QuizRecord) bool { return record.TargetUserID == ts.TargetID })
+		candidates = append(candidates, Award{
+			Kind:        MostMysterious,
+			UserID:      ts.TargetID,
+			TeamID:      log[idx].TargetTeamID,
+			Count:       ts.AnsweredCount,
+			CorrectRate: ts.CorrectRate(),
+		})
+	}
+	return bestBy(candidates, func(a, b Award) int {
+		return cmp.Compare(b.CorrectRate, a.CorrectRate)
+	})
+}
+
+// ヒントが出る前の回答は数えない
+func evaluateBestHintGiver(log []QuizRecord, minCount int) []Award {
+	candidates := make([]Award, 0)
+	correct := make(map[uuid.UUID]int)
+	for _, record := range log {
+		if !record.HasHint() {
+			continue
+		}
+		idx := slices.IndexFunc(candidates, func(a Award) bool { return a.UserID == record.TargetUserID })
+		if idx < 0 {
+			candidates = append(candidates, Award{Kind: BestHintGiver, UserID: record.TargetUserID, TeamID: record.TargetTeamID})
+			idx = len(candidates) - 1
+		}
+		for _, answer := range record.Answers {
+			if answer.UserID == record.TargetUserID || answer.AnsweredAt.Before(record.HintTakenAt) {
+				continue
+			}
+			candidates[idx].Count++
+			if answer.IsCorrect {
+				correct[record.TargetUserID]++
+			}
+		}
+	}
+	candidates = slices.DeleteFunc(candidates, func(a Award) bool { return a.Count < minCount })
+	for i := range candidates {
+		candidates[i].CorrectRate = correctRate(correct[candidates[i].UserID], candidates[i].Count)
+	}
+	return bestBy(candidates, func(a, b Award) int {
+		return cmp.Compare(a.CorrectRate, b.CorrectRate)
+	})
+}
+
+// 首位で終えたチームのうち、途中で首位から最も離されていたチーム
+func evaluateComebackTeam(log []QuizRecord, minCount int) []Award {
+	// 出題対象のチームは答えられないので、まだ答えていないチームも0点として比べる
+	points := make(map[TeamID]int)
+	deficits := make(map[TeamID]int)
+	for _, record := range log {
+		for _, ta := range record.TeamAnswers {
+			points[ta.TeamID] = 0
+		}
+	}
+	if len(points) == 0 {
+		return []Award{}
+	}
+	for _, record := range log {
+		for _, ta := range record.TeamAnswers {
+			gained := 0
+			if ta.IsCorrect {
+				gained = 1
+				if ta.IsDoublePoints {
+					gained = 2
+				}
+			}
+			points[ta.TeamID] += gained
+		}
+		leader := slices.Max(slices.Collect(maps.Values(points)))
+		for tid, p := range points {
+			deficits[tid] = max(deficits[tid], leader-p)
+		}
+	}
+	leader := slices.Max(slices.Collect(maps.Values(points)))
+	candidates := make([]Award, 0)
+	for _, tid := range slices.Sorted(maps.Keys(points)) {
+		if points[tid] != leader || deficits[tid] < minCount {
+			continue
+		}
+		candidates = append(candidates, Award{Kind: ComebackTeam, TeamID: tid, Count: deficits[tid]})
+	}
+	return bestBy(candidates, func(a, b Award) int {
+		return a.Count - b.Count
+	})
+}
+
+// 回答しなかったクイズは途切れたとみなさない、自分のチームについてのクイズには答えられないので
+func evaluatePerfectStreak(log []QuizRecord, minCount int) []Award {
+	candidates := make([]Award, 0)
+	streaks := make(map[uuid.UUID]int)
+	for _, record := range log {
+		for _, answer := range record.Answers {
+			idx := slices.IndexFunc(candidates, func(a Award) bool { return a.UserID == answer.UserID })
+			if idx < 0 {
+				candidates = append(candidates, Award{Kind: PerfectStreak, UserID: answer.UserID, TeamID: answer.TeamID})
+				idx = len(candidates) - 1
+			}
+			if !answer.IsCorrect {
+				streaks[answer.UserID] = 0
+				continue
+			}
+			streaks[answer.UserID]++
+			candidates[idx].Count = max(candidates[idx].Count, streaks[answer.UserID])
+		}
+	}
+	candidates = slices.DeleteFunc(candidates, func(a Award) bool { return a.Count < minCount })
+	return bestBy(candidates, func(a, b Award) int {
+		return a.Count - b.Count
+	})
+}
@@ -0,0 +1,193 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// kindの賞だけをminCountで有効にする
+func onlyAward(kind AwardKind, minCount int) AwardRules {
+	var rules AwardRules
+	rule := AwardRule{Enabled: true, MinCount: minCount}
+	switch kind {
+	case FastestAnswerer:
+		rules.FastestAnswerer = rule
+	case MostMysterious:
+		rules.MostMysterious = rule
+	case BestHintGiver:
+		rules.BestHintGiver = rule
+	case ComebackTeam:
+		rules.ComebackTeam = rule
+	case PerfectStreak:
+		rules.PerfectStreak = rule
+	}
+	return rules
+}
+
+// 賞が一つだけ選ばれたことを確かめて返す
+func singleAward(t *testing.T, awards []Award) Award {
+	t.Helper()
+	if len(awards) != 1 {
+		t.Fatalf("EvaluateAwards() = %+v, want exactly one award", awards)
+	}
+	return awards[0]
+}
+
+// 平均は正解した回答だけで出し、不正解の速い回答は数えない
+func TestEvaluateAwardsFastestAnswerer(t *testing.T) {
+	log := []QuizRecord{
+		checkedQuiz(userB, 1, answerOf(userA, true, time.Second), answerOf(userC, true, time.Second), answerOf(userD, false, 100*time.Millisecond)),
+		checkedQuiz(userD, 2, answerOf(userA, true, 3*time.Second), answerOf(userC, true, time.Second), answerOf(userB, true, 500*time.Millisecond)),
+	}
+	got := singleAward(t, EvaluateAwards(log, onlyAward(FastestAnswerer, 2)))
+	if got.UserID != userC || got.TeamID != 2 || got.Count != 2 || got.ResponseTime != time.Second {
+		t.Errorf("FastestAnswerer = %+v, want C with two answers in 1s", got)
+	}
+}
+
+// 同点なら両方が受賞する
+func TestEvaluateAwardsFastestAnswererTie(t *testing.T) {
+	log := []QuizRecord{
+		checkedQuiz(userB, 1, answerOf(userA, true, time.Second), answerOf(userC, true, 2*time.Second)),
+		checkedQuiz(userD, 2, answerOf(userA, true, 2*time.Second), answerOf(userC, true, time.Second)),
+	}
+	awards := EvaluateAwards(log, onlyAward(FastestAnswerer, 2))
+	if len(awards) != 2 || awards[0].UserID != userA || awards[1].UserID != userC {
+		t.Fatalf("EvaluateAwards() = %+v, want A and C to share the award", awards)
+	}
+	for _, a := range awards {
+		if a.ResponseTime != 1500*time.Millisecond {
+			t.Errorf("%v ResponseTime = %v, want 1.5s", a.UserID, a.ResponseTime)
+		}
+	}
+}
+
+func TestEvaluateAwardsMostMysterious(t *testing.T) {
+	log := []QuizRecord{
+		checkedQuiz(userA, 1, answerOf(userC, true, time.Second), answerOf(userD, false, time.Second)),
+		// 自分の回答は数えないので、Cさんは２回とも外された
+		checkedQuiz(userC, 2, answerOf(userA, false, time.Second), answerOf(userB, false, time.Second), answerOf(userC, true, time.Second)),
+		// 回答が足りないので候補にならない
+		checkedQuiz(userB, 3, answerOf(userD, false, time.Second)),
+	}
+	got := singleAward(t, EvaluateAwards(log, onlyAward(MostMysterious, 2)))
+	if got.UserID != userC || got.Count != 2 || got.CorrectRate != 0 {
+		t.Errorf("MostMysterious = %+v, want C never guessed in two answers", got)
+	}
+}
+
+func TestEvaluateAwardsBestHintGiver(t *testing.T) {
+	hinted := func(target uuid.UUID, answers ...AnswerRecord) QuizRecord {
+		record := checkedQuiz(target, 1, answers...)
+		record.Hint = "hint"
+		record.HintTakenAt = testStartedAt.Add(5 * time.Second)
+		return record
+	}
+	log := []QuizRecord{
+		// ヒントの前に外した回答は数えない
+		hinted(userA, answerOf(userC, false, time.Second), answerOf(userD, true, 6*time.Second)),
+		hinted(userA, answerOf(userC, true, 6*time.Second)),
+		hinted(userC, answerOf(userA, false, 6*time.Second), answerOf(userB, true, 6*time.Second)),
+		// ヒントの無いクイズはいくら正解されても関係無い
+		checkedQuiz(userD, 1, answerOf(userA, true, time.Second), answerOf(userB, true, time.Second)),
+	}
+	got := singleAward(t, EvaluateAwards(log, onlyAward(BestHintGiver, 2)))
+	if got.UserID != userA || got.TeamID != 1 || got.Count != 2 || got.CorrectRate != 1 {
+		t.Errorf("BestHintGiver = %+v, want A with both answers after the hint correct", got)
+	}
+}
+
+func TestEvaluateAwardsComebackTeam(t *testing.T) {
+	// チーム1、チーム2の順に正解したかと２倍かを並べたクイズ
+	teamQuiz := func(team1, team1Double, team2, team2Double bool) QuizRecord {
+		record := checkedQuiz(userA, 1)
+		record.TeamAnswers = []TeamAnswerRecord{
+			{TeamID: 1, IsCorrect: team1, IsDoublePoints: team1Double},
+			{TeamID: 2, IsCorrect: team2, IsDoublePoints: team2Double},
+		}
+		return record
+	}
+	// チーム2が２倍で２点差を付けたが、チーム1が３点取って逆転した
+	comeback := []QuizRecord{
+		teamQuiz(false, false, true, true),
+		teamQuiz(true, false, false, false),
+		teamQuiz(true, true, false, false),
+	}
+	got := singleAward(t, EvaluateAwards(comeback, onlyAward(ComebackTeam, 2)))
+	if got.TeamID != 1 || got.UserID != uuid.Nil || got.Count != 2 {
+		t.Errorf("ComebackTeam = %+v, want team 1 after being two points behind", got)
+	}
+	if awards := EvaluateAwards(comeback, onlyAward(ComebackTeam, 3)); len(awards) != 0 {
+		t.Errorf("EvaluateAwards() with min_count 3 = %+v, want no award", awards)
+	}
+
+	wireToWire := []QuizRecord{teamQuiz(true, false, false, false), teamQuiz(true, false, true, false)}
+	if awards := EvaluateAwards(wireToWire, onlyAward(ComebackTeam, 1)); len(awards) != 0 {
+		t.Errorf("EvaluateAwards() for a team leading from the start = %+v, want no award", awards)
+	}
+}
+
+// 自分のチームについてのクイズで回答しなくても、連続正解は途切れない
+func TestEvaluateAwardsPerfectStreak(t *testing.T) {
+	log := []QuizRecord{
+		checkedQuiz(userB, 1, answerOf(userA, true, time.Second), answerOf(userC, true, time.Second)),
+		checkedQuiz(userD, 1, answerOf(userA, true, time.Second)),
+		checkedQuiz(userB, 2, answerOf(userA, false, time.Second), answerOf(userC, true, time.Second)),
+		checkedQuiz(userD, 2, answerOf(userA, true, time.Second), answerOf(userC, true, time.Second)),
+	}
+	got := singleAward(t, EvaluateAwards(log, onlyAward(PerfectStreak, 3)))
+	if got.UserID != userC || got.Count != 3 {
+		t.Errorf("PerfectStreak = %+v, want C with three in a row", got)
+	}
+}
+
+// 答え合わせ前のクイズと無効な賞は評価しない、賞は決まった順に並ぶ
+func TestEvaluateAwardsSkipsUncheckedAndDisabled(t *testing.T) {
+	unchecked := checkedQuiz(userC, 1, answerOf(userB, true, time.Millisecond), answerOf(userB, true, time.Millisecond))
+	unchecked.CheckedAt = time.Time{}
+	if awards := EvaluateAwards([]QuizRecord{unchecked}, DefaultAwardRules()); len(awards) != 0 {
+		t.Errorf("EvaluateAwards() of an unchecked quiz = %+v, want no award", awards)
+	}
+
+	log := []QuizRecord{
+		checkedQuiz(userB, 1, answerOf(userA, true, time.Second)),
+		checkedQuiz(userC, 2, answerOf(userA, true, time.Second)),
+	}
+	if awards := EvaluateAwards(log, AwardRules{}); len(awards) != 0 {
+		t.Errorf("EvaluateAwards() with every award disabled = %+v, want none", awards)
+	}
+	rules := AwardRules{
+		FastestAnswerer: AwardRule{Enabled: true, MinCount: 1},
+		PerfectStreak:   AwardRule{Enabled: true, MinCount: 1},
+		MostMysterious:  AwardRule{Enabled: true, MinCount: 1},
+	}
+	awards := EvaluateAwards(log, rules)
+	kinds := make([]string, 0, len(awards))
+	for _, a := range awards {
+		kinds = append(kinds, a.Kind.String())
+	}
+	if got := strings.Join(kinds, ","); got != "fastest_answerer,most_mysterious,most_mysterious,perfect_streak" {
+		t.Errorf("award kinds = %s, want them in the evaluation order", got)
+	}
+}
+
+func TestAwardRulesValidate(t *testing.T) {
+	if err := DefaultAwardRules().Validate(); err != nil {
+		t.Errorf("DefaultAwardRules().Validate() error = %v", err)
+	}
+	rules := DefaultAwardRules()
+	rules.FastestAnswerer.MinCount = 0
+	rules.ComebackTeam.MinCount = 101
+	err := rules.Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want error")
+	}
+	for _, key := range []string{"awards.fastest_answerer.min_count", "awards.comeback_team.min_count"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("Validate() error = %v, want it to mention %s", err, key)
+		}
+	}
+}
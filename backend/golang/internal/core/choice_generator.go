@@ -0,0 +1,109 @@
+package core
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
+)
+
+// 正規化後の文字数がこの数増える毎に、１文字分の表記揺れを同じ回答とみなす
+// 短い回答は１文字違いでも全く別の意味になりやすいので、４文字以下は完全一致のみ
+const NearDuplicateRunesPerEdit int = 5
+
+// 全角/半角、前後や連続する空白、大文字/小文字の違いを吸収する
+func NormalizeAnswer(answer string) string {
+	normalized := norm.NFKC.String(answer)
+	normalized = strings.ToLower(normalized)
+	return strings.Join(strings.Fields(normalized), " ")
+}
+
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// 正規化済みの回答同士が同じ回答の表記揺れとみなせるか
+func isNearDuplicate(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ra, rb := []rune(a), []rune(b)
+	threshold := min(len(ra), len(rb)) / NearDuplicateRunesPerEdit
+	if threshold == 0 {
+		return false
+	}
+	return editDistance(ra, rb) <= threshold
+}
+
+type ChoiceGenerator struct {
+	maxChoiceNum int
+}
+
+// candidateGroupsは優先度の高い順に並べる
+// 各グループ内からはランダムに、正答や既に選ばれた選択肢と重複しないものを選択肢数の上限まで補充する
+func (cg *ChoiceGenerator) Generate(correct string, candidateGroups ...[]string) (choices []Choice, correctChoice Choice) {
+	correct = strings.TrimSpace(correct)
+	texts := make([]string, 1, cg.maxChoiceNum)
+	normalizedTexts := make([]string, 1, cg.maxChoiceNum)
+	texts[0] = correct
+	normalizedTexts[0] = NormalizeAnswer(correct)
+fillLoop:
+	for _, candidates := range candidateGroups {
+		for _, candidate := range util.ShuffleSlice(candidates) {
+			if len(texts) >= cg.maxChoiceNum {
+				break fillLoop
+			}
+			normalized := NormalizeAnswer(candidate)
+			if normalized == "" {
+				continue
+			}
+			isDuplicated := false
+			for _, picked := range normalizedTexts {
+				if isNearDuplicate(picked, normalized) {
+					isDuplicated = true
+					break
+				}
+			}
+			if isDuplicated {
+				continue
+			}
+			texts = append(texts, strings.TrimSpace(candidate))
+			normalizedTexts = append(normalizedTexts, normalized)
+		}
+	}
+
+	choices = make([]Choice, len(texts))
+	for i, text := range util.ShuffleSlice(texts) {
+		choices[i] = Choice{
+			ChoiceID:   uint(i + 1),
+			ChoiceText: text,
+		}
+		if text == correct {
+			correctChoice = choices[i]
+		}
+	}
+	return choices, correctChoice
+}
+
+func NewChoiceGenerator(maxChoiceNum int) *ChoiceGenerator {
+	return &ChoiceGenerator{
+		maxChoiceNum: maxChoiceNum,
+	}
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeAnswer(t *testing.T) {
+	tests := map[string]string{
+		"SuShi":           "sushi",
+		"ＳＵＳＨＩ":           "sushi",
+		"ｽｼ":              "スシ",
+		"  ice   cream\t": "ice cream",
+		"アイス　クリーム":        "アイス クリーム",
+	}
+	for answer, want := range tests {
+		if got := NormalizeAnswer(answer); got != want {
+			t.Errorf("NormalizeAnswer(%q) = %q, want %q", answer, got, want)
+		}
+	}
+}
+
+// 短い回答は１文字違いでも別の回答、長い回答は５文字毎に１文字の違いまで同じ回答とみなす
+func TestIsNearDuplicate(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"cat", "cat", true},
+		{"cake", "bake", false},
+		{"apple", "appla", true},
+		{"apple", "apxlx", false},
+		{"strawberry", "strxwberrx", true},
+		{"strawberry", "strxwbxrrx", false},
+		{"チョコレート", "チョコレイト", true},
+		{"basketball", "volleyball", false},
+	}
+	for _, tt := range tests {
+		if got := isNearDuplicate(tt.a, tt.b); got != tt.want {
+			t.Errorf("isNearDuplicate(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func choiceTexts(choices []Choice) []string {
+	texts := make([]string, 0, len(choices))
+	for _, c := range choices {
+		texts = append(texts, c.ChoiceText)
+	}
+	return texts
+}
+
+func TestChoiceGeneratorGenerate(t *testing.T) {
+	cg := NewChoiceGenerator(4)
+	// 選び方はランダムなので何度か試す
+	for range 20 {
+		choices, correct := cg.Generate(" Sushi ",
+			[]string{"SUSHI", "ｓｕｓｈｉ", "ramen", "", "  "},
+			[]string{"curry", "Ramen", "pizza", "udon", "soba"},
+		)
+		if len(choices) != 4 {
+			t.Fatalf("Generate() returned %d choices, want 4: %v", len(choices), choiceTexts(choices))
+		}
+		if correct.ChoiceText != "Sushi" || !slices.Contains(choices, correct) {
+			t.Fatalf("correct choice = %+v, want the trimmed answer among %v", correct, choiceTexts(choices))
+		}
+		normalized := make([]string, 0, len(choices))
+		for i, c := range choices {
+			if c.ChoiceID != uint(i+1) {
+				t.Errorf("choices[%d].ChoiceID = %d, want %d", i, c.ChoiceID, i+1)
+			}
+			n := NormalizeAnswer(c.ChoiceText)
+			if n == "" || slices.Contains(normalized, n) {
+				t.Errorf("choices %v contain an empty or duplicated answer", choiceTexts(choices))
+			}
+			normalized = append(normalized, n)
+		}
+		// 優先度の高いグループで残ったramenは必ず選ばれる
+		if !slices.Contains(normalized, "ramen") {
+			t.Errorf("choices %v do not contain the candidate from the first group", choiceTexts(choices))
+		}
+	}
+}
+
+// 候補が足りない場合は選べた分だけ返す
+func TestChoiceGeneratorGenerateWithFewCandidates(t *testing.T) {
+	choices, correct := NewChoiceGenerator(4).Generate("sushi", []string{"Sushi", "pizza"})
+	if len(choices) != 2 {
+		t.Fatalf("Generate() = %v, want the answer and one candidate", choiceTexts(choices))
+	}
+	if correct.ChoiceText != "sushi" {
+		t.Errorf("correct choice = %+v, want sushi", correct)
+	}
+}
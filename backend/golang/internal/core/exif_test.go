@@ -0,0 +1,104 @@
+package core
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// Orientationのエントリだけを持つTIFFを作る
+func tiffWithOrientation(order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12+4)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], exifOrientationTag)
+	// SHORT型で値は１つ
+	order.PutUint16(tiff[12:], 3)
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], orientation)
+	return tiff
+}
+
+func jpegSegment(marker byte, payload []byte) []byte {
+	segment := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+func jpegWith(segments ...[]byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	for _, s := range segments {
+		data = append(data, s...)
+	}
+	return append(data, 0xFF, 0xD9)
+}
+
+func exifSegment(tiff []byte) []byte {
+	return jpegSegment(0xE1, append([]byte("Exif\x00\x00"), tiff...))
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "little endian", data: jpegWith(exifSegment(tiffWithOrientation(binary.LittleEndian, 6))), want: 6},
+		{name: "big endian", data: jpegWith(exifSegment(tiffWithOrientation(binary.BigEndian, 8))), want: 8},
+		{name: "after other segments", data: jpegWith(
+			jpegSegment(0xE0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")),
+			exifSegment(tiffWithOrientation(binary.BigEndian, 3)),
+		), want: 3},
+		{name: "no exif", data: jpegWith(jpegSegment(0xE0, []byte("JFIF\x00"))), want: 1},
+		{name: "exif after image data", data: jpegWith(
+			jpegSegment(0xDA, []byte{0}),
+			exifSegment(tiffWithOrientation(binary.BigEndian, 6)),
+		), want: 1},
+		{name: "app1 that is not exif", data: jpegWith(jpegSegment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00"))), want: 1},
+		{name: "orientation out of range", data: jpegWith(exifSegment(tiffWithOrientation(binary.LittleEndian, 9))), want: 1},
+		{name: "broken tiff header", data: jpegWith(exifSegment([]byte("XX\x00\x2a\x00\x00\x00\x08"))), want: 1},
+		{name: "truncated segment", data: jpegWith(exifSegment(tiffWithOrientation(binary.BigEndian, 6)))[:20], want: 1},
+		{name: "png", data: []byte("\x89PNG\r\n\x1a\n"), want: 1},
+		{name: "empty", data: nil, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 3x2の画像の左上だけに印を付けて、向きを直した後にどの角へ移るかを見る
+	marker := color.RGBA{R: 255, A: 255}
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	src.SetRGBA(0, 0, marker)
+
+	wantMarker := map[int]image.Point{
+		1: {0, 0}, 2: {2, 0}, 3: {2, 1}, 4: {0, 1},
+		5: {0, 0}, 6: {1, 0}, 7: {1, 2}, 8: {0, 2},
+	}
+	for orientation, want := range wantMarker {
+		dst := applyOrientation(src, orientation)
+		wantSize := image.Pt(3, 2)
+		if orientationSwapsAxes(orientation) {
+			wantSize = image.Pt(2, 3)
+		}
+		if dst.Bounds().Size() != wantSize {
+			t.Errorf("orientation %d: size = %v, want %v", orientation, dst.Bounds().Size(), wantSize)
+			continue
+		}
+		if dst.RGBAAt(want.X, want.Y) != marker {
+			t.Errorf("orientation %d: marker is not at %v", orientation, want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
 	"maps"
 	"math"
 	"slices"
@@ -43,23 +44,68 @@ type Choice struct {
 type AnswerWithMap struct {
 	TeamAnswer Choice
 	AnswerMap  map[uint]int
+	// 出題したクイズの選択肢の数、50:50で消された選択肢も含む
+	ChoiceNum int
 }
 
 type Quiz struct {
-	ImageID      string
-	TeamID       TeamID
-	QuestionID   uint
-	QuestionText string
-	Choices      []Choice
-	RemainedTime int
+	ImageID string
+	// ImageIDの画像を認証ヘッダ無しで取得できる署名付きURL
+	ImageURL           string
+	TeamID             TeamID
+	QuestionID         uint
+	QuestionText       string
+	Choices            []Choice
+	RemainedTime       int
+	AvailableLifelines []Lifeline
 }
 
+type Lifeline int
+
+const (
+	NoLifeline Lifeline = iota
+	FiftyFifty
+	AskTheAudience
+	DoublePoints
+)
+
+var AllLifelines []Lifeline = []Lifeline{FiftyFifty, AskTheAudience, DoublePoints}
+
+var ErrInvalidChoice = errors.New("The choice is not in the current quiz")
+
+var ErrEntryOpened = errors.New("Entry has already been opened")
+
+func (l Lifeline) String() string {
+	switch l {
+	case FiftyFifty:
+		return "fifty_fifty"
+	case AskTheAudience:
+		return "ask_the_audience"
+	case DoublePoints:
+		return "double_points"
+	default:
+		return "none"
+	}
+}
+
+type LifelineResult struct {
+	Choices            []Choice
+	AnswerMap          map[uint]int
+	IsDoublePoints     bool
+	AvailableLifelines []Lifeline
+	// 出題したクイズの選択肢の数、50:50で消された選択肢も含む
+	ChoiceNum int
+}
+
+// MaxChoiceNum〜WaitAnswerTimeoutはGameRulesの既定値
 const (
 	MaxChoiceNum          int           = 4
 	MaxHintLength         int           = 30
 	InitialRemaindTime    int           = 15
 	IncreaseTimeHintTaken int           = 10
 	WaitAnswerTimeout     time.Duration = 3 * time.Second
+	LifelineUsesPerGame   int           = 1
+	FiftyFiftyRemoveNum   int           = 2
 )
 
 type questRoom struct {
@@ -76,16 +122,70 @@ type questRoom struct {
 	doneNotifier       context.CancelFunc
 	currentTarget      uuid.UUID
 	currentAnswer      Choice
+	currentQuiz        Quiz
 	quizCount          int
 	teamStats          map[TeamID]int
+	teamPoints         map[TeamID]int
 	personalStats      map[uuid.UUID]int
-}
-
-func (qr *questRoom) SetCurrent(target uuid.UUID, answer Choice) {
+	userTeams          map[uuid.UUID]TeamID
+	lifelines          map[TeamID]map[Lifeline]int
+	removedChoices     map[TeamID][]uint
+	doublePoints       map[TeamID]bool
+	votes              map[uuid.UUID]Choice
+	// クイズ毎に各チームが使ったライフライン
+	usedLifelines map[TeamID][]Lifeline
+	// 出題と回答の記録、ゲーム終了後の集計や書き出しに使う
+	log   []QuizRecord
+	rules GameRules
+}
+
+func (qr *questRoom) SetCurrent(target uuid.UUID, quiz Quiz, answer Choice) {
 	qr.mu.Lock()
 	defer qr.mu.Unlock()
+	if qr.currentTarget != target || qr.currentQuiz.QuestionID != quiz.QuestionID {
+		// 出題が切り替わったので、クイズ毎のライフラインの効果と投票状況をリセット
+		qr.removedChoices = make(map[TeamID][]uint, len(qr.teams))
+		qr.doublePoints = make(map[TeamID]bool, len(qr.teams))
+		qr.votes = make(map[uuid.UUID]Choice, len(qr.userTeams))
+		qr.startRecord(target, quiz, answer)
+	}
 	qr.currentTarget = target
 	qr.currentAnswer = answer
+	qr.currentQuiz = quiz
+}
+
+func (qr *questRoom) availableLifelines(tid TeamID) []Lifeline {
+	available := make([]Lifeline, 0, len(AllLifelines))
+	for _, l := range AllLifelines {
+		if qr.lifelines[tid][l] > 0 {
+			available = append(available, l)
+		}
+	}
+	return available
+}
+
+// チーム毎にライフラインの効果を反映したクイズを作る
+func (qr *questRoom) quizForTeam(quiz Quiz, tid TeamID) Quiz {
+	quiz.AvailableLifelines = qr.availableLifelines(tid)
+	removed := qr.removedChoices[tid]
+	if len(removed) == 0 {
+		return quiz
+	}
+	choices := make([]Choice, 0, len(quiz.Choices))
+	for _, c := range quiz.Choices {
+		if !slices.Contains(removed, c.ChoiceID) {
+			choices = append(choices, c)
+		}
+	}
+	quiz.Choices = choices
+	return quiz
+}
+
+// チームに配信した選択肢か、50:50で消された選択肢は含まない
+func (qr *questRoom) HasChoice(tid TeamID, choiceID uint) bool {
+	qr.mu.RLock()
+	defer qr.mu.RUnlock()
+	return slices.ContainsFunc(qr.quizForTeam(qr.currentQuiz, tid).Choices, func(c Choice) bool { return c.ChoiceID == choiceID })
 }
 
 func (qr *questRoom) GetConnectedUsers() []uuid.UUID {
@@ -98,20 +198,20 @@ func (qr *questRoom) GetConnectedUsers() []uuid.UUID {
 func (qr *questRoom) PublishQuiz(quiz Quiz) {
 	qr.mu.RLock()
 	defer qr.mu.RUnlock()
-	for _, con := range qr.conn {
+	for uid, con := range qr.conn {
 		if con == nil {
 			continue
 		}
-		go func(ch chan<- Quiz) {
+		go func(ch chan<- Quiz, q Quiz) {
 			select {
-			case ch <- quiz:
+			case ch <- q:
 				return
 			case <-time.After(time.Second):
 				return
 			case <-qr.ctx.Done():
 				return
 			}
-		}(con)
+		}(con, qr.quizForTeam(quiz, qr.userTeams[uid]))
 	}
 }
 
@@ -123,7 +223,7 @@ func (qr *questRoom) CollectAnswer() (map[TeamID]Choice, map[TeamID]map[uint]int
 	for tid := range qr.teams {
 		reporters[tid] = make(chan Choice, len(qr.teams[tid]))
 		wg.Go(func() {
-			timer := time.NewTimer(WaitAnswerTimeout)
+			timer := time.NewTimer(qr.rules.AnswerTimeout)
 			defer timer.Stop()
 			defer close(reporters[tid])
 			for {
@@ -152,16 +252,20 @@ func (qr *questRoom) CollectAnswer() (map[TeamID]Choice, map[TeamID]map[uint]int
 	for tid, answers := range reporters {
 		wg.Go(func() {
 			res := make([]Choice, 0, len(answers))
-			choiceCounter := make(map[uint]int, MaxChoiceNum)
-			for idx := range MaxChoiceNum {
-				choiceCounter[uint(idx)+1] = 0
+			// 出題したクイズの選択肢だけを数える
+			choiceCounter := make(map[uint]int, len(qr.currentQuiz.Choices))
+			for _, c := range qr.currentQuiz.Choices {
+				choiceCounter[c.ChoiceID] = 0
 			}
 			for answer := range answers {
+				if _, ok := choiceCounter[answer.ChoiceID]; !ok {
+					continue
+				}
 				res = append(res, answer)
 				choiceCounter[answer.ChoiceID]++
 			}
 			var maxChoiceCnt int = 0
-			var maxChoiceIDs []uint = make([]uint, 0, MaxChoiceNum)
+			var maxChoiceIDs []uint = make([]uint, 0, qr.rules.MaxChoiceNum)
 			for cid, cnt := range choiceCounter {
 				if cnt == 0 {
 					continue
@@ -192,13 +296,19 @@ func (qr *questRoom) CollectAnswer() (map[TeamID]Choice, map[TeamID]map[uint]int
 	return teamAnswers, teamAnswersMap
 }
 
-func (qr *questRoom) UpdateTeamStats(teamAnswers map[TeamID]Choice) {
+func (qr *questRoom) UpdateTeamStats(teamAnswers map[TeamID]Choice, answerMaps map[TeamID]map[uint]int) {
 	qr.mu.Lock()
 	defer qr.mu.Unlock()
+	qr.recordTeamAnswers(teamAnswers, answerMaps)
 	qr.quizCount++
 	for tid, choice := range teamAnswers {
 		if choice.ChoiceID == qr.currentAnswer.ChoiceID {
 			qr.teamStats[tid]++
+			if qr.doublePoints[tid] {
+				qr.teamPoints[tid] += 2
+			} else {
+				qr.teamPoints[tid]++
+			}
 		}
 	}
 }
@@ -211,10 +321,78 @@ func (qr *questRoom) Connect(uid uuid.UUID) (context.Context, <-chan Quiz) {
 	return qr.ctx, ch
 }
 
+func (qr *questRoom) RecordVote(uid uuid.UUID, answer Choice) {
+	qr.mu.Lock()
+	defer qr.mu.Unlock()
+	qr.votes[uid] = answer
+	qr.recordAnswer(uid, answer)
+}
+
+func (qr *questRoom) UseLifeline(tid TeamID, questionID uint, lifeline Lifeline) (LifelineResult, error) {
+	qr.mu.Lock()
+	defer qr.mu.Unlock()
+	if qr.currentQuiz.QuestionID != questionID || qr.currentTarget == uuid.Nil {
+		return LifelineResult{}, errors.New("The quiz has already changed")
+	}
+	if qr.currentQuiz.TeamID == tid {
+		return LifelineResult{}, errors.New("Your team cannot answer this quiz")
+	}
+	if qr.lifelines[tid][lifeline] <= 0 {
+		return LifelineResult{}, errors.New("The lifeline has already been used up")
+	}
+
+	result := LifelineResult{}
+	switch lifeline {
+	case FiftyFifty:
+		removed := qr.removedChoices[tid]
+		wrongIDs := make([]uint, 0, len(qr.currentQuiz.Choices))
+		for _, c := range qr.currentQuiz.Choices {
+			if c.ChoiceID != qr.currentAnswer.ChoiceID && !slices.Contains(removed, c.ChoiceID) {
+				wrongIDs = append(wrongIDs, c.ChoiceID)
+			}
+		}
+		// 不正解の選択肢を最低１つは残す
+		removeNum := min(FiftyFiftyRemoveNum, len(wrongIDs)-1)
+		if removeNum <= 0 {
+			return LifelineResult{}, errors.New("There are no choices to remove")
+		}
+		qr.removedChoices[tid] = append(removed, util.ShuffleSlice(wrongIDs)[:removeNum]...)
+	case AskTheAudience:
+		answerMap := make(map[uint]int, len(qr.currentQuiz.Choices))
+		for _, c := range qr.currentQuiz.Choices {
+			answerMap[c.ChoiceID] = 0
+		}
+		// 自チーム以外の投票状況のみを見せる
+		for uid, vote := range qr.votes {
+			if qr.userTeams[uid] == tid {
+				continue
+			}
+			if _, ok := answerMap[vote.ChoiceID]; ok {
+				answerMap[vote.ChoiceID]++
+			}
+		}
+		result.AnswerMap = answerMap
+	case DoublePoints:
+		qr.doublePoints[tid] = true
+	default:
+		return LifelineResult{}, errors.New("Unknown lifeline")
+	}
+	qr.lifelines[tid][lifeline]--
+	qr.usedLifelines[tid] = append(qr.usedLifelines[tid], lifeline)
+
+	quiz := qr.quizForTeam(qr.currentQuiz, tid)
+	result.Choices = quiz.Choices
+	result.IsDoublePoints = qr.doublePoints[tid]
+	result.AvailableLifelines = quiz.AvailableLifelines
+	result.ChoiceNum = len(qr.currentQuiz.Choices)
+	return result, nil
+}
+
 func (qr *questRoom) Answer(tid TeamID, uid uuid.UUID, answer Choice) AnswerWithMap {
+	qr.RecordVote(uid, answer)
 	select {
 	case qr.answerListener[tid] <- answer:
-	case <-time.After(WaitAnswerTimeout):
+	case <-time.After(qr.rules.AnswerTimeout):
 		// 自分のAnswerを送るのに失敗してもチームのAnswerの受取を待つ
 	}
 	select {
@@ -254,26 +432,140 @@ type Stats struct {
 	Order       int
 }
 
+// 参加者数の見込みと上限、どちらも0の場合は未定/無制限
+type EntryLimits struct {
+	ExpectedUserNum int
+	MaxUserNum      int
+}
+
 type GameManager struct {
-	state      State
-	maxUserNum int
-	teamNum    int
-	ctx        context.Context
-	mu         sync.RWMutex
-	lobby      *lobby
-	room       *questRoom
+	state   State
+	limits  EntryLimits
+	teamNum int
+	ctx     context.Context
+	mu      sync.RWMutex
+	lobby   *lobby
+	room    *questRoom
+	rules   GameRules
+	// 管理者に画像を消されて、撮り直しを求められている参加者
+	imageRequests map[uuid.UUID]struct{}
+	// 参加登録して拒否されていない参加者、上限はロビーへの接続ではなくこちらで数える
+	entries map[uuid.UUID]struct{}
+}
+
+func (gm *GameManager) GetRules() GameRules {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	return gm.rules
 }
 
-func (gm *GameManager) OpenLobby() (context.Context, error) {
+// 参加登録の受付開始後にルールが変わると、参加者の画面と噛み合わなくなるので受付開始前に限る
+func (gm *GameManager) SetRules(rules GameRules) error {
+	if err := rules.Validate(); err != nil {
+		return err
+	}
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	if gm.state != INITIALIZED {
+		return errors.New("Rules cannot be changed after entry has been opened")
+	}
+	// 参加者数が決まっていない場合は、受付の締め切り時にチームの人数を確認する
+	if gm.limits.ExpectedUserNum > 0 {
+		if err := rules.ValidateFor(gm.limits.ExpectedUserNum, gm.teamNum); err != nil {
+			return err
+		}
+	}
+	gm.rules = rules
+	return nil
+}
+
+func (gm *GameManager) GetEntryLimits() EntryLimits {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	return gm.limits
+}
+
+// 参加者数の見込みと上限は、参加登録の受付を締め切るまでは変更できる
+// 上限は既にロビーにいる人数より少なくはできない
+func (gm *GameManager) SetEntryLimits(limits EntryLimits) error {
+	if limits.ExpectedUserNum < 0 || limits.MaxUserNum < 0 {
+		return errors.New("User num must not be negative")
+	}
+	if limits.MaxUserNum > 0 && limits.ExpectedUserNum > limits.MaxUserNum {
+		return fmt.Errorf("Expected user num %d exceeds max user num %d", limits.ExpectedUserNum, limits.MaxUserNum)
+	}
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
 	if gm.state != INITIALIZED && gm.state != ACCEPTING {
-		return nil, errors.New("Lobby has already been opend before")
+		return errors.New("User num cannot be changed after entry has been closed")
+	}
+	if limits.MaxUserNum > 0 && len(gm.entries) > limits.MaxUserNum {
+		return fmt.Errorf("%d users have already entered, more than max user num %d", len(gm.entries), limits.MaxUserNum)
+	}
+	gm.limits = limits
+	return nil
+}
+
+// 参加登録の枠を確保する、上限が決まっていて既に埋まっている場合は断る
+// 確認と確保を同じロックの中で行うので、同時に登録されても上限を超えない
+func (gm *GameManager) ReserveEntry(uid uuid.UUID) error {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	if gm.limits.MaxUserNum > 0 && len(gm.entries) >= gm.limits.MaxUserNum {
+		return errors.New("Entry is full")
+	}
+	gm.entries[uid] = struct{}{}
+	return nil
+}
+
+// 登録に失敗した場合や、管理者に拒否された場合に枠を空ける
+func (gm *GameManager) ReleaseEntry(uid uuid.UUID) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	delete(gm.entries, uid)
+}
+
+// 負荷の見積もりに使う参加者数
+// 見込みが無い場合は上限を使い、実際に集まった人数の方が多い場合はそちらを使う
+func (gm *GameManager) GetRosterSize() int {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	size := gm.limits.ExpectedUserNum
+	if size == 0 {
+		size = gm.limits.MaxUserNum
 	}
+	return max(size, len(gm.lobby.users))
+}
+
+func (gm *GameManager) GetState() State {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	return gm.state
+}
+
+// エントリー開始前に限る処理がRunBeforeEntryで走っている間は、終わるまで待ってから開く
+func (gm *GameManager) OpenLobby() (context.Context, error) {
 	gm.mu.Lock()
 	defer gm.mu.Unlock()
+	if gm.state != INITIALIZED && gm.state != ACCEPTING {
+		return nil, errors.New("Lobby has already been opend before")
+	}
 	gm.state = ACCEPTING
 	return gm.lobby.ctx, nil
 }
 
+// エントリー開始前の間だけfを実行し、fが終わるまでエントリーを開始させない
+// 状態の確認とfの間にエントリーが始まらないよう、まとめてロックを取る
+// ロックを取ったまま呼ぶので、fの中からGameManagerのメソッドを呼んではいけない
+func (gm *GameManager) RunBeforeEntry(f func() error) error {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	if gm.state != INITIALIZED {
+		return ErrEntryOpened
+	}
+	return f()
+}
+
 func (gm *GameManager) CloseLobby() error {
 	if gm.state != ACCEPTING && gm.state != CLOSED {
 		return errors.New("Lobby has not opend yet, or already closed")
@@ -339,6 +631,45 @@ func (gm *GameManager) GetTeams() map[TeamID][]uuid.UUID {
 	return teams
 }
 
+// 参加者に画像のアップロードし直しを求める、ロビーの通知で本人に伝わる
+func (gm *GameManager) RequestImageUpload(uid uuid.UUID) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	gm.imageRequests[uid] = struct{}{}
+}
+
+func (gm *GameManager) IsImageUploadRequested(uid uuid.UUID) bool {
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	_, ok := gm.imageRequests[uid]
+	return ok
+}
+
+// 新しい画像がアップロードされたら求めるのを止める
+func (gm *GameManager) ClearImageUploadRequest(uid uuid.UUID) {
+	gm.mu.Lock()
+	defer gm.mu.Unlock()
+	delete(gm.imageRequests, uid)
+}
+
+// 受付中のロビーかチーム分け後のチームにいる参加者か
+func (gm *GameManager) IsParticipant(uid uuid.UUID) bool {
+	if gm.state < ACCEPTING {
+		return false
+	}
+	gm.mu.RLock()
+	defer gm.mu.RUnlock()
+	if (gm.state == ACCEPTING || gm.state == CLOSED) && slices.Contains(gm.lobby.users, uid) {
+		return true
+	}
+	for _, uidList := range gm.room.teams {
+		if slices.Contains(uidList, uid) {
+			return true
+		}
+	}
+	return false
+}
+
 func (gm *GameManager) QuestStart() (count <-chan struct{}, next <-chan struct{}, err error) {
 	if gm.state != CLOSED && gm.state != INGAME {
 		return nil, nil, errors.New("Not quest ready or quest has already done")
@@ -346,10 +677,17 @@ func (gm *GameManager) QuestStart() (count <-chan struct{}, next <-chan struct{}
 	gm.mu.Lock()
 	defer gm.mu.Unlock()
 	gm.state = INGAME
+	// 出題中にルールが変わらないように、開始時点のルールを部屋に持たせる
+	gm.room.rules = gm.rules
 	for tid, uids := range gm.room.teams {
 		gm.room.answerListener[tid] = make(chan Choice)
+		gm.room.lifelines[tid] = make(map[Lifeline]int, len(AllLifelines))
+		for _, l := range AllLifelines {
+			gm.room.lifelines[tid][l] = LifelineUsesPerGame
+		}
 		for _, uid := range uids {
 			gm.room.answerSender[uid] = make(chan AnswerWithMap)
+			gm.room.userTeams[uid] = tid
 		}
 	}
 	return gm.room.startCountNotifier, gm.room.nextQuizNotifier, nil
@@ -383,7 +721,7 @@ func (gm *GameManager) Broadcast(target uuid.UUID, quiz Quiz, correct Choice) er
 	}
 	gm.mu.Lock()
 	defer gm.mu.Unlock()
-	gm.room.SetCurrent(target, correct)
+	gm.room.SetCurrent(target, quiz, correct)
 	gm.room.PublishQuiz(quiz)
 	return nil
 }
@@ -398,6 +736,7 @@ func (gm *GameManager) StartCount() error {
 
 	select {
 	case gm.room.startCountNotifier <- struct{}{}:
+		gm.room.RecordCountdownStarted()
 		return nil
 	case <-time.After(time.Second):
 		return errors.New("Timed out")
@@ -417,7 +756,7 @@ func (gm *GameManager) CollectAnswer() (map[TeamID]Result, map[TeamID]map[uint]i
 		return nil, nil, errors.New("Server is not in game mode")
 	}
 	teamAnswers, teamAnswersMap := gm.room.CollectAnswer()
-	gm.room.UpdateTeamStats(teamAnswers)
+	gm.room.UpdateTeamStats(teamAnswers, teamAnswersMap)
 	results := make(map[TeamID]Result, len(teamAnswers))
 	for tid, choice := range teamAnswers {
 		results[tid] = Result{
@@ -434,6 +773,7 @@ func (gm *GameManager) DistributeAnswer(results map[TeamID]Result, answerMaps ma
 	}
 
 	teams := gm.GetTeams()
+	choiceNum := len(gm.room.currentQuiz.Choices)
 	for tid, result := range results {
 		users := teams[tid]
 		for _, user := range users {
@@ -442,9 +782,10 @@ func (gm *GameManager) DistributeAnswer(results map[TeamID]Result, answerMaps ma
 				case ch <- AnswerWithMap{
 					TeamAnswer: c,
 					AnswerMap:  am,
+					ChoiceNum:  choiceNum,
 				}:
 					return
-				case <-time.After(2 * WaitAnswerTimeout):
+				case <-time.After(2 * gm.room.rules.AnswerTimeout):
 					return
 				}
 			}(gm.room.answerSender[user], result.Answer, answerMaps[tid])
@@ -481,6 +822,14 @@ func (gm *GameManager) EndQuest() error {
 	return nil
 }
 
+// クエスト開始後の出題と回答の記録、出題中の場合は途中までの記録を返す
+func (gm *GameManager) GetQuestLog() []QuizRecord {
+	if gm.state < INGAME {
+		return nil
+	}
+	return gm.room.GetLog()
+}
+
 func (gm *GameManager) GetAllStats() (float32, map[uuid.UUID]Stats, map[TeamID]Stats, error) {
 	if gm.state != RESULT {
 		return 0.0, nil, nil, errors.New("Game has not been ended")
@@ -502,18 +851,19 @@ func (gm *GameManager) GetAllStats() (float32, map[uuid.UUID]Stats, map[TeamID]S
 		}
 	}
 	teamsStats := make(map[TeamID]Stats, len(gm.room.teamStats))
-	teamsCounts := make([]int, 0, len(gm.room.teamStats))
+	teamsPoints := make([]int, 0, len(gm.room.teamStats))
 	var sum int = 0
-	for _, cnt := range gm.room.teamStats {
-		teamsCounts = append(teamsCounts, cnt)
+	for tid, cnt := range gm.room.teamStats {
+		teamsPoints = append(teamsPoints, gm.room.teamPoints[tid])
 		sum += cnt
 	}
 	// descで並べたいので
-	slices.SortFunc(teamsCounts, func(a, b int) int { return b - a })
+	// 順位はダブルポイントを加味した得点で決める
+	slices.SortFunc(teamsPoints, func(a, b int) int { return b - a })
 	for uid, cnt := range gm.room.teamStats {
 		teamsStats[uid] = Stats{
 			CorrectRate: float32(cnt) / float32(gm.room.quizCount),
-			Order:       slices.Index(teamsCounts, cnt) + 1,
+			Order:       slices.Index(teamsPoints, gm.room.teamPoints[uid]) + 1,
 		}
 	}
 	return float32(sum) / float32(gm.room.quizCount*len(gm.room.teamStats)), usersStats, teamsStats, nil
@@ -525,6 +875,7 @@ func (gm *GameManager) JoinLobby(uid uuid.UUID) (context.Context, error) {
 	}
 	gm.mu.Lock()
 	defer gm.mu.Unlock()
+	// 上限は参加登録の時に確かめているので、再接続でも枠を失わない
 	gm.lobby.Join(uid)
 	return gm.lobby.ctx, nil
 }
@@ -556,21 +907,32 @@ func (gm *GameManager) TakeHint(uid uuid.UUID, hint string) error {
 	if gm.room.currentTarget != uid {
 		return errors.New("You cannot take a hint")
 	}
-	if utf8.RuneCountInString(hint) > MaxHintLength {
+	if utf8.RuneCountInString(hint) > gm.room.rules.MaxHintLength {
 		return errors.New("Your hint is too long")
 	}
 	select {
 	case gm.room.hintCh <- hint:
+		gm.room.RecordHint(hint)
 		return nil
 	case <-time.After(2 * time.Second):
 		return errors.New("Send hint has timed out")
 	}
 }
 
+func (gm *GameManager) UseLifeline(tid TeamID, questionID uint, lifeline Lifeline) (LifelineResult, error) {
+	if gm.state != INGAME {
+		return LifelineResult{}, errors.New("Game is not start or has ended")
+	}
+	return gm.room.UseLifeline(tid, questionID, lifeline)
+}
+
 func (gm *GameManager) Answer(uid uuid.UUID, tid TeamID, answer Choice) (AnswerWithMap, bool, error) {
 	if gm.state != INGAME {
 		return AnswerWithMap{}, false, errors.New("Game is not start or has ended")
 	}
+	if !gm.room.HasChoice(tid, answer.ChoiceID) {
+		return AnswerWithMap{}, false, ErrInvalidChoice
+	}
 	teamAnswer := gm.room.Answer(tid, uid, answer)
 	gm.room.UpdatePersonalStats(uid, answer)
 	if teamAnswer.TeamAnswer.ChoiceID == 0 {
@@ -597,41 +959,45 @@ func (gm *GameManager) GetResultStats(uid uuid.UUID, tid TeamID) (total float32,
 	}
 	tsSorts := make([]int, 0, len(gm.room.teamStats))
 	var sum int = 0
-	for _, cnt := range gm.room.teamStats {
-		tsSorts = append(tsSorts, cnt)
+	for t, cnt := range gm.room.teamStats {
+		tsSorts = append(tsSorts, gm.room.teamPoints[t])
 		sum += cnt
 	}
 	// descで並べたいので
+	// 順位はダブルポイントを加味した得点で決める
 	slices.SortFunc(tsSorts, func(a, b int) int { return b - a })
 	ts = Stats{
 		CorrectRate: float32(gm.room.teamStats[tid]) / float32(gm.room.quizCount),
-		Order:       slices.Index(tsSorts, gm.room.teamStats[tid]) + 1,
+		Order:       slices.Index(tsSorts, gm.room.teamPoints[tid]) + 1,
 	}
 	return float32(sum) / float32(gm.room.quizCount*len(gm.room.teamStats)), ps, ts, nil
 }
 
-func NewGameManager(maxUserNum int, teamNum int) *GameManager {
+func NewGameManager(limits EntryLimits, teamNum int, rules GameRules) *GameManager {
 	return sync.OnceValue(func() *GameManager {
 		rootCtx := context.Background()
 		lobbyCtx, lobbyDone := context.WithCancel(rootCtx)
 		roomCtx, roomDone := context.WithCancel(rootCtx)
 		return &GameManager{
-			state:      INITIALIZED,
-			maxUserNum: maxUserNum,
-			teamNum:    teamNum,
-			ctx:        rootCtx,
-			mu:         sync.RWMutex{},
+			state:         INITIALIZED,
+			limits:        limits,
+			teamNum:       teamNum,
+			rules:         rules,
+			ctx:           rootCtx,
+			mu:            sync.RWMutex{},
+			imageRequests: make(map[uuid.UUID]struct{}),
+			entries:       make(map[uuid.UUID]struct{}, limits.ExpectedUserNum),
 			lobby: &lobby{
-				users:        make([]uuid.UUID, 0, maxUserNum),
+				users:        make([]uuid.UUID, 0, limits.ExpectedUserNum),
 				ctx:          lobbyCtx,
 				doneNotifier: lobbyDone,
 			},
 			room: &questRoom{
 				teams:              make(map[TeamID][]uuid.UUID, teamNum),
-				conn:               make(map[uuid.UUID]chan<- Quiz, maxUserNum),
+				conn:               make(map[uuid.UUID]chan<- Quiz, limits.ExpectedUserNum),
 				hintCh:             make(chan string),
 				answerListener:     make(map[TeamID]chan Choice, teamNum),
-				answerSender:       make(map[uuid.UUID]chan AnswerWithMap, maxUserNum),
+				answerSender:       make(map[uuid.UUID]chan AnswerWithMap, limits.ExpectedUserNum),
 				abortAnswer:        make(chan struct{}),
 				startCountNotifier: make(chan struct{}),
 				nextQuizNotifier:   make(chan struct{}),
@@ -640,7 +1006,16 @@ func NewGameManager(maxUserNum int, teamNum int) *GameManager {
 				doneNotifier:       roomDone,
 				quizCount:          0,
 				teamStats:          make(map[TeamID]int, teamNum),
-				personalStats:      make(map[uuid.UUID]int, maxUserNum),
+				teamPoints:         make(map[TeamID]int, teamNum),
+				personalStats:      make(map[uuid.UUID]int, limits.ExpectedUserNum),
+				userTeams:          make(map[uuid.UUID]TeamID, limits.ExpectedUserNum),
+				lifelines:          make(map[TeamID]map[Lifeline]int, teamNum),
+				removedChoices:     make(map[TeamID][]uint, teamNum),
+				doublePoints:       make(map[TeamID]bool, teamNum),
+				votes:              make(map[uuid.UUID]Choice, limits.ExpectedUserNum),
+				usedLifelines:      make(map[TeamID][]Lifeline, teamNum),
+				log:                make([]QuizRecord, 0),
+				rules:              rules,
 			},
 		}
 	})()
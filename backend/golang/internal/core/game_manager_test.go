@@ -0,0 +1,192 @@
+package core
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 同時に登録されても上限を超えて枠を確保しないこと
+func TestReserveEntryConcurrently(t *testing.T) {
+	const maxUserNum = 5
+	gm := NewGameManager(EntryLimits{MaxUserNum: maxUserNum}, 2, DefaultGameRules())
+	var reserved atomic.Int32
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Go(func() {
+			if gm.ReserveEntry(uuid.New()) == nil {
+				reserved.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+	if got := reserved.Load(); got != maxUserNum {
+		t.Errorf("reserved = %d, want %d", got, maxUserNum)
+	}
+}
+
+func TestReserveEntry(t *testing.T) {
+	gm := NewGameManager(EntryLimits{MaxUserNum: 2}, 2, DefaultGameRules())
+	first, second := uuid.New(), uuid.New()
+	for _, uid := range []uuid.UUID{first, second} {
+		if err := gm.ReserveEntry(uid); err != nil {
+			t.Fatalf("ReserveEntry() error = %v", err)
+		}
+	}
+	if err := gm.ReserveEntry(uuid.New()); err == nil {
+		t.Error("ReserveEntry() over the limit succeeded, want error")
+	}
+	if err := gm.SetEntryLimits(EntryLimits{MaxUserNum: 1}); err == nil {
+		t.Error("SetEntryLimits() below the entry count succeeded, want error")
+	}
+
+	// ロビーへの接続が切れても枠は残る
+	if _, err := gm.OpenLobby(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gm.JoinLobby(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := gm.DisconnectLobby(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := gm.ReserveEntry(uuid.New()); err == nil {
+		t.Error("ReserveEntry() after a disconnect succeeded, want error")
+	}
+	if _, err := gm.JoinLobby(first); err != nil {
+		t.Errorf("JoinLobby() on reconnect error = %v", err)
+	}
+
+	// 拒否された参加者の枠は空く
+	gm.ReleaseEntry(second)
+	if err := gm.ReserveEntry(uuid.New()); err != nil {
+		t.Errorf("ReserveEntry() after a release error = %v", err)
+	}
+}
+
+// エントリー前に限る処理の途中でロビーを開こうとしても、処理が終わるまで待たされる
+func TestGameManagerRunBeforeEntry(t *testing.T) {
+	gm := NewGameManager(EntryLimits{}, 2, DefaultGameRules())
+	var finished atomic.Bool
+	openedAfterRun := make(chan bool)
+	if err := gm.RunBeforeEntry(func() error {
+		go func() {
+			if _, err := gm.OpenLobby(); err != nil {
+				t.Error(err)
+			}
+			openedAfterRun <- finished.Load()
+		}()
+		// ロビーを開く側がロックを待つまでの時間
+		time.Sleep(50 * time.Millisecond)
+		finished.Store(true)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !<-openedAfterRun {
+		t.Error("OpenLobby() returned while RunBeforeEntry() was running")
+	}
+
+	called := false
+	if err := gm.RunBeforeEntry(func() error {
+		called = true
+		return nil
+	}); !errors.Is(err, ErrEntryOpened) || called {
+		t.Errorf("RunBeforeEntry() after the lobby opened = %v (called: %v), want ErrEntryOpened without calling", err, called)
+	}
+}
+
+// １人ずつの２チームで、チーム2の参加者について３択のクイズを出した状態にする
+func startOneOnOneQuiz(t *testing.T) (gm *GameManager, answerer uuid.UUID) {
+	t.Helper()
+	rules := DefaultGameRules()
+	rules.AnswerTimeout = 500 * time.Millisecond
+	gm = NewGameManager(EntryLimits{}, 2, rules)
+	if _, err := gm.OpenLobby(); err != nil {
+		t.Fatal(err)
+	}
+	users := []uuid.UUID{uuid.New(), uuid.New()}
+	for _, uid := range users {
+		if _, err := gm.JoinLobby(uid); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := gm.CloseLobby(); err != nil {
+		t.Fatal(err)
+	}
+	gm.SplitTeams(users, 2)
+	teams := gm.GetTeams()
+	if _, _, err := gm.QuestStart(); err != nil {
+		t.Fatal(err)
+	}
+	quiz := Quiz{
+		TeamID:     2,
+		QuestionID: 1,
+		Choices:    []Choice{{ChoiceID: 1, ChoiceText: "a"}, {ChoiceID: 2, ChoiceText: "b"}, {ChoiceID: 3, ChoiceText: "c"}},
+	}
+	if err := gm.Broadcast(teams[2][0], quiz, quiz.Choices[0]); err != nil {
+		t.Fatal(err)
+	}
+	return gm, teams[1][0]
+}
+
+// クイズに無い選択肢や50:50で消された選択肢は、記録も集計もせずに断る
+func TestGameManagerAnswerRejectsUnknownChoices(t *testing.T) {
+	gm, answerer := startOneOnOneQuiz(t)
+	for _, cid := range []uint{0, 4, 1 << 31} {
+		if _, _, err := gm.Answer(answerer, 1, Choice{ChoiceID: cid}); !errors.Is(err, ErrInvalidChoice) {
+			t.Errorf("Answer(%d) error = %v, want ErrInvalidChoice", cid, err)
+		}
+	}
+
+	result, err := gm.UseLifeline(1, 1, FiftyFifty)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ChoiceNum != 3 {
+		t.Errorf("LifelineResult.ChoiceNum = %d, want 3", result.ChoiceNum)
+	}
+	removed := gm.room.removedChoices[1]
+	if len(removed) != 1 {
+		t.Fatalf("removed choices = %v, want one", removed)
+	}
+	if _, _, err := gm.Answer(answerer, 1, Choice{ChoiceID: removed[0]}); !errors.Is(err, ErrInvalidChoice) {
+		t.Errorf("Answer() of the removed choice %d error = %v, want ErrInvalidChoice", removed[0], err)
+	}
+	if answers := gm.GetQuestLog()[0].Answers; len(answers) != 0 {
+		t.Errorf("rejected answers were recorded: %+v", answers)
+	}
+}
+
+// 投票数はルールの選択肢の上限ではなく、出題したクイズの選択肢毎に数える
+func TestGameManagerCollectAnswerCountsQuizChoices(t *testing.T) {
+	gm, answerer := startOneOnOneQuiz(t)
+	done := make(chan AnswerWithMap)
+	go func() {
+		answer, _, _ := gm.Answer(answerer, 1, Choice{ChoiceID: 2, ChoiceText: "b"})
+		done <- answer
+	}()
+	// 回収中は回答を記録できないので、記録されてから回収する
+	for len(gm.GetQuestLog()[0].Answers) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	results, answerMaps, err := gm.CollectAnswer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := slices.Sorted(maps.Keys(answerMaps[1])); !slices.Equal(got, []uint{1, 2, 3}) {
+		t.Errorf("answer map keys = %v, want the quiz's choices [1 2 3]", got)
+	}
+	if err := gm.DistributeAnswer(results, answerMaps); err != nil {
+		t.Fatal(err)
+	}
+	if answer := <-done; answer.ChoiceNum != 3 || answer.AnswerMap[2] != 1 || answer.TeamAnswer.ChoiceID != 2 {
+		t.Errorf("Answer() = %+v, want choice 2 voted once out of 3 choices", answer)
+	}
+}
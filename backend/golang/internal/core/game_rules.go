@@ -0,0 +1,97 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 既定値
+const (
+	DefaultLobbyTick   time.Duration = 5 * time.Second
+	DefaultMinTeamUser int           = 3
+)
+
+// 正解率がそれぞれの値以上であれば、その評価になる
+// 正解率が100%の場合のPERFECTは固定なので含めない
+type ResultThresholds struct {
+	Excellent float32 `yaml:"excellent" toml:"excellent" json:"excellent"`
+	Great     float32 `yaml:"great" toml:"great" json:"great"`
+	GoodJob   float32 `yaml:"good_job" toml:"good_job" json:"good_job"`
+	Clear     float32 `yaml:"clear" toml:"clear" json:"clear"`
+}
+
+type GameRules struct {
+	// クイズ毎の回答時間（秒）
+	CountdownSeconds int `yaml:"countdown_seconds" toml:"countdown_seconds" json:"countdown_seconds"`
+	// ヒントが出された時に延長する回答時間（秒）
+	HintBonusSeconds int `yaml:"hint_bonus_seconds" toml:"hint_bonus_seconds" json:"hint_bonus_seconds"`
+	MaxHintLength    int `yaml:"max_hint_length" toml:"max_hint_length" json:"max_hint_length"`
+	// チームメンバーの回答が出揃うのを待つ時間
+	AnswerTimeout time.Duration `yaml:"answer_timeout" toml:"answer_timeout" json:"answer_timeout"`
+	MaxChoiceNum  int           `yaml:"max_choice_num" toml:"max_choice_num" json:"max_choice_num"`
+	// ロビーの状態を通知する間隔
+	LobbyTick        time.Duration    `yaml:"lobby_tick" toml:"lobby_tick" json:"lobby_tick"`
+	MinTeamUser      int              `yaml:"min_team_user" toml:"min_team_user" json:"min_team_user"`
+	ResultThresholds ResultThresholds `yaml:"result_thresholds" toml:"result_thresholds" json:"result_thresholds"`
+	// 結果発表で贈る賞
+	Awards AwardRules `yaml:"awards" toml:"awards" json:"awards"`
+}
+
+func DefaultGameRules() GameRules {
+	return GameRules{
+		CountdownSeconds: InitialRemaindTime,
+		HintBonusSeconds: IncreaseTimeHintTaken,
+		MaxHintLength:    MaxHintLength,
+		AnswerTimeout:    WaitAnswerTimeout,
+		MaxChoiceNum:     MaxChoiceNum,
+		LobbyTick:        DefaultLobbyTick,
+		MinTeamUser:      DefaultMinTeamUser,
+		ResultThresholds: ResultThresholds{
+			Excellent: 0.9,
+			Great:     0.75,
+			GoodJob:   0.5,
+			Clear:     0.3,
+		},
+		Awards: DefaultAwardRules(),
+	}
+}
+
+// 問題のある項目を全てまとめて返す
+func (r GameRules) Validate() error {
+	errs := make([]error, 0)
+	checkRange := func(name string, v, lower, upper int) {
+		if v < lower || v > upper {
+			errs = append(errs, fmt.Errorf("%s must be between %d and %d, but got %d", name, lower, upper, v))
+		}
+	}
+	checkDuration := func(name string, v, lower, upper time.Duration) {
+		if v < lower || v > upper {
+			errs = append(errs, fmt.Errorf("%s must be between %s and %s, but got %s", name, lower, upper, v))
+		}
+	}
+	checkRange("countdown_seconds", r.CountdownSeconds, 1, 600)
+	checkRange("hint_bonus_seconds", r.HintBonusSeconds, 0, 600)
+	checkRange("max_hint_length", r.MaxHintLength, 1, 200)
+	checkDuration("answer_timeout", r.AnswerTimeout, 500*time.Millisecond, 30*time.Second)
+	// 正解と不正解が最低１つずつ無いとクイズにならない
+	checkRange("max_choice_num", r.MaxChoiceNum, 2, 10)
+	checkDuration("lobby_tick", r.LobbyTick, time.Second, time.Minute)
+	checkRange("min_team_user", r.MinTeamUser, 1, 100)
+	t := r.ResultThresholds
+	if !(0 <= t.Clear && t.Clear <= t.GoodJob && t.GoodJob <= t.Great && t.Great <= t.Excellent && t.Excellent <= 1) {
+		errs = append(errs, errors.New("result_thresholds must satisfy 0 <= clear <= good_job <= great <= excellent <= 1"))
+	}
+	if err := r.Awards.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// 参加者数とチーム数に対して、全チームが最低人数を満たせるか
+func (r GameRules) ValidateFor(userNum int, teamNum int) error {
+	if teamNum <= 0 || userNum/teamNum < r.MinTeamUser {
+		return fmt.Errorf("%d users cannot be split into %d teams of at least %d users", userNum, teamNum, r.MinTeamUser)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDefaultGameRulesAreValid(t *testing.T) {
+	if err := DefaultGameRules().Validate(); err != nil {
+		t.Errorf("DefaultGameRules().Validate() error = %v, want nil", err)
+	}
+}
+
+// 範囲の境界値は受け付ける
+func TestGameRulesValidateAcceptsBounds(t *testing.T) {
+	rules := DefaultGameRules()
+	rules.CountdownSeconds = 600
+	rules.HintBonusSeconds = 0
+	rules.AnswerTimeout = 30 * time.Second
+	rules.MaxChoiceNum = 2
+	rules.LobbyTick = time.Second
+	rules.ResultThresholds = ResultThresholds{Excellent: 0.5, Great: 0.5, GoodJob: 0.5, Clear: 0.5}
+	if err := rules.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestGameRulesValidateRejectsOutOfRange(t *testing.T) {
+	breaks := map[string]func(r *GameRules){
+		"countdown_seconds":               func(r *GameRules) { r.CountdownSeconds = 0 },
+		"hint_bonus_seconds":              func(r *GameRules) { r.HintBonusSeconds = -1 },
+		"max_hint_length":                 func(r *GameRules) { r.MaxHintLength = 201 },
+		"answer_timeout":                  func(r *GameRules) { r.AnswerTimeout = 499 * time.Millisecond },
+		"max_choice_num":                  func(r *GameRules) { r.MaxChoiceNum = 1 },
+		"lobby_tick":                      func(r *GameRules) { r.LobbyTick = time.Minute + time.Second },
+		"min_team_user":                   func(r *GameRules) { r.MinTeamUser = 0 },
+		"result_thresholds":               func(r *GameRules) { r.ResultThresholds.Great = 0.95 },
+		"awards.perfect_streak.min_count": func(r *GameRules) { r.Awards.PerfectStreak.MinCount = 0 },
+	}
+	for field, breakRule := range breaks {
+		rules := DefaultGameRules()
+		breakRule(&rules)
+		if err := rules.Validate(); err == nil || !strings.Contains(err.Error(), field) {
+			t.Errorf("Validate() with a broken %s: error = %v, want it to mention the field", field, err)
+		}
+	}
+}
+
+// 問題のある項目は最初の１つだけでなく全て返すこと
+func TestGameRulesValidateReportsEveryField(t *testing.T) {
+	rules := DefaultGameRules()
+	rules.CountdownSeconds = 0
+	rules.MaxChoiceNum = 0
+	err := rules.Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want error")
+	}
+	for _, field := range []string{"countdown_seconds", "max_choice_num"} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("Validate() error = %v, want it to mention %q", err, field)
+		}
+	}
+}
+
+func TestGameRulesValidateFor(t *testing.T) {
+	rules := DefaultGameRules()
+	rules.MinTeamUser = 3
+	if err := rules.ValidateFor(10, 3); err != nil {
+		t.Errorf("ValidateFor(10, 3) error = %v, want nil", err)
+	}
+	if err := rules.ValidateFor(8, 3); err == nil {
+		t.Error("ValidateFor(8, 3) error = nil, want error for a team of two")
+	}
+	if err := rules.ValidateFor(9, 0); err == nil {
+		t.Error("ValidateFor(9, 0) error = nil, want error")
+	}
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"golang.org/x/image/draw"
+)
+
+// 顔写真の縦横比（横:縦 = 3:4）
+const (
+	PortraitAspectWidth  int = 3
+	PortraitAspectHeight int = 4
+)
+
+const MaxCropZoom float64 = 10
+
+// クライアントが指定する切り抜き範囲
+// 座標は向きを直した後（ブラウザで表示される向き）の元画像のピクセル数で表す
+type ImageCrop struct {
+	// 切り抜く範囲、空の場合はZoomとCenterから決める
+	Rect image.Rectangle
+	// 1で画像に収まる最大の3:4の範囲、大きくするほど狭い範囲を切り抜く
+	Zoom float64
+	// Zoomで切り抜く範囲の中心、nilの場合は画像の中心
+	Center *image.Point
+}
+
+// w x hの画像の中で切り抜く範囲を決める
+func (ic *ImageCrop) resolve(w int, h int) (image.Rectangle, error) {
+	bounds := image.Rect(0, 0, w, h)
+	if !ic.Rect.Empty() {
+		rect := ic.Rect.Intersect(bounds)
+		if rect.Empty() {
+			return rect, NewImageError(ImageErrorInvalidCrop, "Crop rectangle %v is outside of the %dx%d image", ic.Rect, w, h)
+		}
+		return rect, nil
+	}
+
+	zoom := ic.Zoom
+	if zoom == 0 {
+		zoom = 1
+	}
+	if zoom < 1 || zoom > MaxCropZoom || math.IsNaN(zoom) {
+		return image.Rectangle{}, NewImageError(ImageErrorInvalidCrop, "Zoom must be between 1 and %v, but got %v", MaxCropZoom, ic.Zoom)
+	}
+	// 画像に収まる最大の3:4の範囲をzoomで縮める
+	baseWidth := math.Min(float64(w), float64(h)*float64(PortraitAspectWidth)/float64(PortraitAspectHeight))
+	cropWidth := max(1, int(math.Round(baseWidth/zoom)))
+	cropHeight := max(1, min(h, int(math.Round(baseWidth*float64(PortraitAspectHeight)/float64(PortraitAspectWidth)/zoom))))
+	center := image.Pt(w/2, h/2)
+	if ic.Center != nil {
+		center = *ic.Center
+	}
+	// 範囲が画像からはみ出さないように中心をずらす
+	x := min(max(center.X-cropWidth/2, 0), w-cropWidth)
+	y := min(max(center.Y-cropHeight/2, 0), h-cropHeight)
+	return image.Rect(x, y, x+cropWidth, y+cropHeight), nil
+}
+
+// 切り抜いた範囲を縦横比を保って一辺frameSizeの正方形に収め、余白は黒で塗る
+// ブラウザで切り抜いていた時と同じく、3:4の範囲なら左右に余白ができる
+func cropToFrame(src image.Image, orientation int, crop *ImageCrop, frameSize int) (*image.RGBA, error) {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	swapped := orientationSwapsAxes(orientation)
+	if swapped {
+		dw, dh = h, w
+	}
+	rect, err := crop.resolve(dw, dh)
+	if err != nil {
+		return nil, err
+	}
+
+	// 表示される向きの範囲を元画像の範囲に戻して、回転前に切り抜きと縮小をまとめて行う
+	x0, y0 := orientedSourcePoint(orientation, w, h, rect.Min.X, rect.Min.Y)
+	x1, y1 := orientedSourcePoint(orientation, w, h, rect.Max.X-1, rect.Max.Y-1)
+	srcRect := image.Rect(min(x0, x1), min(y0, y1), max(x0, x1)+1, max(y0, y1)+1).Add(b.Min)
+
+	scale := math.Min(float64(frameSize)/float64(rect.Dx()), float64(frameSize)/float64(rect.Dy()))
+	fitWidth := max(1, min(frameSize, int(math.Round(float64(rect.Dx())*scale))))
+	fitHeight := max(1, min(frameSize, int(math.Round(float64(rect.Dy())*scale))))
+	scaledWidth, scaledHeight := fitWidth, fitHeight
+	if swapped {
+		scaledWidth, scaledHeight = fitHeight, fitWidth
+	}
+	scaled := image.NewRGBA(image.Rect(0, 0, scaledWidth, scaledHeight))
+	draw.Draw(scaled, scaled.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), src, srcRect, draw.Over, nil)
+	oriented := applyOrientation(scaled, orientation)
+
+	frame := image.NewRGBA(image.Rect(0, 0, frameSize, frameSize))
+	draw.Draw(frame, frame.Bounds(), image.NewUniform(color.Black), image.Point{}, draw.Src)
+	offset := image.Pt((frameSize-fitWidth)/2, (frameSize-fitHeight)/2)
+	draw.Draw(frame, oriented.Bounds().Add(offset), oriented, image.Point{}, draw.Src)
+	return frame, nil
+}
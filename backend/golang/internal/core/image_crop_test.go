@@ -0,0 +1,142 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"testing"
+)
+
+func TestImageCropResolve(t *testing.T) {
+	tests := []struct {
+		name string
+		crop ImageCrop
+		w, h int
+		want image.Rectangle
+	}{
+		{name: "whole portrait", w: 300, h: 400, want: image.Rect(0, 0, 300, 400)},
+		{name: "landscape is cut to 3:4", w: 800, h: 600, want: image.Rect(175, 0, 625, 600)},
+		{name: "zoom at center", crop: ImageCrop{Zoom: 2}, w: 300, h: 400, want: image.Rect(75, 100, 225, 300)},
+		{name: "zoom near top left", crop: ImageCrop{Zoom: 2, Center: &image.Point{X: 10, Y: 10}}, w: 300, h: 400, want: image.Rect(0, 0, 150, 200)},
+		{name: "zoom near bottom right", crop: ImageCrop{Zoom: 2, Center: &image.Point{X: 300, Y: 400}}, w: 300, h: 400, want: image.Rect(150, 200, 300, 400)},
+		{name: "tiny image", crop: ImageCrop{Zoom: MaxCropZoom}, w: 2, h: 2, want: image.Rect(1, 1, 2, 2)},
+		{name: "rectangle", crop: ImageCrop{Rect: image.Rect(10, 10, 50, 50)}, w: 300, h: 400, want: image.Rect(10, 10, 50, 50)},
+		{name: "rectangle is clipped", crop: ImageCrop{Rect: image.Rect(250, 350, 400, 500)}, w: 300, h: 400, want: image.Rect(250, 350, 300, 400)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.crop.resolve(tt.w, tt.h)
+			if err != nil {
+				t.Fatalf("resolve() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageCropResolveInvalid(t *testing.T) {
+	invalid := []ImageCrop{
+		{Rect: image.Rect(400, 500, 450, 550)},
+		{Zoom: 0.5},
+		{Zoom: MaxCropZoom + 1},
+		{Zoom: math.NaN()},
+	}
+	for _, crop := range invalid {
+		_, err := crop.resolve(300, 400)
+		var ie *ImageError
+		if !errors.As(err, &ie) || ie.Code != ImageErrorInvalidCrop {
+			t.Errorf("resolve(%+v) error = %v, want %s", crop, err, ImageErrorInvalidCrop)
+		}
+	}
+}
+
+// 左半分が赤、右半分が青の40x20の画像
+func halfRedHalfBlue() *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, 40, 20))
+	for y := range 20 {
+		for x := range 40 {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 20 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func isReddish(c color.RGBA) bool { return c.R > 200 && c.B < 60 }
+func isBluish(c color.RGBA) bool  { return c.B > 200 && c.R < 60 }
+
+func TestCropToFrame(t *testing.T) {
+	src := halfRedHalfBlue()
+
+	// 右半分の正方形を切り抜くと、額縁いっぱいに青が入る
+	frame, err := cropToFrame(src, 1, &ImageCrop{Rect: image.Rect(20, 0, 40, 20)}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.Bounds().Size() != image.Pt(100, 100) {
+		t.Fatalf("frame size = %v, want 100x100", frame.Bounds().Size())
+	}
+	if c := frame.RGBAAt(5, 50); !isBluish(c) {
+		t.Errorf("left edge of the frame = %v, want blue", c)
+	}
+
+	// 3:4の範囲は左右に黒の余白ができる
+	frame, err = cropToFrame(src, 1, &ImageCrop{Rect: image.Rect(0, 0, 15, 20)}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := frame.RGBAAt(2, 50); c != (color.RGBA{A: 255}) {
+		t.Errorf("margin = %v, want black", c)
+	}
+	if c := frame.RGBAAt(50, 50); !isReddish(c) {
+		t.Errorf("center = %v, want red", c)
+	}
+}
+
+// 範囲は表示される向きで指定されるので、回転して保存された画像でも見た目通りに切り抜く
+func TestCropToFrameWithOrientation(t *testing.T) {
+	// 向き6の画像は時計回りに90度回して表示されるので、元画像の左側（赤）が上に来る
+	frame, err := cropToFrame(halfRedHalfBlue(), 6, &ImageCrop{Rect: image.Rect(0, 0, 20, 20)}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := frame.RGBAAt(50, 50); !isReddish(c) {
+		t.Errorf("top of the displayed image = %v, want red", c)
+	}
+	frame, err = cropToFrame(halfRedHalfBlue(), 6, &ImageCrop{Rect: image.Rect(0, 20, 20, 40)}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := frame.RGBAAt(50, 50); !isBluish(c) {
+		t.Errorf("bottom of the displayed image = %v, want blue", c)
+	}
+}
+
+func TestImageProcessorNormalizeWithCrop(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, halfRedHalfBlue(), nil); err != nil {
+		t.Fatal(err)
+	}
+	out, err := NewImageProcessor(DefaultMaxUploadBytes, 200).Normalize(bytes.NewReader(buf.Bytes()), &ImageCrop{Zoom: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(out[ImageLarge]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Width != 200 || cfg.Height != 200 {
+		t.Errorf("cropped image = %dx%d, want a 200x200 frame", cfg.Width, cfg.Height)
+	}
+
+	_, err = NewImageProcessor(DefaultMaxUploadBytes, 200).Normalize(bytes.NewReader(buf.Bytes()), &ImageCrop{Zoom: 0.1})
+	wantImageError(t, err, ImageErrorInvalidCrop)
+}
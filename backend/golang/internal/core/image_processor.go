@@ -0,0 +1,195 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"slices"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// 元画像の大きさの上限、デコード前に確認してメモリを使い切られないようにする
+const (
+	MaxSourceDimension int = 8192
+	MaxSourcePixels    int = 40_000_000
+)
+
+const (
+	DefaultImageOutputSize int   = 1024
+	DefaultMaxUploadBytes  int64 = 20 << 20
+	ImageJPEGQuality       int   = 85
+)
+
+// 用途毎に保存しておく画像の大きさ
+type ImageVariant string
+
+const (
+	// 名簿などに並べる小さい画像
+	ImageThumbnail ImageVariant = "thumbnail"
+	// スマートフォンで表示する画像
+	ImageMedium ImageVariant = "medium"
+	// プロジェクタやUnityのテクスチャに使う画像
+	ImageLarge ImageVariant = "large"
+)
+
+var ImageVariants = []ImageVariant{ImageThumbnail, ImageMedium, ImageLarge}
+
+// 各サイズの長辺のピクセル数、largeは設定の出力サイズを使う
+var imageVariantSizes = map[ImageVariant]int{
+	ImageThumbnail: 160,
+	ImageMedium:    640,
+}
+
+// 空の場合は以前と同じ画像を返せるようにlargeにする
+func ParseImageVariant(s string) (ImageVariant, error) {
+	if s == "" {
+		return ImageLarge, nil
+	}
+	variant := ImageVariant(s)
+	if !slices.Contains(ImageVariants, variant) {
+		return "", fmt.Errorf("Image size must be one of %v, but got %q", ImageVariants, s)
+	}
+	return variant, nil
+}
+
+// 受け付ける画像の形式（image.DecodeConfigが返す名前）
+var acceptedImageFormats = []string{"jpeg", "png", "webp"}
+
+// クライアントに返す画像のエラーの種類
+type ImageErrorCode string
+
+const (
+	ImageErrorMissing            ImageErrorCode = "missing_image"
+	ImageErrorFileTooLarge       ImageErrorCode = "file_too_large"
+	ImageErrorUnsupportedFormat  ImageErrorCode = "unsupported_format"
+	ImageErrorDimensionsTooLarge ImageErrorCode = "dimensions_too_large"
+	ImageErrorCorrupted          ImageErrorCode = "corrupted_image"
+	ImageErrorInvalidCrop        ImageErrorCode = "invalid_crop"
+)
+
+type ImageError struct {
+	Code    ImageErrorCode
+	Message string
+}
+
+func (ie *ImageError) Error() string {
+	return ie.Message
+}
+
+func NewImageError(code ImageErrorCode, format string, args ...any) *ImageError {
+	return &ImageError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// アップロードされた画像をデコードして、向きを直し、メタデータを含まない大きさを抑えたJPEGを各サイズ分作る
+type ImageProcessor struct {
+	maxUploadBytes int64
+	// 出力する一番大きい画像の長辺のピクセル数
+	outputSize int
+}
+
+func (ip *ImageProcessor) MaxUploadBytes() int64 {
+	return ip.maxUploadBytes
+}
+
+func (ip *ImageProcessor) OutputSize() int {
+	return ip.outputSize
+}
+
+// cropが指定された場合は、その範囲を切り抜いて一辺がoutputSizeの正方形の額縁に収める
+func (ip *ImageProcessor) Normalize(src io.Reader, crop *ImageCrop) (map[ImageVariant][]byte, error) {
+	// 上限を1バイト超えて読めたら大きすぎる
+	data, err := io.ReadAll(io.LimitReader(src, ip.maxUploadBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > ip.maxUploadBytes {
+		return nil, NewImageError(ImageErrorFileTooLarge, "Image must be at most %d bytes", ip.maxUploadBytes)
+	}
+	if len(data) == 0 {
+		return nil, NewImageError(ImageErrorMissing, "Image is empty")
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, NewImageError(ImageErrorUnsupportedFormat, "Image must be JPEG, PNG or WebP")
+	}
+	if !slices.Contains(acceptedImageFormats, format) {
+		return nil, NewImageError(ImageErrorUnsupportedFormat, "Image must be JPEG, PNG or WebP, but got %s", format)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, NewImageError(ImageErrorCorrupted, "Image has no pixels")
+	}
+	if cfg.Width > MaxSourceDimension || cfg.Height > MaxSourceDimension || cfg.Width*cfg.Height > MaxSourcePixels {
+		return nil, NewImageError(ImageErrorDimensionsTooLarge, "Image must be at most %dx%d and %d pixels, but got %dx%d", MaxSourceDimension, MaxSourceDimension, MaxSourcePixels, cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, NewImageError(ImageErrorCorrupted, "Failed to decode image: %s", err.Error())
+	}
+
+	orientation := 1
+	if format == "jpeg" {
+		orientation = jpegOrientation(data)
+	}
+	var large *image.RGBA
+	if crop != nil {
+		if large, err = cropToFrame(img, orientation, crop, ip.outputSize); err != nil {
+			return nil, err
+		}
+	} else {
+		// 回転は全画素を触るので、縮小してから行う
+		large = applyOrientation(resizeToFit(img, ip.outputSize), orientation)
+	}
+
+	return ip.EncodeVariants(large)
+}
+
+// 一番大きいサイズの画像から各サイズのJPEGを作る
+func (ip *ImageProcessor) EncodeVariants(large *image.RGBA) (map[ImageVariant][]byte, error) {
+	encoded := make(map[ImageVariant][]byte, len(ImageVariants))
+	for _, variant := range ImageVariants {
+		variantImg := large
+		if size, ok := imageVariantSizes[variant]; ok && size < ip.outputSize {
+			// 小さいサイズは向きを直した後の画像から縮小する
+			variantImg = resizeToFit(large, size)
+		}
+		// 再エンコードするとEXIFなどのメタデータは含まれない
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, variantImg, &jpeg.Options{Quality: ImageJPEGQuality}); err != nil {
+			return nil, err
+		}
+		encoded[variant] = buf.Bytes()
+	}
+	return encoded, nil
+}
+
+// 長辺がmaxSize以下になるように縮小する、拡大はしない
+// JPEGは透過できないので、透過部分は白で塗る
+func resizeToFit(src image.Image, maxSize int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if longSide := max(w, h); longSide > maxSize {
+		w = max(1, w*maxSize/longSide)
+		h = max(1, h*maxSize/longSide)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
+
+func NewImageProcessor(maxUploadBytes int64, outputSize int) *ImageProcessor {
+	return &ImageProcessor{
+		maxUploadBytes: maxUploadBytes,
+		outputSize:     outputSize,
+	}
+}
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func encodeTestJPEG(t *testing.T, w int, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func wantImageError(t *testing.T, err error, code ImageErrorCode) {
+	t.Helper()
+	var ie *ImageError
+	if !errors.As(err, &ie) || ie.Code != code {
+		t.Errorf("Normalize() error = %v, want %s", err, code)
+	}
+}
+
+func TestImageProcessorNormalizeOrientsAndStripsExif(t *testing.T) {
+	plain := encodeTestJPEG(t, 40, 20)
+	// SOIの直後に横向き(6)のEXIFを差し込む
+	withExif := append(append([]byte{0xFF, 0xD8}, exifSegment(tiffWithOrientation(binary.BigEndian, 6))...), plain[2:]...)
+
+	out, err := NewImageProcessor(DefaultMaxUploadBytes, DefaultImageOutputSize).Normalize(bytes.NewReader(withExif), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(out[ImageLarge]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "jpeg" || cfg.Width != 20 || cfg.Height != 40 {
+		t.Errorf("Normalize() = %s %dx%d, want a 20x40 jpeg", format, cfg.Width, cfg.Height)
+	}
+	for variant, data := range out {
+		if bytes.Contains(data, []byte("Exif\x00\x00")) {
+			t.Errorf("Normalize() kept the EXIF segment in %s", variant)
+		}
+	}
+}
+
+// 長辺を出力の大きさに縮め、透過部分は白で塗る
+func TestImageProcessorNormalizeResizesPNG(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 200, 100))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+	out, err := NewImageProcessor(DefaultMaxUploadBytes, 50).Normalize(&buf, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(out[ImageLarge]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := img.Bounds().Size(); size != image.Pt(50, 25) {
+		t.Errorf("Normalize() size = %v, want 50x25", size)
+	}
+	if r, g, b, _ := img.At(10, 10).RGBA(); r>>8 < 250 || g>>8 < 250 || b>>8 < 250 {
+		t.Errorf("transparent pixel became (%d, %d, %d), want white", r>>8, g>>8, b>>8)
+	}
+}
+
+// 小さいサイズは長辺をそれぞれの大きさに縮め、元より大きくはしない
+func TestImageProcessorNormalizeVariants(t *testing.T) {
+	out, err := NewImageProcessor(DefaultMaxUploadBytes, 1000).Normalize(bytes.NewReader(encodeTestJPEG(t, 1600, 400)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[ImageVariant]image.Point{
+		ImageThumbnail: {160, 40},
+		ImageMedium:    {640, 160},
+		ImageLarge:     {1000, 250},
+	}
+	for variant, size := range want {
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(out[variant]))
+		if err != nil {
+			t.Fatalf("%s: %v", variant, err)
+		}
+		if got := image.Pt(cfg.Width, cfg.Height); got != size {
+			t.Errorf("%s size = %v, want %v", variant, got, size)
+		}
+	}
+
+	small, err := NewImageProcessor(DefaultMaxUploadBytes, 1000).Normalize(bytes.NewReader(encodeTestJPEG(t, 100, 50)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, variant := range ImageVariants {
+		if cfg, _ := jpeg.DecodeConfig(bytes.NewReader(small[variant])); cfg.Width != 100 {
+			t.Errorf("%s width = %d, want the original 100", variant, cfg.Width)
+		}
+	}
+}
+
+func TestParseImageVariant(t *testing.T) {
+	if v, err := ParseImageVariant(""); err != nil || v != ImageLarge {
+		t.Errorf(`ParseImageVariant("") = (%q, %v), want large`, v, err)
+	}
+	if v, err := ParseImageVariant("thumbnail"); err != nil || v != ImageThumbnail {
+		t.Errorf(`ParseImageVariant("thumbnail") = (%q, %v), want thumbnail`, v, err)
+	}
+	if _, err := ParseImageVariant("huge"); err == nil {
+		t.Error(`ParseImageVariant("huge") error = nil, want error`)
+	}
+}
+
+func TestImageProcessorNormalizeErrors(t *testing.T) {
+	ip := NewImageProcessor(1024, DefaultImageOutputSize)
+
+	_, err := ip.Normalize(bytes.NewReader(nil), nil)
+	wantImageError(t, err, ImageErrorMissing)
+
+	_, err = ip.Normalize(bytes.NewReader(make([]byte, 1025)), nil)
+	wantImageError(t, err, ImageErrorFileTooLarge)
+
+	var gifBuf bytes.Buffer
+	if err := gif.Encode(&gifBuf, image.NewPaletted(image.Rect(0, 0, 2, 2), []color.Color{color.Black}), nil); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ip.Normalize(&gifBuf, nil)
+	wantImageError(t, err, ImageErrorUnsupportedFormat)
+
+	_, err = ip.Normalize(bytes.NewReader([]byte("not an image")), nil)
+	wantImageError(t, err, ImageErrorUnsupportedFormat)
+
+	// ヘッダだけ正しく、中身が壊れている
+	broken := encodeTestJPEG(t, 8, 8)
+	_, err = ip.Normalize(bytes.NewReader(broken[:len(broken)-10]), nil)
+	wantImageError(t, err, ImageErrorCorrupted)
+}
+
+// デコードする前に大きさを確かめて弾く
+func TestImageProcessorNormalizeRejectsHugeDimensions(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, MaxSourceDimension+1, 1))); err != nil {
+		t.Fatal(err)
+	}
+	_, err := NewImageProcessor(DefaultMaxUploadBytes, DefaultImageOutputSize).Normalize(&buf, nil)
+	wantImageError(t, err, ImageErrorDimensionsTooLarge)
+}
@@ -0,0 +1,109 @@
+package core
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 禁止語の表記揺れを吸収するための正規化
+// 全角/半角と大文字/小文字を揃え、カタカナをひらがなに寄せる
+func NormalizeForModeration(text string) string {
+	normalized := strings.ToLower(norm.NFKC.String(text))
+	return strings.Map(func(r rune) rune {
+		// ァ(U+30A1)〜ヶ(U+30F6)はひらがなと同じ並びなので、ずらすだけで変換できる
+		if r >= 'ァ' && r <= 'ヶ' {
+			return r - ('ァ' - 'ぁ')
+		}
+		return r
+	}, normalized)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+func isASCIIWord(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+type Moderator struct {
+	// 英数字のみの禁止語は、単語の一部に含まれるだけで引っかからないように単語単位で照合する
+	asciiTerms []string
+	// 末尾に*を付けた英数字の禁止語は、その語で始まる単語全てにマッチさせる
+	asciiPrefixTerms []string
+	// 日本語などを含む禁止語は単語の区切りが無いので、記号や空白を除いた文字列に含まれるかで照合する
+	otherTerms []string
+}
+
+// 禁止語が含まれていれば、最初に見つかった禁止語を返す
+func (m *Moderator) Check(text string) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+	normalized := NormalizeForModeration(text)
+	words := strings.FieldsFunc(normalized, func(r rune) bool { return !isWordRune(r) })
+	// 「f u c k」や「ば.か」のように区切って書かれたものも拾うために、区切りを全て除いたものも用意する
+	joined := strings.Join(words, "")
+	for _, term := range m.asciiTerms {
+		if slices.Contains(words, term) || joined == term {
+			return term, true
+		}
+		if oneRuneWords(words) && strings.Contains(joined, term) {
+			return term, true
+		}
+	}
+	for _, term := range m.asciiPrefixTerms {
+		if slices.ContainsFunc(words, func(w string) bool { return strings.HasPrefix(w, term) }) || strings.HasPrefix(joined, term) {
+			return term, true
+		}
+	}
+	for _, term := range m.otherTerms {
+		if strings.Contains(joined, term) {
+			return term, true
+		}
+	}
+	return "", false
+}
+
+func oneRuneWords(words []string) bool {
+	if len(words) < 2 {
+		return false
+	}
+	for _, w := range words {
+		if len([]rune(w)) != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// termsが空の場合は何も弾かない
+func NewModerator(terms []string) *Moderator {
+	m := &Moderator{
+		asciiTerms:       make([]string, 0, len(terms)),
+		asciiPrefixTerms: make([]string, 0),
+		otherTerms:       make([]string, 0, len(terms)),
+	}
+	for _, term := range terms {
+		normalized := strings.Join(strings.FieldsFunc(NormalizeForModeration(term), func(r rune) bool { return !isWordRune(r) }), "")
+		if normalized == "" {
+			continue
+		}
+		if isASCIIWord(normalized) && strings.HasSuffix(strings.TrimSpace(term), "*") {
+			m.asciiPrefixTerms = append(m.asciiPrefixTerms, normalized)
+		} else if isASCIIWord(normalized) {
+			m.asciiTerms = append(m.asciiTerms, normalized)
+		} else {
+			m.otherTerms = append(m.otherTerms, normalized)
+		}
+	}
+	return m
+}
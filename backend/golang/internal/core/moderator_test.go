@@ -0,0 +1,57 @@
+package core
+
+import "testing"
+
+func TestNormalizeForModeration(t *testing.T) {
+	tests := map[string]string{
+		"ＡＢＣ": "abc",
+		"バカ":  "ばか",
+		"ﾊﾞｶ": "ばか",
+		"ヴ":   "ゔ",
+		"ー":   "ー",
+	}
+	for text, want := range tests {
+		if got := NormalizeForModeration(text); got != want {
+			t.Errorf("NormalizeForModeration(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestModeratorCheck(t *testing.T) {
+	m := NewModerator([]string{"ass", "damn*", "ばか", "  "})
+
+	// 表記揺れや区切り文字を挟んでも、元の禁止語として報告される
+	blocked := map[string]string{
+		"ASS":      "ass",
+		"ＡＳＳ":      "ass",
+		"you ass!": "ass",
+		"a s s":    "ass",
+		"a.s.s":    "ass",
+		"damned":   "damn",
+		"バカ":       "ばか",
+		"ﾊﾞｶ":      "ばか",
+		"お ば・か さん": "ばか",
+	}
+	for text, want := range blocked {
+		if term, found := m.Check(text); !found || term != want {
+			t.Errorf("Check(%q) = (%q, %v), want (%q, true)", text, term, found, want)
+		}
+	}
+
+	// 英数字の禁止語は単語の一部に含まれるだけでは弾かない
+	allowed := []string{"hello world", "first class", "goddamn", "はかせ"}
+	for _, text := range allowed {
+		if term, found := m.Check(text); found {
+			t.Errorf("Check(%q) = (%q, true), want nothing to be found", text, term)
+		}
+	}
+}
+
+// 禁止語が無い場合は何も弾かない
+func TestModeratorCheckWithoutTerms(t *testing.T) {
+	for _, m := range []*Moderator{nil, NewModerator(nil), NewModerator([]string{"", " * "})} {
+		if term, found := m.Check("ass"); found {
+			t.Errorf("Check() = (%q, true), want nothing to be found", term)
+		}
+	}
+}
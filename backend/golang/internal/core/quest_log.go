@@ -0,0 +1,243 @@
+package core
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 参加者一人の回答
+type AnswerRecord struct {
+	UserID    uuid.UUID
+	TeamID    TeamID
+	Answer    Choice
+	IsCorrect bool
+	// 回答を送った時刻
+	AnsweredAt time.Time
+	// カウントダウンの開始から回答までの時間、開始前に回答した場合は0
+	ResponseTime time.Duration
+}
+
+// チームとしての回答
+type TeamAnswerRecord struct {
+	TeamID    TeamID
+	Answer    Choice
+	IsCorrect bool
+	// 選択肢のID毎のチーム内の投票数
+	AnswerMap      map[uint]int
+	IsDoublePoints bool
+	// このクイズで使ったライフライン
+	Lifelines []Lifeline
+}
+
+// 出題した１問分の記録
+type QuizRecord struct {
+	// 1から始まる出題順
+	Number        int
+	TargetUserID  uuid.UUID
+	TargetTeamID  TeamID
+	QuestionID    uint
+	QuestionText  string
+	Choices       []Choice
+	CorrectAnswer Choice
+	// 出題対象の参加者が出したヒント、出していない場合は空
+	Hint string
+	// 最初に配信した時刻
+	StartedAt          time.Time
+	CountdownStartedAt time.Time
+	HintTakenAt        time.Time
+	// 回答を締め切って答え合わせをした時刻、出題中の場合はゼロ値
+	CheckedAt   time.Time
+	TeamAnswers []TeamAnswerRecord
+	Answers     []AnswerRecord
+}
+
+func (qr QuizRecord) HasHint() bool {
+	return qr.Hint != ""
+}
+
+func (qr QuizRecord) IsChecked() bool {
+	return !qr.CheckedAt.IsZero()
+}
+
+// 答え合わせまで終わったクイズの数、正解率の分母に使う
+func CheckedQuizCount(log []QuizRecord) int {
+	count := 0
+	for _, record := range log {
+		if record.IsChecked() {
+			count++
+		}
+	}
+	return count
+}
+
+// 呼び出し側で書き換えても記録に影響しないように複製する
+func (qr QuizRecord) clone() QuizRecord {
+	qr.Choices = slices.Clone(qr.Choices)
+	qr.Answers = slices.Clone(qr.Answers)
+	teamAnswers := make([]TeamAnswerRecord, 0, len(qr.TeamAnswers))
+	for _, ta := range qr.TeamAnswers {
+		ta.AnswerMap = maps.Clone(ta.AnswerMap)
+		ta.Lifelines = slices.Clone(ta.Lifelines)
+		teamAnswers = append(teamAnswers, ta)
+	}
+	qr.TeamAnswers = teamAnswers
+	return qr
+}
+
+// 以下はquestRoomのロックを取った状態で呼ぶ
+
+func (qr *questRoom) currentRecord() *QuizRecord {
+	if len(qr.log) == 0 {
+		return nil
+	}
+	return &qr.log[len(qr.log)-1]
+}
+
+func (qr *questRoom) startRecord(target uuid.UUID, quiz Quiz, answer Choice) {
+	qr.log = append(qr.log, QuizRecord{
+		Number:        len(qr.log) + 1,
+		TargetUserID:  target,
+		TargetTeamID:  quiz.TeamID,
+		QuestionID:    quiz.QuestionID,
+		QuestionText:  quiz.QuestionText,
+		Choices:       slices.Clone(quiz.Choices),
+		CorrectAnswer: answer,
+		StartedAt:     time.Now(),
+		TeamAnswers:   make([]TeamAnswerRecord, 0, len(qr.teams)),
+		Answers:       make([]AnswerRecord, 0, len(qr.userTeams)),
+	})
+	qr.usedLifelines = make(map[TeamID][]Lifeline, len(qr.teams))
+}
+
+// 回答し直した場合は最後の回答で置き換える
+func (qr *questRoom) recordAnswer(uid uuid.UUID, answer Choice) {
+	record := qr.currentRecord()
+	if record == nil {
+		return
+	}
+	now := time.Now()
+	var responseTime time.Duration
+	if !record.CountdownStartedAt.IsZero() && now.After(record.CountdownStartedAt) {
+		responseTime = now.Sub(record.CountdownStartedAt)
+	}
+	ar := AnswerRecord{
+		UserID:       uid,
+		TeamID:       qr.userTeams[uid],
+		Answer:       answer,
+		IsCorrect:    answer.ChoiceID == record.CorrectAnswer.ChoiceID,
+		AnsweredAt:   now,
+		ResponseTime: responseTime,
+	}
+	if idx := slices.IndexFunc(record.Answers, func(a AnswerRecord) bool { return a.UserID == uid }); idx >= 0 {
+		record.Answers[idx] = ar
+		return
+	}
+	record.Answers = append(record.Answers, ar)
+}
+
+func (qr *questRoom) recordTeamAnswers(teamAnswers map[TeamID]Choice, answerMaps map[TeamID]map[uint]int) {
+	record := qr.currentRecord()
+	if record == nil {
+		return
+	}
+	record.CheckedAt = time.Now()
+	record.TeamAnswers = record.TeamAnswers[:0]
+	for _, tid := range slices.Sorted(maps.Keys(teamAnswers)) {
+		record.TeamAnswers = append(record.TeamAnswers, TeamAnswerRecord{
+			TeamID:         tid,
+			Answer:         teamAnswers[tid],
+			IsCorrect:      teamAnswers[tid].ChoiceID == record.CorrectAnswer.ChoiceID,
+			AnswerMap:      maps.Clone(answerMaps[tid]),
+			IsDoublePoints: qr.doublePoints[tid],
+			Lifelines:      slices.Clone(qr.usedLifelines[tid]),
+		})
+	}
+}
+
+func (qr *questRoom) RecordCountdownStarted() {
+	qr.mu.Lock()
+	defer qr.mu.Unlock()
+	if record := qr.currentRecord(); record != nil && record.CountdownStartedAt.IsZero() {
+		record.CountdownStartedAt = time.Now()
+	}
+}
+
+func (qr *questRoom) RecordHint(hint string) {
+	qr.mu.Lock()
+	defer qr.mu.Unlock()
+	if record := qr.currentRecord(); record != nil {
+		record.Hint = hint
+		record.HintTakenAt = time.Now()
+	}
+}
+
+func (qr *questRoom) GetLog() []QuizRecord {
+	qr.mu.RLock()
+	defer qr.mu.RUnlock()
+	log := make([]QuizRecord, 0, len(qr.log))
+	for _, record := range qr.log {
+		log = append(log, record.clone())
+	}
+	return log
+}
+
+// 記録から集計した参加者毎の成績
+type PlayerSummary struct {
+	UserID        uuid.UUID
+	TeamID        TeamID
+	AnsweredCount int
+	CorrectCount  int
+	// 回答時間の合計、平均を出すにはAnsweredCountで割る
+	TotalResponseTime time.Duration
+}
+
+func (ps PlayerSummary) AverageResponseTime() time.Duration {
+	if ps.AnsweredCount == 0 {
+		return 0
+	}
+	return ps.TotalResponseTime / time.Duration(ps.AnsweredCount)
+}
+
+// 記録から集計したチーム毎の成績
+type TeamSummary struct {
+	TeamID       TeamID
+	CorrectCount int
+	// ダブルポイントを加味した得点
+	Points int
+}
+
+// 出題中の記録からも集計できるように、GetAllStatsとは別に記録だけから数える
+func SummarizeQuestLog(log []QuizRecord) (map[uuid.UUID]PlayerSummary, map[TeamID]TeamSummary) {
+	players := make(map[uuid.UUID]PlayerSummary)
+	teams := make(map[TeamID]TeamSummary)
+	for _, record := range log {
+		for _, answer := range record.Answers {
+			ps := players[answer.UserID]
+			ps.UserID = answer.UserID
+			ps.TeamID = answer.TeamID
+			ps.AnsweredCount++
+			ps.TotalResponseTime += answer.ResponseTime
+			if answer.IsCorrect {
+				ps.CorrectCount++
+			}
+			players[answer.UserID] = ps
+		}
+		for _, teamAnswer := range record.TeamAnswers {
+			ts := teams[teamAnswer.TeamID]
+			ts.TeamID = teamAnswer.TeamID
+			if teamAnswer.IsCorrect {
+				ts.CorrectCount++
+				if teamAnswer.IsDoublePoints {
+					ts.Points += 2
+				} else {
+					ts.Points++
+				}
+			}
+			teams[teamAnswer.TeamID] = ts
+		}
+	}
+	return players, teams
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
+)
+
+// 参加者一人あたりに聞く質問の選び方
+type QuestionnairePolicy struct {
+	// 一人あたりの質問数、0以下の場合は全ての質問を聞く
+	questionsPerGuest int
+	// 並び順で先頭から数えて、全員に共通して聞く質問数
+	// 同じ質問に複数人が回答していないと選択肢が他人の回答で埋まらないので、最低限の重なりを保証する
+	// 一人あたりの質問数を超える場合は一人あたりの質問数に切り詰める
+	commonQuestions int
+}
+
+// questionIDsは質問の並び順で渡す
+// 共通の質問に加えて残りからランダムに選び、返す順番は元の並び順を保つ
+func (qp QuestionnairePolicy) Pick(questionIDs []uint) []uint {
+	if qp.questionsPerGuest <= 0 || qp.questionsPerGuest >= len(questionIDs) {
+		return slices.Clone(questionIDs)
+	}
+	common := min(qp.commonQuestions, qp.questionsPerGuest)
+	picked := slices.Clone(questionIDs[:common])
+	rest := util.ShuffleSlice(questionIDs[common:])
+	picked = append(picked, rest[:qp.questionsPerGuest-common]...)
+	slices.SortFunc(picked, func(a, b uint) int {
+		return slices.Index(questionIDs, a) - slices.Index(questionIDs, b)
+	})
+	return picked
+}
+
+func NewQuestionnairePolicy(questionsPerGuest int, commonQuestions int) (QuestionnairePolicy, error) {
+	if questionsPerGuest < 0 {
+		return QuestionnairePolicy{}, errors.New("Questions per guest must not be negative")
+	}
+	if commonQuestions < 0 {
+		return QuestionnairePolicy{}, errors.New("Common questions must not be negative")
+	}
+	return QuestionnairePolicy{
+		questionsPerGuest: questionsPerGuest,
+		commonQuestions:   commonQuestions,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+var testQuestionIDs = []uint{7, 3, 9, 1, 5, 8, 2}
+
+func TestQuestionnairePolicyPick(t *testing.T) {
+	tests := []struct {
+		name              string
+		questionsPerGuest int
+		commonQuestions   int
+		wantLen           int
+		wantCommon        []uint
+	}{
+		{name: "subset with common questions", questionsPerGuest: 4, commonQuestions: 2, wantLen: 4, wantCommon: []uint{7, 3}},
+		{name: "no common question", questionsPerGuest: 3, commonQuestions: 0, wantLen: 3},
+		{name: "common questions are cut to the count", questionsPerGuest: 2, commonQuestions: 5, wantLen: 2, wantCommon: []uint{7, 3}},
+		{name: "zero asks everything", questionsPerGuest: 0, commonQuestions: 2, wantLen: len(testQuestionIDs), wantCommon: testQuestionIDs},
+		{name: "more than the bank asks everything", questionsPerGuest: 10, commonQuestions: 2, wantLen: len(testQuestionIDs), wantCommon: testQuestionIDs},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewQuestionnairePolicy(tt.questionsPerGuest, tt.commonQuestions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 選び方はランダムなので何度か試す
+			for range 50 {
+				picked := policy.Pick(testQuestionIDs)
+				if len(picked) != tt.wantLen {
+					t.Fatalf("Pick() = %v, want %d questions", picked, tt.wantLen)
+				}
+				for _, qid := range tt.wantCommon {
+					if !slices.Contains(picked, qid) {
+						t.Fatalf("Pick() = %v, want it to contain common question %d", picked, qid)
+					}
+				}
+				// 渡した質問だけを、重複無く元の並び順で返すこと
+				last := -1
+				for _, qid := range picked {
+					idx := slices.Index(testQuestionIDs, qid)
+					if idx <= last {
+						t.Fatalf("Pick() = %v, which is not an ordered subset of %v", picked, testQuestionIDs)
+					}
+					last = idx
+				}
+			}
+		})
+	}
+}
+
+// 共通の質問以外は参加者によって違う質問が選ばれ得ること
+func TestQuestionnairePolicyPickVaries(t *testing.T) {
+	policy, err := NewQuestionnairePolicy(3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[uint]bool)
+	for range 200 {
+		for _, qid := range policy.Pick(testQuestionIDs) {
+			seen[qid] = true
+		}
+	}
+	if len(seen) != len(testQuestionIDs) {
+		t.Errorf("only %d of %d questions were ever picked", len(seen), len(testQuestionIDs))
+	}
+}
+
+func TestQuestionnairePolicyPickDoesNotModifyInput(t *testing.T) {
+	ids := slices.Clone(testQuestionIDs)
+	policy, err := NewQuestionnairePolicy(3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy.Pick(ids)
+	if !slices.Equal(ids, testQuestionIDs) {
+		t.Errorf("Pick() changed its input to %v", ids)
+	}
+}
+
+func TestNewQuestionnairePolicyRejectsNegative(t *testing.T) {
+	if _, err := NewQuestionnairePolicy(-1, 0); err == nil {
+		t.Error("NewQuestionnairePolicy(-1, 0) error = nil, want error")
+	}
+	if _, err := NewQuestionnairePolicy(3, -1); err == nil {
+		t.Error("NewQuestionnairePolicy(3, -1) error = nil, want error")
+	}
+}
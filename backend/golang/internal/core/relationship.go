@@ -0,0 +1,182 @@
+package core
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// 回答した人が出題対象の人についてどれだけ正解したか
+type PairStats struct {
+	AnswererID    uuid.UUID
+	TargetID      uuid.UUID
+	AnsweredCount int
+	CorrectCount  int
+}
+
+func (ps PairStats) CorrectRate() float32 {
+	return correctRate(ps.CorrectCount, ps.AnsweredCount)
+}
+
+// 出題対象になった人が周りからどれだけ知られていたか
+type TargetStats struct {
+	TargetID uuid.UUID
+	// 出題対象になった回数
+	QuizCount     int
+	AnsweredCount int
+	CorrectCount  int
+}
+
+func (ts TargetStats) CorrectRate() float32 {
+	return correctRate(ts.CorrectCount, ts.AnsweredCount)
+}
+
+// 質問毎の正解のしやすさ、同じ質問が別の人について出題された分もまとめる
+type QuestionStats struct {
+	QuestionID    uint
+	QuestionText  string
+	QuizCount     int
+	AnsweredCount int
+	CorrectCount  int
+}
+
+func (qs QuestionStats) CorrectRate() float32 {
+	return correctRate(qs.CorrectCount, qs.AnsweredCount)
+}
+
+type Relationships struct {
+	// 回答した人、出題対象の人の順に並べる
+	Pairs []PairStats
+	// 出題対象になった人だけ、出題順に並べる
+	Targets []TargetStats
+	// 出題順に並べる
+	Questions []QuestionStats
+}
+
+func correctRate(correct int, answered int) float32 {
+	if answered == 0 {
+		return 0
+	}
+	return float32(correct) / float32(answered)
+}
+
+// 記録から誰が誰をどれだけ知っているかを集計する
+// 自分についての出題への回答は、知っていて当然なので数えない
+func AnalyzeRelationships(log []QuizRecord) Relationships {
+	type pairKey struct {
+		answerer uuid.UUID
+		target   uuid.UUID
+	}
+	pairs := make(map[pairKey]PairStats)
+	pairOrder := make([]pairKey, 0)
+	targets := make(map[uuid.UUID]TargetStats)
+	targetOrder := make([]uuid.UUID, 0)
+	questions := make(map[uint]QuestionStats)
+	questionOrder := make([]uint, 0)
+
+	for _, record := range log {
+		ts, ok := targets[record.TargetUserID]
+		if !ok {
+			ts.TargetID = record.TargetUserID
+			targetOrder = append(targetOrder, record.TargetUserID)
+		}
+		ts.QuizCount++
+		qs, ok := questions[record.QuestionID]
+		if !ok {
+			qs.QuestionID = record.QuestionID
+			qs.QuestionText = record.QuestionText
+			questionOrder = append(questionOrder, record.QuestionID)
+		}
+		qs.QuizCount++
+
+		for _, answer := range record.Answers {
+			if answer.UserID == record.TargetUserID {
+				continue
+			}
+			key := pairKey{answerer: answer.UserID, target: record.TargetUserID}
+			ps, ok := pairs[key]
+			if !ok {
+				ps.AnswererID = answer.UserID
+				ps.TargetID = record.TargetUserID
+				pairOrder = append(pairOrder, key)
+			}
+			ps.AnsweredCount++
+			ts.AnsweredCount++
+			qs.AnsweredCount++
+			if answer.IsCorrect {
+				ps.CorrectCount++
+				ts.CorrectCount++
+				qs.CorrectCount++
+			}
+			pairs[key] = ps
+		}
+		targets[record.TargetUserID] = ts
+		questions[record.QuestionID] = qs
+	}
+
+	// 回答した人毎にまとまるように並べ替える、同じ人の中では出題順のまま
+	answererOrder := make([]uuid.UUID, 0)
+	for _, key := range pairOrder {
+		if !slices.Contains(answererOrder, key.answerer) {
+			answererOrder = append(answererOrder, key.answerer)
+		}
+	}
+	slices.SortStableFunc(pairOrder, func(a, b pairKey) int {
+		return slices.Index(answererOrder, a.answerer) - slices.Index(answererOrder, b.answerer)
+	})
+
+	relationships := Relationships{
+		Pairs:     make([]PairStats, 0, len(pairs)),
+		Targets:   make([]TargetStats, 0, len(targets)),
+		Questions: make([]QuestionStats, 0, len(questions)),
+	}
+	for _, key := range pairOrder {
+		relationships.Pairs = append(relationships.Pairs, pairs[key])
+	}
+	for _, tid := range targetOrder {
+		relationships.Targets = append(relationships.Targets, targets[tid])
+	}
+	for _, qid := range questionOrder {
+		relationships.Questions = append(relationships.Questions, questions[qid])
+	}
+	return relationships
+}
+
+// 正解率の高い順に並べる、同じ正解率なら回答の多い方を上にする
+// 回答が一つも無いものは比べようが無いので除く
+func rankByCorrectRate[T interface {
+	CorrectRate() float32
+}](items []T, answered func(T) int, desc bool) []T {
+	ranked := slices.DeleteFunc(slices.Clone(items), func(item T) bool { return answered(item) == 0 })
+	slices.SortStableFunc(ranked, func(a, b T) int {
+		ra, rb := a.CorrectRate(), b.CorrectRate()
+		if ra != rb {
+			if (ra > rb) == desc {
+				return -1
+			}
+			return 1
+		}
+		return answered(b) - answered(a)
+	})
+	return ranked
+}
+
+// よく知られている人から順に並べる
+func (r Relationships) MostKnown() []TargetStats {
+	return rankByCorrectRate(r.Targets, func(ts TargetStats) int { return ts.AnsweredCount }, true)
+}
+
+// 知られていない人から順に並べる
+func (r Relationships) LeastKnown() []TargetStats {
+	return rankByCorrectRate(r.Targets, func(ts TargetStats) int { return ts.AnsweredCount }, false)
+}
+
+// 正解率の低い質問から順に並べる
+func (r Relationships) HardestQuestions() []QuestionStats {
+	return rankByCorrectRate(r.Questions, func(qs QuestionStats) int { return qs.AnsweredCount }, false)
+}
+
+// 回答した人がよく知っている相手から順に並べる、一度も答えていない組は除く
+func RankKnownPairs(pairs []PairStats) []PairStats {
+	return rankByCorrectRate(pairs, func(ps PairStats) int { return ps.AnsweredCount }, true)
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	userA = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	userB = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	userC = uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+	userD = uuid.MustParse("00000000-0000-0000-0000-00000000000d")
+)
+
+// Aさん、Bさんはチーム1、Cさん、Dさんはチーム2
+func teamOf(uid uuid.UUID) TeamID {
+	if uid == userA || uid == userB {
+		return 1
+	}
+	return 2
+}
+
+var testStartedAt = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// 答え合わせまで終わったクイズの記録
+func checkedQuiz(target uuid.UUID, questionID uint, answers ...AnswerRecord) QuizRecord {
+	return QuizRecord{
+		TargetUserID: target,
+		TargetTeamID: teamOf(target),
+		QuestionID:   questionID,
+		QuestionText: "Q" + string(rune('0'+questionID)),
+		StartedAt:    testStartedAt,
+		CheckedAt:    testStartedAt.Add(time.Minute),
+		Answers:      answers,
+	}
+}
+
+// 出題からafter後に送った回答
+func answerOf(uid uuid.UUID, isCorrect bool, after time.Duration) AnswerRecord {
+	return AnswerRecord{
+		UserID:       uid,
+		TeamID:       teamOf(uid),
+		IsCorrect:    isCorrect,
+		AnsweredAt:   testStartedAt.Add(after),
+		ResponseTime: after,
+	}
+}
+
+func TestAnalyzeRelationshipsEmpty(t *testing.T) {
+	r := AnalyzeRelationships(nil)
+	if r.Pairs == nil || r.Targets == nil || r.Questions == nil {
+		t.Errorf("AnalyzeRelationships(nil) = %+v, want empty slices rather than nil", r)
+	}
+}
+
+// 自分についての出題に答えても、誰かを知っていることにはならない
+func TestAnalyzeRelationshipsSkipsOwnAnswers(t *testing.T) {
+	r := AnalyzeRelationships([]QuizRecord{
+		checkedQuiz(userA, 1, answerOf(userA, true, time.Second), answerOf(userB, false, time.Second)),
+	})
+	if len(r.Pairs) != 1 || r.Pairs[0].AnswererID != userB {
+		t.Fatalf("Pairs = %+v, want only B about A", r.Pairs)
+	}
+	if got := r.Targets[0]; got.QuizCount != 1 || got.AnsweredCount != 1 || got.CorrectCount != 0 {
+		t.Errorf("Targets[0] = %+v, want A asked once and answered once by someone else", got)
+	}
+	if got := r.Questions[0]; got.AnsweredCount != 1 {
+		t.Errorf("Questions[0].AnsweredCount = %d, want 1", got.AnsweredCount)
+	}
+}
+
+// 組は回答した人毎にまとまり、同じ人の中は出題順、出題対象と質問は出題順のまま
+func TestAnalyzeRelationshipsOrderAndCounts(t *testing.T) {
+	r := AnalyzeRelationships([]QuizRecord{
+		checkedQuiz(userA, 1, answerOf(userB, true, time.Second), answerOf(userC, false, 2*time.Second)),
+		checkedQuiz(userC, 2, answerOf(userA, true, time.Second), answerOf(userB, true, time.Second)),
+		checkedQuiz(userA, 1, answerOf(userB, false, time.Second)),
+		checkedQuiz(userD, 3),
+	})
+
+	type pair struct{ answerer, target uuid.UUID }
+	gotPairs := make([]pair, 0, len(r.Pairs))
+	for _, ps := range r.Pairs {
+		gotPairs = append(gotPairs, pair{ps.AnswererID, ps.TargetID})
+	}
+	wantPairs := []pair{{userB, userA}, {userB, userC}, {userC, userA}, {userA, userC}}
+	if !slices.Equal(gotPairs, wantPairs) {
+		t.Errorf("pair order = %v, want %v", gotPairs, wantPairs)
+	}
+	if ba := r.Pairs[0]; ba.AnsweredCount != 2 || ba.CorrectCount != 1 || ba.CorrectRate() != 0.5 {
+		t.Errorf("B about A = %+v (rate %v), want 1 of 2", ba, ba.CorrectRate())
+	}
+
+	if got, want := r.Targets, []TargetStats{
+		{TargetID: userA, QuizCount: 2, AnsweredCount: 3, CorrectCount: 1},
+		{TargetID: userC, QuizCount: 1, AnsweredCount: 2, CorrectCount: 2},
+		{TargetID: userD, QuizCount: 1},
+	}; !slices.Equal(got, want) {
+		t.Errorf("Targets = %+v, want %+v", got, want)
+	}
+	if got, want := r.Questions, []QuestionStats{
+		{QuestionID: 1, QuestionText: "Q1", QuizCount: 2, AnsweredCount: 3, CorrectCount: 1},
+		{QuestionID: 2, QuestionText: "Q2", QuizCount: 1, AnsweredCount: 2, CorrectCount: 2},
+		{QuestionID: 3, QuestionText: "Q3", QuizCount: 1},
+	}; !slices.Equal(got, want) {
+		t.Errorf("Questions = %+v, want %+v", got, want)
+	}
+	if rate := r.Targets[2].CorrectRate(); rate != 0 {
+		t.Errorf("CorrectRate() without answers = %v, want 0", rate)
+	}
+}
+
+func TestRelationshipsRanking(t *testing.T) {
+	r := Relationships{
+		Targets: []TargetStats{
+			{TargetID: userA, AnsweredCount: 2, CorrectCount: 1},
+			{TargetID: userB, AnsweredCount: 4, CorrectCount: 2},
+			{TargetID: userC, AnsweredCount: 3, CorrectCount: 3},
+			{TargetID: userD},
+		},
+		Questions: []QuestionStats{
+			{QuestionID: 1, AnsweredCount: 4, CorrectCount: 3},
+			{QuestionID: 2, AnsweredCount: 4, CorrectCount: 1},
+			{QuestionID: 3},
+		},
+	}
+	ids := func(stats []TargetStats) []uuid.UUID {
+		ids := make([]uuid.UUID, 0, len(stats))
+		for _, ts := range stats {
+			ids = append(ids, ts.TargetID)
+		}
+		return ids
+	}
+
+	// 回答の無いDさんは除き、AさんとBさんは同じ正解率なので回答の多いBさんが上
+	if got, want := ids(r.MostKnown()), []uuid.UUID{userC, userB, userA}; !slices.Equal(got, want) {
+		t.Errorf("MostKnown() = %v, want %v", got, want)
+	}
+	if got, want := ids(r.LeastKnown()), []uuid.UUID{userB, userA, userC}; !slices.Equal(got, want) {
+		t.Errorf("LeastKnown() = %v, want %v", got, want)
+	}
+	hardest := r.HardestQuestions()
+	if len(hardest) != 2 || hardest[0].QuestionID != 2 || hardest[1].QuestionID != 1 {
+		t.Errorf("HardestQuestions() = %+v, want questions 2 then 1", hardest)
+	}
+	if len(r.Targets) != 4 {
+		t.Errorf("ranking changed the original targets to %+v", r.Targets)
+	}
+}
+
+// 一度も答えていない相手は除き、同じ正解率なら回答の多い相手を上にする
+func TestRankKnownPairs(t *testing.T) {
+	pairs := []PairStats{
+		{AnswererID: userD, TargetID: userA, AnsweredCount: 2, CorrectCount: 1},
+		{AnswererID: userD, TargetID: userB},
+		{AnswererID: userD, TargetID: userC, AnsweredCount: 4, CorrectCount: 2},
+	}
+	if got, want := RankKnownPairs(pairs), []PairStats{pairs[2], pairs[0]}; !slices.Equal(got, want) {
+		t.Errorf("RankKnownPairs() = %+v, want %+v", got, want)
+	}
+	if pairs[1].TargetID != userB {
+		t.Errorf("RankKnownPairs() changed the original pairs to %+v", pairs)
+	}
+}
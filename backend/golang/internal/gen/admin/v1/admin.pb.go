@@ -7,6 +7,7 @@
 package adminv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	v1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -144,11 +145,14 @@ func (x *User) GetIsReady() bool {
 }
 
 type OpenEntryResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	EnteredUsers    []*User                `protobuf:"bytes,1,rep,name=entered_users,json=enteredUsers,proto3" json:"entered_users,omitempty"`
-	ExpectedUserNum int32                  `protobuf:"varint,2,opt,name=expected_user_num,json=expectedUserNum,proto3" json:"expected_user_num,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	EnteredUsers []*User                `protobuf:"bytes,1,rep,name=entered_users,json=enteredUsers,proto3" json:"entered_users,omitempty"`
+	// 0の場合は未定
+	ExpectedUserNum int32 `protobuf:"varint,2,opt,name=expected_user_num,json=expectedUserNum,proto3" json:"expected_user_num,omitempty"`
+	// 0の場合は無制限
+	MaxUserNum    int32 `protobuf:"varint,3,opt,name=max_user_num,json=maxUserNum,proto3" json:"max_user_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OpenEntryResponse) Reset() {
@@ -195,6 +199,13 @@ func (x *OpenEntryResponse) GetExpectedUserNum() int32 {
 	return 0
 }
 
+func (x *OpenEntryResponse) GetMaxUserNum() int32 {
+	if x != nil {
+		return x.MaxUserNum
+	}
+	return 0
+}
+
 type RejectUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -300,8 +311,10 @@ type StartQuestResponse struct {
 	Choices           []*v1.Choice           `protobuf:"bytes,5,rep,name=choices,proto3" json:"choices,omitempty"`
 	LastTime          int32                  `protobuf:"varint,6,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
 	HintText          string                 `protobuf:"bytes,7,opt,name=hint_text,json=hintText,proto3" json:"hint_text,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// 認証ヘッダ無しで画像を取得できる、期限付きの署名付きURL
+	TargetUserImageUrl string `protobuf:"bytes,8,opt,name=target_user_image_url,json=targetUserImageUrl,proto3" json:"target_user_image_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *StartQuestResponse) Reset() {
@@ -383,6 +396,13 @@ func (x *StartQuestResponse) GetHintText() string {
 	return ""
 }
 
+func (x *StartQuestResponse) GetTargetUserImageUrl() string {
+	if x != nil {
+		return x.TargetUserImageUrl
+	}
+	return ""
+}
+
 type TeamAnswer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeamId        uint32                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
@@ -643,6 +663,7 @@ type EndQuestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        v1.Result              `protobuf:"varint,1,opt,name=result,proto3,enum=common.v1.Result" json:"result,omitempty"`
 	Stats         []*TeamStats           `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	Awards        []*v1.Award            `protobuf:"bytes,3,rep,name=awards,proto3" json:"awards,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -691,77 +712,2217 @@ func (x *EndQuestResponse) GetStats() []*TeamStats {
 	return nil
 }
 
-var File_admin_v1_admin_proto protoreflect.FileDescriptor
+func (x *EndQuestResponse) GetAwards() []*v1.Award {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
 
-const file_admin_v1_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x14admin/v1/admin.proto\x12\badmin.v1\x1a\x16common/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"G\n" +
-	"\x17RegistAdminUserResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
-	"\x06secret\x18\x02 \x01(\tR\x06secret\"p\n" +
-	"\x04User\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x17\n" +
-	"\ateam_id\x18\x03 \x01(\rR\x06teamId\x12\x19\n" +
-	"\bis_ready\x18\x04 \x01(\bR\aisReady\"t\n" +
-	"\x11OpenEntryResponse\x123\n" +
-	"\rentered_users\x18\x01 \x03(\v2\x0e.admin.v1.UserR\fenteredUsers\x12*\n" +
-	"\x11expected_user_num\x18\x02 \x01(\x05R\x0fexpectedUserNum\",\n" +
-	"\x11RejectUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
-	"\x11ChangeTeamRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
-	"\vnew_team_id\x18\x02 \x01(\rR\tnewTeamId\"\x8f\x02\n" +
-	"\x12StartQuestResponse\x12/\n" +
-	"\x14target_user_image_id\x18\x01 \x01(\tR\x11targetUserImageId\x12$\n" +
-	"\x0etarget_team_id\x18\x02 \x01(\rR\ftargetTeamId\x12\x1f\n" +
-	"\vquestion_id\x18\x03 \x01(\rR\n" +
-	"questionId\x12\x1a\n" +
-	"\bquestion\x18\x04 \x01(\tR\bquestion\x12+\n" +
-	"\achoices\x18\x05 \x03(\v2\x11.common.v1.ChoiceR\achoices\x12\x1b\n" +
-	"\tlast_time\x18\x06 \x01(\x05R\blastTime\x12\x1b\n" +
-	"\thint_text\x18\a \x01(\tR\bhintText\"\x8e\x01\n" +
-	"\n" +
-	"TeamAnswer\x12\x17\n" +
-	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x1d\n" +
-	"\n" +
-	"team_color\x18\x04 \x01(\tR\tteamColor\x12)\n" +
-	"\x06answer\x18\x02 \x01(\v2\x11.common.v1.ChoiceR\x06answer\x12\x1d\n" +
-	"\n" +
-	"is_correct\x18\x03 \x01(\bR\tisCorrect\"\x80\x01\n" +
-	"\x14CheckAnswersResponse\x12.\n" +
-	"\aanswers\x18\x01 \x03(\v2\x14.admin.v1.TeamAnswerR\aanswers\x128\n" +
-	"\x0ecorrect_choice\x18\x02 \x01(\v2\x11.common.v1.ChoiceR\rcorrectChoice\"r\n" +
-	"\tUserStats\x12\x1b\n" +
-	"\tuser_name\x18\x01 \x01(\tR\buserName\x12!\n" +
-	"\fcorrect_rate\x18\x02 \x01(\x02R\vcorrectRate\x12%\n" +
-	"\x0epersonal_order\x18\x03 \x01(\rR\rpersonalOrder\"\xc8\x01\n" +
-	"\tTeamStats\x12\x17\n" +
-	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x1d\n" +
-	"\n" +
-	"team_color\x18\x05 \x01(\tR\tteamColor\x128\n" +
-	"\rmembers_stats\x18\x02 \x03(\v2\x13.admin.v1.UserStatsR\fmembersStats\x12*\n" +
-	"\x11team_correct_rate\x18\x03 \x01(\x02R\x0fteamCorrectRate\x12\x1d\n" +
-	"\n" +
-	"team_order\x18\x04 \x01(\rR\tteamOrder\"h\n" +
-	"\x10EndQuestResponse\x12)\n" +
-	"\x06result\x18\x01 \x01(\x0e2\x11.common.v1.ResultR\x06result\x12)\n" +
-	"\x05stats\x18\x02 \x03(\v2\x13.admin.v1.TeamStatsR\x05stats2\xab\x05\n" +
-	"\fAdminService\x12L\n" +
-	"\x0fRegistAdminUser\x12\x16.google.protobuf.Empty\x1a!.admin.v1.RegistAdminUserResponse\x12B\n" +
-	"\tOpenEntry\x12\x16.google.protobuf.Empty\x1a\x1b.admin.v1.OpenEntryResponse0\x01\x12<\n" +
-	"\n" +
-	"CloseEntry\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12A\n" +
-	"\n" +
-	"RejectUser\x12\x1b.admin.v1.RejectUserRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
-	"\n" +
-	"ChangeTeam\x12\x1b.admin.v1.ChangeTeamRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
-	"\n" +
-	"StartQuest\x12\x16.google.protobuf.Empty\x1a\x1c.admin.v1.StartQuestResponse0\x01\x12;\n" +
-	"\tReadyQuiz\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12F\n" +
-	"\fCheckAnswers\x12\x16.google.protobuf.Empty\x1a\x1e.admin.v1.CheckAnswersResponse\x12:\n" +
-	"\bNextQuiz\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12>\n" +
-	"\bEndQuest\x12\x16.google.protobuf.Empty\x1a\x1a.admin.v1.EndQuestResponseBTZRgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1;adminv1b\x06proto3"
+type ProfileQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	QuizText      string                 `protobuf:"bytes,3,opt,name=quiz_text,json=quizText,proto3" json:"quiz_text,omitempty"`
+	SampleAnswers []string               `protobuf:"bytes,4,rep,name=sample_answers,json=sampleAnswers,proto3" json:"sample_answers,omitempty"`
+	IsOptional    bool                   `protobuf:"varint,5,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProfileQuestion) Reset() {
+	*x = ProfileQuestion{}
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileQuestion) ProtoMessage() {}
+
+func (x *ProfileQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileQuestion.ProtoReflect.Descriptor instead.
+func (*ProfileQuestion) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProfileQuestion) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ProfileQuestion) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *ProfileQuestion) GetQuizText() string {
+	if x != nil {
+		return x.QuizText
+	}
+	return ""
+}
+
+func (x *ProfileQuestion) GetSampleAnswers() []string {
+	if x != nil {
+		return x.SampleAnswers
+	}
+	return nil
+}
+
+func (x *ProfileQuestion) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+type ListQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*ProfileQuestion     `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionsResponse) Reset() {
+	*x = ListQuestionsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionsResponse) ProtoMessage() {}
+
+func (x *ListQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListQuestionsResponse) GetQuestions() []*ProfileQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type DeleteQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuestionRequest) Reset() {
+	*x = DeleteQuestionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteQuestionRequest) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+type ReorderQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionIds   []uint32               `protobuf:"varint,1,rep,packed,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderQuestionsRequest) Reset() {
+	*x = ReorderQuestionsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderQuestionsRequest) ProtoMessage() {}
+
+func (x *ReorderQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReorderQuestionsRequest) GetQuestionIds() []uint32 {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+type FlaggedAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	QuestionId    uint32                 `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,4,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	Answer        string                 `protobuf:"bytes,5,opt,name=answer,proto3" json:"answer,omitempty"`
+	MatchedTerm   string                 `protobuf:"bytes,6,opt,name=matched_term,json=matchedTerm,proto3" json:"matched_term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlaggedAnswer) Reset() {
+	*x = FlaggedAnswer{}
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlaggedAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlaggedAnswer) ProtoMessage() {}
+
+func (x *FlaggedAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlaggedAnswer.ProtoReflect.Descriptor instead.
+func (*FlaggedAnswer) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FlaggedAnswer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FlaggedAnswer) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *FlaggedAnswer) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *FlaggedAnswer) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *FlaggedAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *FlaggedAnswer) GetMatchedTerm() string {
+	if x != nil {
+		return x.MatchedTerm
+	}
+	return ""
+}
+
+type ListFlaggedAnswersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answers       []*FlaggedAnswer       `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedAnswersResponse) Reset() {
+	*x = ListFlaggedAnswersResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedAnswersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedAnswersResponse) ProtoMessage() {}
+
+func (x *ListFlaggedAnswersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedAnswersResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedAnswersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListFlaggedAnswersResponse) GetAnswers() []*FlaggedAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type EditUserAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuestionId    uint32                 `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditUserAnswerRequest) Reset() {
+	*x = EditUserAnswerRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditUserAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditUserAnswerRequest) ProtoMessage() {}
+
+func (x *EditUserAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditUserAnswerRequest.ProtoReflect.Descriptor instead.
+func (*EditUserAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EditUserAnswerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EditUserAnswerRequest) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *EditUserAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type RemoveUserAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuestionId    uint32                 `protobuf:"varint,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserAnswerRequest) Reset() {
+	*x = RemoveUserAnswerRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserAnswerRequest) ProtoMessage() {}
+
+func (x *RemoveUserAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserAnswerRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveUserAnswerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemoveUserAnswerRequest) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+type RemoveUserImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserImageRequest) Reset() {
+	*x = RemoveUserImageRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveUserImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserImageRequest) ProtoMessage() {}
+
+func (x *RemoveUserImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserImageRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveUserImageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ResultThresholds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Excellent     float32                `protobuf:"fixed32,1,opt,name=excellent,proto3" json:"excellent,omitempty"`
+	Great         float32                `protobuf:"fixed32,2,opt,name=great,proto3" json:"great,omitempty"`
+	GoodJob       float32                `protobuf:"fixed32,3,opt,name=good_job,json=goodJob,proto3" json:"good_job,omitempty"`
+	Clear         float32                `protobuf:"fixed32,4,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultThresholds) Reset() {
+	*x = ResultThresholds{}
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultThresholds) ProtoMessage() {}
+
+func (x *ResultThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultThresholds.ProtoReflect.Descriptor instead.
+func (*ResultThresholds) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResultThresholds) GetExcellent() float32 {
+	if x != nil {
+		return x.Excellent
+	}
+	return 0
+}
+
+func (x *ResultThresholds) GetGreat() float32 {
+	if x != nil {
+		return x.Great
+	}
+	return 0
+}
+
+func (x *ResultThresholds) GetGoodJob() float32 {
+	if x != nil {
+		return x.GoodJob
+	}
+	return 0
+}
+
+func (x *ResultThresholds) GetClear() float32 {
+	if x != nil {
+		return x.Clear
+	}
+	return 0
+}
+
+// min_countは賞によって、受賞に必要な回答数、点差、連続正解数になる
+type AwardRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MinCount      int32                  `protobuf:"varint,2,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AwardRule) Reset() {
+	*x = AwardRule{}
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AwardRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwardRule) ProtoMessage() {}
+
+func (x *AwardRule) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwardRule.ProtoReflect.Descriptor instead.
+func (*AwardRule) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AwardRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AwardRule) GetMinCount() int32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+type AwardRules struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FastestAnswerer *AwardRule             `protobuf:"bytes,1,opt,name=fastest_answerer,json=fastestAnswerer,proto3" json:"fastest_answerer,omitempty"`
+	MostMysterious  *AwardRule             `protobuf:"bytes,2,opt,name=most_mysterious,json=mostMysterious,proto3" json:"most_mysterious,omitempty"`
+	BestHintGiver   *AwardRule             `protobuf:"bytes,3,opt,name=best_hint_giver,json=bestHintGiver,proto3" json:"best_hint_giver,omitempty"`
+	ComebackTeam    *AwardRule             `protobuf:"bytes,4,opt,name=comeback_team,json=comebackTeam,proto3" json:"comeback_team,omitempty"`
+	PerfectStreak   *AwardRule             `protobuf:"bytes,5,opt,name=perfect_streak,json=perfectStreak,proto3" json:"perfect_streak,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AwardRules) Reset() {
+	*x = AwardRules{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AwardRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwardRules) ProtoMessage() {}
+
+func (x *AwardRules) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwardRules.ProtoReflect.Descriptor instead.
+func (*AwardRules) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AwardRules) GetFastestAnswerer() *AwardRule {
+	if x != nil {
+		return x.FastestAnswerer
+	}
+	return nil
+}
+
+func (x *AwardRules) GetMostMysterious() *AwardRule {
+	if x != nil {
+		return x.MostMysterious
+	}
+	return nil
+}
+
+func (x *AwardRules) GetBestHintGiver() *AwardRule {
+	if x != nil {
+		return x.BestHintGiver
+	}
+	return nil
+}
+
+func (x *AwardRules) GetComebackTeam() *AwardRule {
+	if x != nil {
+		return x.ComebackTeam
+	}
+	return nil
+}
+
+func (x *AwardRules) GetPerfectStreak() *AwardRule {
+	if x != nil {
+		return x.PerfectStreak
+	}
+	return nil
+}
+
+type GameRules struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CountdownSeconds int32                  `protobuf:"varint,1,opt,name=countdown_seconds,json=countdownSeconds,proto3" json:"countdown_seconds,omitempty"`
+	HintBonusSeconds int32                  `protobuf:"varint,2,opt,name=hint_bonus_seconds,json=hintBonusSeconds,proto3" json:"hint_bonus_seconds,omitempty"`
+	MaxHintLength    int32                  `protobuf:"varint,3,opt,name=max_hint_length,json=maxHintLength,proto3" json:"max_hint_length,omitempty"`
+	AnswerTimeoutMs  int64                  `protobuf:"varint,4,opt,name=answer_timeout_ms,json=answerTimeoutMs,proto3" json:"answer_timeout_ms,omitempty"`
+	MaxChoiceNum     int32                  `protobuf:"varint,5,opt,name=max_choice_num,json=maxChoiceNum,proto3" json:"max_choice_num,omitempty"`
+	LobbyTickMs      int64                  `protobuf:"varint,6,opt,name=lobby_tick_ms,json=lobbyTickMs,proto3" json:"lobby_tick_ms,omitempty"`
+	MinTeamUser      int32                  `protobuf:"varint,7,opt,name=min_team_user,json=minTeamUser,proto3" json:"min_team_user,omitempty"`
+	ResultThresholds *ResultThresholds      `protobuf:"bytes,8,opt,name=result_thresholds,json=resultThresholds,proto3" json:"result_thresholds,omitempty"`
+	Awards           *AwardRules            `protobuf:"bytes,9,opt,name=awards,proto3" json:"awards,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GameRules) Reset() {
+	*x = GameRules{}
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRules) ProtoMessage() {}
+
+func (x *GameRules) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRules.ProtoReflect.Descriptor instead.
+func (*GameRules) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GameRules) GetCountdownSeconds() int32 {
+	if x != nil {
+		return x.CountdownSeconds
+	}
+	return 0
+}
+
+func (x *GameRules) GetHintBonusSeconds() int32 {
+	if x != nil {
+		return x.HintBonusSeconds
+	}
+	return 0
+}
+
+func (x *GameRules) GetMaxHintLength() int32 {
+	if x != nil {
+		return x.MaxHintLength
+	}
+	return 0
+}
+
+func (x *GameRules) GetAnswerTimeoutMs() int64 {
+	if x != nil {
+		return x.AnswerTimeoutMs
+	}
+	return 0
+}
+
+func (x *GameRules) GetMaxChoiceNum() int32 {
+	if x != nil {
+		return x.MaxChoiceNum
+	}
+	return 0
+}
+
+func (x *GameRules) GetLobbyTickMs() int64 {
+	if x != nil {
+		return x.LobbyTickMs
+	}
+	return 0
+}
+
+func (x *GameRules) GetMinTeamUser() int32 {
+	if x != nil {
+		return x.MinTeamUser
+	}
+	return 0
+}
+
+func (x *GameRules) GetResultThresholds() *ResultThresholds {
+	if x != nil {
+		return x.ResultThresholds
+	}
+	return nil
+}
+
+func (x *GameRules) GetAwards() *AwardRules {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+// 指定しなかった項目は変更しない
+type SetEntryLimitsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExpectedUserNum *int32                 `protobuf:"varint,1,opt,name=expected_user_num,json=expectedUserNum,proto3,oneof" json:"expected_user_num,omitempty"`
+	MaxUserNum      *int32                 `protobuf:"varint,2,opt,name=max_user_num,json=maxUserNum,proto3,oneof" json:"max_user_num,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetEntryLimitsRequest) Reset() {
+	*x = SetEntryLimitsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntryLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntryLimitsRequest) ProtoMessage() {}
+
+func (x *SetEntryLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntryLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetEntryLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetEntryLimitsRequest) GetExpectedUserNum() int32 {
+	if x != nil && x.ExpectedUserNum != nil {
+		return *x.ExpectedUserNum
+	}
+	return 0
+}
+
+func (x *SetEntryLimitsRequest) GetMaxUserNum() int32 {
+	if x != nil && x.MaxUserNum != nil {
+		return *x.MaxUserNum
+	}
+	return 0
+}
+
+type EntryLimits struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExpectedUserNum int32                  `protobuf:"varint,1,opt,name=expected_user_num,json=expectedUserNum,proto3" json:"expected_user_num,omitempty"`
+	MaxUserNum      int32                  `protobuf:"varint,2,opt,name=max_user_num,json=maxUserNum,proto3" json:"max_user_num,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EntryLimits) Reset() {
+	*x = EntryLimits{}
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EntryLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryLimits) ProtoMessage() {}
+
+func (x *EntryLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryLimits.ProtoReflect.Descriptor instead.
+func (*EntryLimits) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EntryLimits) GetExpectedUserNum() int32 {
+	if x != nil {
+		return x.ExpectedUserNum
+	}
+	return 0
+}
+
+func (x *EntryLimits) GetMaxUserNum() int32 {
+	if x != nil {
+		return x.MaxUserNum
+	}
+	return 0
+}
+
+// ユーザ、チーム、プロフィールの回答、出題、回答、成績をJSONとCSVで、画像と合わせてZIPにまとめたものを分割して送る
+// 受け取った順にchunkを繋げるとZIPになる
+type ExportSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 最初のメッセージにだけ入る
+	FileName      string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportSessionResponse) Reset() {
+	*x = ExportSessionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSessionResponse) ProtoMessage() {}
+
+func (x *ExportSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSessionResponse.ProtoReflect.Descriptor instead.
+func (*ExportSessionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExportSessionResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportSessionResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type GetHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0の場合は既定の件数
+	Limit         uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryRequest) Reset() {
+	*x = GetHistoryRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryRequest) ProtoMessage() {}
+
+func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetHistoryRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetHistoryRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type HistoryTeam struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        uint32                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamColor     string                 `protobuf:"bytes,2,opt,name=team_color,json=teamColor,proto3" json:"team_color,omitempty"`
+	CorrectCount  uint32                 `protobuf:"varint,3,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	Points        uint32                 `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	CorrectRate   float32                `protobuf:"fixed32,5,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	TeamOrder     uint32                 `protobuf:"varint,6,opt,name=team_order,json=teamOrder,proto3" json:"team_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryTeam) Reset() {
+	*x = HistoryTeam{}
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryTeam) ProtoMessage() {}
+
+func (x *HistoryTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryTeam.ProtoReflect.Descriptor instead.
+func (*HistoryTeam) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HistoryTeam) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *HistoryTeam) GetTeamColor() string {
+	if x != nil {
+		return x.TeamColor
+	}
+	return ""
+}
+
+func (x *HistoryTeam) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *HistoryTeam) GetPoints() uint32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *HistoryTeam) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+func (x *HistoryTeam) GetTeamOrder() uint32 {
+	if x != nil {
+		return x.TeamOrder
+	}
+	return 0
+}
+
+type HistoryPlayer struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// そのゲームで登録した名前
+	PlayerName            string  `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	TeamId                uint32  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	AnsweredCount         uint32  `protobuf:"varint,4,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount          uint32  `protobuf:"varint,5,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	CorrectRate           float32 `protobuf:"fixed32,6,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	AverageResponseTimeMs int64   `protobuf:"varint,7,opt,name=average_response_time_ms,json=averageResponseTimeMs,proto3" json:"average_response_time_ms,omitempty"`
+	PersonalOrder         uint32  `protobuf:"varint,8,opt,name=personal_order,json=personalOrder,proto3" json:"personal_order,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *HistoryPlayer) Reset() {
+	*x = HistoryPlayer{}
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryPlayer) ProtoMessage() {}
+
+func (x *HistoryPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryPlayer.ProtoReflect.Descriptor instead.
+func (*HistoryPlayer) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HistoryPlayer) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *HistoryPlayer) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *HistoryPlayer) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *HistoryPlayer) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *HistoryPlayer) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *HistoryPlayer) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+func (x *HistoryPlayer) GetAverageResponseTimeMs() int64 {
+	if x != nil {
+		return x.AverageResponseTimeMs
+	}
+	return 0
+}
+
+func (x *HistoryPlayer) GetPersonalOrder() uint32 {
+	if x != nil {
+		return x.PersonalOrder
+	}
+	return 0
+}
+
+// 時刻はUNIXミリ秒
+type GameHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	StartedAtMs   int64                  `protobuf:"varint,2,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"`
+	EndedAtMs     int64                  `protobuf:"varint,3,opt,name=ended_at_ms,json=endedAtMs,proto3" json:"ended_at_ms,omitempty"`
+	Rules         *GameRules             `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	QuizCount     uint32                 `protobuf:"varint,5,opt,name=quiz_count,json=quizCount,proto3" json:"quiz_count,omitempty"`
+	Teams         []*HistoryTeam         `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	Players       []*HistoryPlayer       `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameHistory) Reset() {
+	*x = GameHistory{}
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameHistory) ProtoMessage() {}
+
+func (x *GameHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameHistory.ProtoReflect.Descriptor instead.
+func (*GameHistory) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GameHistory) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameHistory) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *GameHistory) GetEndedAtMs() int64 {
+	if x != nil {
+		return x.EndedAtMs
+	}
+	return 0
+}
+
+func (x *GameHistory) GetRules() *GameRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GameHistory) GetQuizCount() uint32 {
+	if x != nil {
+		return x.QuizCount
+	}
+	return 0
+}
+
+func (x *GameHistory) GetTeams() []*HistoryTeam {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GameHistory) GetPlayers() []*HistoryPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type GetHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*GameHistory         `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryResponse) Reset() {
+	*x = GetHistoryResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryResponse) ProtoMessage() {}
+
+func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetHistoryResponse) GetGames() []*GameHistory {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GetPlayerHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerHistoryRequest) Reset() {
+	*x = GetPlayerHistoryRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerHistoryRequest) ProtoMessage() {}
+
+func (x *GetPlayerHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPlayerHistoryRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type PlayerGameHistory struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	GameId                string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	StartedAtMs           int64                  `protobuf:"varint,2,opt,name=started_at_ms,json=startedAtMs,proto3" json:"started_at_ms,omitempty"`
+	PlayerName            string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	TeamId                uint32                 `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	QuizCount             uint32                 `protobuf:"varint,5,opt,name=quiz_count,json=quizCount,proto3" json:"quiz_count,omitempty"`
+	AnsweredCount         uint32                 `protobuf:"varint,6,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount          uint32                 `protobuf:"varint,7,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	CorrectRate           float32                `protobuf:"fixed32,8,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	AverageResponseTimeMs int64                  `protobuf:"varint,9,opt,name=average_response_time_ms,json=averageResponseTimeMs,proto3" json:"average_response_time_ms,omitempty"`
+	PersonalOrder         uint32                 `protobuf:"varint,10,opt,name=personal_order,json=personalOrder,proto3" json:"personal_order,omitempty"`
+	PlayerCount           uint32                 `protobuf:"varint,11,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	TeamOrder             uint32                 `protobuf:"varint,12,opt,name=team_order,json=teamOrder,proto3" json:"team_order,omitempty"`
+	TeamNum               uint32                 `protobuf:"varint,13,opt,name=team_num,json=teamNum,proto3" json:"team_num,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PlayerGameHistory) Reset() {
+	*x = PlayerGameHistory{}
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerGameHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerGameHistory) ProtoMessage() {}
+
+func (x *PlayerGameHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerGameHistory.ProtoReflect.Descriptor instead.
+func (*PlayerGameHistory) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlayerGameHistory) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *PlayerGameHistory) GetStartedAtMs() int64 {
+	if x != nil {
+		return x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *PlayerGameHistory) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetQuizCount() uint32 {
+	if x != nil {
+		return x.QuizCount
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetAverageResponseTimeMs() int64 {
+	if x != nil {
+		return x.AverageResponseTimeMs
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetPersonalOrder() uint32 {
+	if x != nil {
+		return x.PersonalOrder
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetPlayerCount() uint32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetTeamOrder() uint32 {
+	if x != nil {
+		return x.TeamOrder
+	}
+	return 0
+}
+
+func (x *PlayerGameHistory) GetTeamNum() uint32 {
+	if x != nil {
+		return x.TeamNum
+	}
+	return 0
+}
+
+type GetPlayerHistoryResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId   string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	// 古いゲームから順に並ぶ
+	Games         []*PlayerGameHistory `protobuf:"bytes,3,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerHistoryResponse) Reset() {
+	*x = GetPlayerHistoryResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerHistoryResponse) ProtoMessage() {}
+
+func (x *GetPlayerHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPlayerHistoryResponse) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetPlayerHistoryResponse) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *GetPlayerHistoryResponse) GetGames() []*PlayerGameHistory {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// 出題対象としてどれだけ知られていたか
+type MemberKnowledge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	TeamId        uint32                 `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamColor     string                 `protobuf:"bytes,4,opt,name=team_color,json=teamColor,proto3" json:"team_color,omitempty"`
+	QuizCount     uint32                 `protobuf:"varint,5,opt,name=quiz_count,json=quizCount,proto3" json:"quiz_count,omitempty"`
+	AnsweredCount uint32                 `protobuf:"varint,6,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount  uint32                 `protobuf:"varint,7,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	CorrectRate   float32                `protobuf:"fixed32,8,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberKnowledge) Reset() {
+	*x = MemberKnowledge{}
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberKnowledge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberKnowledge) ProtoMessage() {}
+
+func (x *MemberKnowledge) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberKnowledge.ProtoReflect.Descriptor instead.
+func (*MemberKnowledge) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MemberKnowledge) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberKnowledge) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *MemberKnowledge) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *MemberKnowledge) GetTeamColor() string {
+	if x != nil {
+		return x.TeamColor
+	}
+	return ""
+}
+
+func (x *MemberKnowledge) GetQuizCount() uint32 {
+	if x != nil {
+		return x.QuizCount
+	}
+	return 0
+}
+
+func (x *MemberKnowledge) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *MemberKnowledge) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *MemberKnowledge) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+type QuestionDifficulty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	QuizCount     uint32                 `protobuf:"varint,3,opt,name=quiz_count,json=quizCount,proto3" json:"quiz_count,omitempty"`
+	AnsweredCount uint32                 `protobuf:"varint,4,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount  uint32                 `protobuf:"varint,5,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	CorrectRate   float32                `protobuf:"fixed32,6,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionDifficulty) Reset() {
+	*x = QuestionDifficulty{}
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionDifficulty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionDifficulty) ProtoMessage() {}
+
+func (x *QuestionDifficulty) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionDifficulty.ProtoReflect.Descriptor instead.
+func (*QuestionDifficulty) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QuestionDifficulty) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *QuestionDifficulty) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *QuestionDifficulty) GetQuizCount() uint32 {
+	if x != nil {
+		return x.QuizCount
+	}
+	return 0
+}
+
+func (x *QuestionDifficulty) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *QuestionDifficulty) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *QuestionDifficulty) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+// 回答した人が出題対象の人についてどれだけ正解したか
+type Relationship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AnswererId    string                 `protobuf:"bytes,1,opt,name=answerer_id,json=answererId,proto3" json:"answerer_id,omitempty"`
+	AnswererName  string                 `protobuf:"bytes,2,opt,name=answerer_name,json=answererName,proto3" json:"answerer_name,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	TargetName    string                 `protobuf:"bytes,4,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	AnsweredCount uint32                 `protobuf:"varint,5,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount  uint32                 `protobuf:"varint,6,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	CorrectRate   float32                `protobuf:"fixed32,7,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Relationship) GetAnswererId() string {
+	if x != nil {
+		return x.AnswererId
+	}
+	return ""
+}
+
+func (x *Relationship) GetAnswererName() string {
+	if x != nil {
+		return x.AnswererName
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Relationship) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *Relationship) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *Relationship) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *Relationship) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+type GetAnalyticsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MostKnown        []*MemberKnowledge     `protobuf:"bytes,1,rep,name=most_known,json=mostKnown,proto3" json:"most_known,omitempty"`
+	LeastKnown       []*MemberKnowledge     `protobuf:"bytes,2,rep,name=least_known,json=leastKnown,proto3" json:"least_known,omitempty"`
+	HardestQuestions []*QuestionDifficulty  `protobuf:"bytes,3,rep,name=hardest_questions,json=hardestQuestions,proto3" json:"hardest_questions,omitempty"`
+	Relationships    []*Relationship        `protobuf:"bytes,4,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	// 参加者をノード、回答した人から出題対象の人への正解数を辺にしたグラフ
+	GraphJson     string `protobuf:"bytes,5,opt,name=graph_json,json=graphJson,proto3" json:"graph_json,omitempty"`
+	GraphDot      string `protobuf:"bytes,6,opt,name=graph_dot,json=graphDot,proto3" json:"graph_dot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAnalyticsResponse) GetMostKnown() []*MemberKnowledge {
+	if x != nil {
+		return x.MostKnown
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetLeastKnown() []*MemberKnowledge {
+	if x != nil {
+		return x.LeastKnown
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetHardestQuestions() []*QuestionDifficulty {
+	if x != nil {
+		return x.HardestQuestions
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+func (x *GetAnalyticsResponse) GetGraphJson() string {
+	if x != nil {
+		return x.GraphJson
+	}
+	return ""
+}
+
+func (x *GetAnalyticsResponse) GetGraphDot() string {
+	if x != nil {
+		return x.GraphDot
+	}
+	return ""
+}
+
+var File_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14admin/v1/admin.proto\x12\badmin.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16common/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"G\n" +
+	"\x17RegistAdminUserResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"p\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\rR\x06teamId\x12\x19\n" +
+	"\bis_ready\x18\x04 \x01(\bR\aisReady\"\x96\x01\n" +
+	"\x11OpenEntryResponse\x123\n" +
+	"\rentered_users\x18\x01 \x03(\v2\x0e.admin.v1.UserR\fenteredUsers\x12*\n" +
+	"\x11expected_user_num\x18\x02 \x01(\x05R\x0fexpectedUserNum\x12 \n" +
+	"\fmax_user_num\x18\x03 \x01(\x05R\n" +
+	"maxUserNum\",\n" +
+	"\x11RejectUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x11ChangeTeamRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1e\n" +
+	"\vnew_team_id\x18\x02 \x01(\rR\tnewTeamId\"\xc2\x02\n" +
+	"\x12StartQuestResponse\x12/\n" +
+	"\x14target_user_image_id\x18\x01 \x01(\tR\x11targetUserImageId\x12$\n" +
+	"\x0etarget_team_id\x18\x02 \x01(\rR\ftargetTeamId\x12\x1f\n" +
+	"\vquestion_id\x18\x03 \x01(\rR\n" +
+	"questionId\x12\x1a\n" +
+	"\bquestion\x18\x04 \x01(\tR\bquestion\x12+\n" +
+	"\achoices\x18\x05 \x03(\v2\x11.common.v1.ChoiceR\achoices\x12\x1b\n" +
+	"\tlast_time\x18\x06 \x01(\x05R\blastTime\x12\x1b\n" +
+	"\thint_text\x18\a \x01(\tR\bhintText\x121\n" +
+	"\x15target_user_image_url\x18\b \x01(\tR\x12targetUserImageUrl\"\x8e\x01\n" +
+	"\n" +
+	"TeamAnswer\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x04 \x01(\tR\tteamColor\x12)\n" +
+	"\x06answer\x18\x02 \x01(\v2\x11.common.v1.ChoiceR\x06answer\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x03 \x01(\bR\tisCorrect\"\x80\x01\n" +
+	"\x14CheckAnswersResponse\x12.\n" +
+	"\aanswers\x18\x01 \x03(\v2\x14.admin.v1.TeamAnswerR\aanswers\x128\n" +
+	"\x0ecorrect_choice\x18\x02 \x01(\v2\x11.common.v1.ChoiceR\rcorrectChoice\"r\n" +
+	"\tUserStats\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12!\n" +
+	"\fcorrect_rate\x18\x02 \x01(\x02R\vcorrectRate\x12%\n" +
+	"\x0epersonal_order\x18\x03 \x01(\rR\rpersonalOrder\"\xc8\x01\n" +
+	"\tTeamStats\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x05 \x01(\tR\tteamColor\x128\n" +
+	"\rmembers_stats\x18\x02 \x03(\v2\x13.admin.v1.UserStatsR\fmembersStats\x12*\n" +
+	"\x11team_correct_rate\x18\x03 \x01(\x02R\x0fteamCorrectRate\x12\x1d\n" +
+	"\n" +
+	"team_order\x18\x04 \x01(\rR\tteamOrder\"\x92\x01\n" +
+	"\x10EndQuestResponse\x12)\n" +
+	"\x06result\x18\x01 \x01(\x0e2\x11.common.v1.ResultR\x06result\x12)\n" +
+	"\x05stats\x18\x02 \x03(\v2\x13.admin.v1.TeamStatsR\x05stats\x12(\n" +
+	"\x06awards\x18\x03 \x03(\v2\x10.common.v1.AwardR\x06awards\"\xce\x01\n" +
+	"\x0fProfileQuestion\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12,\n" +
+	"\rquestion_text\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fquestionText\x12$\n" +
+	"\tquiz_text\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bquizText\x12%\n" +
+	"\x0esample_answers\x18\x04 \x03(\tR\rsampleAnswers\x12\x1f\n" +
+	"\vis_optional\x18\x05 \x01(\bR\n" +
+	"isOptional\"P\n" +
+	"\x15ListQuestionsResponse\x127\n" +
+	"\tquestions\x18\x01 \x03(\v2\x19.admin.v1.ProfileQuestionR\tquestions\"8\n" +
+	"\x15DeleteQuestionRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\"F\n" +
+	"\x17ReorderQuestionsRequest\x12+\n" +
+	"\fquestion_ids\x18\x01 \x03(\rB\b\xbaH\x05\x92\x01\x02\b\x01R\vquestionIds\"\xc6\x01\n" +
+	"\rFlaggedAnswer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1f\n" +
+	"\vquestion_id\x18\x03 \x01(\rR\n" +
+	"questionId\x12#\n" +
+	"\rquestion_text\x18\x04 \x01(\tR\fquestionText\x12\x16\n" +
+	"\x06answer\x18\x05 \x01(\tR\x06answer\x12!\n" +
+	"\fmatched_term\x18\x06 \x01(\tR\vmatchedTerm\"O\n" +
+	"\x1aListFlaggedAnswersResponse\x121\n" +
+	"\aanswers\x18\x01 \x03(\v2\x17.admin.v1.FlaggedAnswerR\aanswers\"|\n" +
+	"\x15EditUserAnswerRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\rR\n" +
+	"questionId\x12\x1f\n" +
+	"\x06answer\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06answer\"]\n" +
+	"\x17RemoveUserAnswerRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\rR\n" +
+	"questionId\";\n" +
+	"\x16RemoveUserImageRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"w\n" +
+	"\x10ResultThresholds\x12\x1c\n" +
+	"\texcellent\x18\x01 \x01(\x02R\texcellent\x12\x14\n" +
+	"\x05great\x18\x02 \x01(\x02R\x05great\x12\x19\n" +
+	"\bgood_job\x18\x03 \x01(\x02R\agoodJob\x12\x14\n" +
+	"\x05clear\x18\x04 \x01(\x02R\x05clear\"B\n" +
+	"\tAwardRule\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tmin_count\x18\x02 \x01(\x05R\bminCount\"\xe5\x02\n" +
+	"\n" +
+	"AwardRules\x12F\n" +
+	"\x10fastest_answerer\x18\x01 \x01(\v2\x13.admin.v1.AwardRuleB\x06\xbaH\x03\xc8\x01\x01R\x0ffastestAnswerer\x12D\n" +
+	"\x0fmost_mysterious\x18\x02 \x01(\v2\x13.admin.v1.AwardRuleB\x06\xbaH\x03\xc8\x01\x01R\x0emostMysterious\x12C\n" +
+	"\x0fbest_hint_giver\x18\x03 \x01(\v2\x13.admin.v1.AwardRuleB\x06\xbaH\x03\xc8\x01\x01R\rbestHintGiver\x12@\n" +
+	"\rcomeback_team\x18\x04 \x01(\v2\x13.admin.v1.AwardRuleB\x06\xbaH\x03\xc8\x01\x01R\fcomebackTeam\x12B\n" +
+	"\x0eperfect_streak\x18\x05 \x01(\v2\x13.admin.v1.AwardRuleB\x06\xbaH\x03\xc8\x01\x01R\rperfectStreak\"\xaf\x03\n" +
+	"\tGameRules\x12+\n" +
+	"\x11countdown_seconds\x18\x01 \x01(\x05R\x10countdownSeconds\x12,\n" +
+	"\x12hint_bonus_seconds\x18\x02 \x01(\x05R\x10hintBonusSeconds\x12&\n" +
+	"\x0fmax_hint_length\x18\x03 \x01(\x05R\rmaxHintLength\x12*\n" +
+	"\x11answer_timeout_ms\x18\x04 \x01(\x03R\x0fanswerTimeoutMs\x12$\n" +
+	"\x0emax_choice_num\x18\x05 \x01(\x05R\fmaxChoiceNum\x12\"\n" +
+	"\rlobby_tick_ms\x18\x06 \x01(\x03R\vlobbyTickMs\x12\"\n" +
+	"\rmin_team_user\x18\a \x01(\x05R\vminTeamUser\x12O\n" +
+	"\x11result_thresholds\x18\b \x01(\v2\x1a.admin.v1.ResultThresholdsB\x06\xbaH\x03\xc8\x01\x01R\x10resultThresholds\x124\n" +
+	"\x06awards\x18\t \x01(\v2\x14.admin.v1.AwardRulesB\x06\xbaH\x03\xc8\x01\x01R\x06awards\"\xa8\x01\n" +
+	"\x15SetEntryLimitsRequest\x128\n" +
+	"\x11expected_user_num\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x00R\x0fexpectedUserNum\x88\x01\x01\x12.\n" +
+	"\fmax_user_num\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\n" +
+	"maxUserNum\x88\x01\x01B\x14\n" +
+	"\x12_expected_user_numB\x0f\n" +
+	"\r_max_user_num\"[\n" +
+	"\vEntryLimits\x12*\n" +
+	"\x11expected_user_num\x18\x01 \x01(\x05R\x0fexpectedUserNum\x12 \n" +
+	"\fmax_user_num\x18\x02 \x01(\x05R\n" +
+	"maxUserNum\"J\n" +
+	"\x15ExportSessionResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"J\n" +
+	"\x11GetHistoryRequest\x12\x1d\n" +
+	"\x05limit\x18\x01 \x01(\rB\a\xbaH\x04*\x02\x18dR\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\rR\x06offset\"\xc4\x01\n" +
+	"\vHistoryTeam\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x02 \x01(\tR\tteamColor\x12#\n" +
+	"\rcorrect_count\x18\x03 \x01(\rR\fcorrectCount\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\rR\x06points\x12!\n" +
+	"\fcorrect_rate\x18\x05 \x01(\x02R\vcorrectRate\x12\x1d\n" +
+	"\n" +
+	"team_order\x18\x06 \x01(\rR\tteamOrder\"\xb5\x02\n" +
+	"\rHistoryPlayer\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\rR\x06teamId\x12%\n" +
+	"\x0eanswered_count\x18\x04 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\x05 \x01(\rR\fcorrectCount\x12!\n" +
+	"\fcorrect_rate\x18\x06 \x01(\x02R\vcorrectRate\x127\n" +
+	"\x18average_response_time_ms\x18\a \x01(\x03R\x15averageResponseTimeMs\x12%\n" +
+	"\x0epersonal_order\x18\b \x01(\rR\rpersonalOrder\"\x94\x02\n" +
+	"\vGameHistory\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\"\n" +
+	"\rstarted_at_ms\x18\x02 \x01(\x03R\vstartedAtMs\x12\x1e\n" +
+	"\vended_at_ms\x18\x03 \x01(\x03R\tendedAtMs\x12)\n" +
+	"\x05rules\x18\x04 \x01(\v2\x13.admin.v1.GameRulesR\x05rules\x12\x1d\n" +
+	"\n" +
+	"quiz_count\x18\x05 \x01(\rR\tquizCount\x12+\n" +
+	"\x05teams\x18\x06 \x03(\v2\x15.admin.v1.HistoryTeamR\x05teams\x121\n" +
+	"\aplayers\x18\a \x03(\v2\x17.admin.v1.HistoryPlayerR\aplayers\"A\n" +
+	"\x12GetHistoryResponse\x12+\n" +
+	"\x05games\x18\x01 \x03(\v2\x15.admin.v1.GameHistoryR\x05games\"@\n" +
+	"\x17GetPlayerHistoryRequest\x12%\n" +
+	"\tplayer_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bplayerId\"\xd5\x03\n" +
+	"\x11PlayerGameHistory\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\"\n" +
+	"\rstarted_at_ms\x18\x02 \x01(\x03R\vstartedAtMs\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"quiz_count\x18\x05 \x01(\rR\tquizCount\x12%\n" +
+	"\x0eanswered_count\x18\x06 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\a \x01(\rR\fcorrectCount\x12!\n" +
+	"\fcorrect_rate\x18\b \x01(\x02R\vcorrectRate\x127\n" +
+	"\x18average_response_time_ms\x18\t \x01(\x03R\x15averageResponseTimeMs\x12%\n" +
+	"\x0epersonal_order\x18\n" +
+	" \x01(\rR\rpersonalOrder\x12!\n" +
+	"\fplayer_count\x18\v \x01(\rR\vplayerCount\x12\x1d\n" +
+	"\n" +
+	"team_order\x18\f \x01(\rR\tteamOrder\x12\x19\n" +
+	"\bteam_num\x18\r \x01(\rR\ateamNum\"\x8b\x01\n" +
+	"\x18GetPlayerHistoryResponse\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x121\n" +
+	"\x05games\x18\x03 \x03(\v2\x1b.admin.v1.PlayerGameHistoryR\x05games\"\x8d\x02\n" +
+	"\x0fMemberKnowledge\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x04 \x01(\tR\tteamColor\x12\x1d\n" +
+	"\n" +
+	"quiz_count\x18\x05 \x01(\rR\tquizCount\x12%\n" +
+	"\x0eanswered_count\x18\x06 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\a \x01(\rR\fcorrectCount\x12!\n" +
+	"\fcorrect_rate\x18\b \x01(\x02R\vcorrectRate\"\xe8\x01\n" +
+	"\x12QuestionDifficulty\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12#\n" +
+	"\rquestion_text\x18\x02 \x01(\tR\fquestionText\x12\x1d\n" +
+	"\n" +
+	"quiz_count\x18\x03 \x01(\rR\tquizCount\x12%\n" +
+	"\x0eanswered_count\x18\x04 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\x05 \x01(\rR\fcorrectCount\x12!\n" +
+	"\fcorrect_rate\x18\x06 \x01(\x02R\vcorrectRate\"\x81\x02\n" +
+	"\fRelationship\x12\x1f\n" +
+	"\vanswerer_id\x18\x01 \x01(\tR\n" +
+	"answererId\x12#\n" +
+	"\ranswerer_name\x18\x02 \x01(\tR\fanswererName\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vtarget_name\x18\x04 \x01(\tR\n" +
+	"targetName\x12%\n" +
+	"\x0eanswered_count\x18\x05 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\x06 \x01(\rR\fcorrectCount\x12!\n" +
+	"\fcorrect_rate\x18\a \x01(\x02R\vcorrectRate\"\xd1\x02\n" +
+	"\x14GetAnalyticsResponse\x128\n" +
+	"\n" +
+	"most_known\x18\x01 \x03(\v2\x19.admin.v1.MemberKnowledgeR\tmostKnown\x12:\n" +
+	"\vleast_known\x18\x02 \x03(\v2\x19.admin.v1.MemberKnowledgeR\n" +
+	"leastKnown\x12I\n" +
+	"\x11hardest_questions\x18\x03 \x03(\v2\x1c.admin.v1.QuestionDifficultyR\x10hardestQuestions\x12<\n" +
+	"\rrelationships\x18\x04 \x03(\v2\x16.admin.v1.RelationshipR\rrelationships\x12\x1d\n" +
+	"\n" +
+	"graph_json\x18\x05 \x01(\tR\tgraphJson\x12\x1b\n" +
+	"\tgraph_dot\x18\x06 \x01(\tR\bgraphDot2\xd4\x0e\n" +
+	"\fAdminService\x12L\n" +
+	"\x0fRegistAdminUser\x12\x16.google.protobuf.Empty\x1a!.admin.v1.RegistAdminUserResponse\x12B\n" +
+	"\tOpenEntry\x12\x16.google.protobuf.Empty\x1a\x1b.admin.v1.OpenEntryResponse0\x01\x12<\n" +
+	"\n" +
+	"CloseEntry\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\n" +
+	"RejectUser\x12\x1b.admin.v1.RejectUserRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\n" +
+	"ChangeTeam\x12\x1b.admin.v1.ChangeTeamRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
+	"\n" +
+	"StartQuest\x12\x16.google.protobuf.Empty\x1a\x1c.admin.v1.StartQuestResponse0\x01\x12;\n" +
+	"\tReadyQuiz\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\fCheckAnswers\x12\x16.google.protobuf.Empty\x1a\x1e.admin.v1.CheckAnswersResponse\x12:\n" +
+	"\bNextQuiz\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12>\n" +
+	"\bEndQuest\x12\x16.google.protobuf.Empty\x1a\x1a.admin.v1.EndQuestResponse\x12H\n" +
+	"\rListQuestions\x12\x16.google.protobuf.Empty\x1a\x1f.admin.v1.ListQuestionsResponse\x12F\n" +
+	"\x0eCreateQuestion\x12\x19.admin.v1.ProfileQuestion\x1a\x19.admin.v1.ProfileQuestion\x12F\n" +
+	"\x0eUpdateQuestion\x12\x19.admin.v1.ProfileQuestion\x1a\x19.admin.v1.ProfileQuestion\x12I\n" +
+	"\x0eDeleteQuestion\x12\x1f.admin.v1.DeleteQuestionRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x10ReorderQuestions\x12!.admin.v1.ReorderQuestionsRequest\x1a\x1f.admin.v1.ListQuestionsResponse\x12R\n" +
+	"\x12ListFlaggedAnswers\x12\x16.google.protobuf.Empty\x1a$.admin.v1.ListFlaggedAnswersResponse\x12I\n" +
+	"\x0eEditUserAnswer\x12\x1f.admin.v1.EditUserAnswerRequest\x1a\x16.google.protobuf.Empty\x12M\n" +
+	"\x10RemoveUserAnswer\x12!.admin.v1.RemoveUserAnswerRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0fRemoveUserImage\x12 .admin.v1.RemoveUserImageRequest\x1a\x16.google.protobuf.Empty\x127\n" +
+	"\bGetRules\x12\x16.google.protobuf.Empty\x1a\x13.admin.v1.GameRules\x124\n" +
+	"\bSetRules\x12\x13.admin.v1.GameRules\x1a\x13.admin.v1.GameRules\x12H\n" +
+	"\x0eSetEntryLimits\x12\x1f.admin.v1.SetEntryLimitsRequest\x1a\x15.admin.v1.EntryLimits\x12J\n" +
+	"\rExportSession\x12\x16.google.protobuf.Empty\x1a\x1f.admin.v1.ExportSessionResponse0\x01\x12G\n" +
+	"\n" +
+	"GetHistory\x12\x1b.admin.v1.GetHistoryRequest\x1a\x1c.admin.v1.GetHistoryResponse\x12Y\n" +
+	"\x10GetPlayerHistory\x12!.admin.v1.GetPlayerHistoryRequest\x1a\".admin.v1.GetPlayerHistoryResponse\x12F\n" +
+	"\fGetAnalytics\x12\x16.google.protobuf.Empty\x1a\x1e.admin.v1.GetAnalyticsResponseBTZRgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1;adminv1b\x06proto3"
 
 var (
 	file_admin_v1_admin_proto_rawDescOnce sync.Once
@@ -775,57 +2936,137 @@ func file_admin_v1_admin_proto_rawDescGZIP() []byte {
 	return file_admin_v1_admin_proto_rawDescData
 }
 
-var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_admin_v1_admin_proto_goTypes = []any{
-	(*RegistAdminUserResponse)(nil), // 0: admin.v1.RegistAdminUserResponse
-	(*User)(nil),                    // 1: admin.v1.User
-	(*OpenEntryResponse)(nil),       // 2: admin.v1.OpenEntryResponse
-	(*RejectUserRequest)(nil),       // 3: admin.v1.RejectUserRequest
-	(*ChangeTeamRequest)(nil),       // 4: admin.v1.ChangeTeamRequest
-	(*StartQuestResponse)(nil),      // 5: admin.v1.StartQuestResponse
-	(*TeamAnswer)(nil),              // 6: admin.v1.TeamAnswer
-	(*CheckAnswersResponse)(nil),    // 7: admin.v1.CheckAnswersResponse
-	(*UserStats)(nil),               // 8: admin.v1.UserStats
-	(*TeamStats)(nil),               // 9: admin.v1.TeamStats
-	(*EndQuestResponse)(nil),        // 10: admin.v1.EndQuestResponse
-	(*v1.Choice)(nil),               // 11: common.v1.Choice
-	(v1.Result)(0),                  // 12: common.v1.Result
-	(*emptypb.Empty)(nil),           // 13: google.protobuf.Empty
+	(*RegistAdminUserResponse)(nil),    // 0: admin.v1.RegistAdminUserResponse
+	(*User)(nil),                       // 1: admin.v1.User
+	(*OpenEntryResponse)(nil),          // 2: admin.v1.OpenEntryResponse
+	(*RejectUserRequest)(nil),          // 3: admin.v1.RejectUserRequest
+	(*ChangeTeamRequest)(nil),          // 4: admin.v1.ChangeTeamRequest
+	(*StartQuestResponse)(nil),         // 5: admin.v1.StartQuestResponse
+	(*TeamAnswer)(nil),                 // 6: admin.v1.TeamAnswer
+	(*CheckAnswersResponse)(nil),       // 7: admin.v1.CheckAnswersResponse
+	(*UserStats)(nil),                  // 8: admin.v1.UserStats
+	(*TeamStats)(nil),                  // 9: admin.v1.TeamStats
+	(*EndQuestResponse)(nil),           // 10: admin.v1.EndQuestResponse
+	(*ProfileQuestion)(nil),            // 11: admin.v1.ProfileQuestion
+	(*ListQuestionsResponse)(nil),      // 12: admin.v1.ListQuestionsResponse
+	(*DeleteQuestionRequest)(nil),      // 13: admin.v1.DeleteQuestionRequest
+	(*ReorderQuestionsRequest)(nil),    // 14: admin.v1.ReorderQuestionsRequest
+	(*FlaggedAnswer)(nil),              // 15: admin.v1.FlaggedAnswer
+	(*ListFlaggedAnswersResponse)(nil), // 16: admin.v1.ListFlaggedAnswersResponse
+	(*EditUserAnswerRequest)(nil),      // 17: admin.v1.EditUserAnswerRequest
+	(*RemoveUserAnswerRequest)(nil),    // 18: admin.v1.RemoveUserAnswerRequest
+	(*RemoveUserImageRequest)(nil),     // 19: admin.v1.RemoveUserImageRequest
+	(*ResultThresholds)(nil),           // 20: admin.v1.ResultThresholds
+	(*AwardRule)(nil),                  // 21: admin.v1.AwardRule
+	(*AwardRules)(nil),                 // 22: admin.v1.AwardRules
+	(*GameRules)(nil),                  // 23: admin.v1.GameRules
+	(*SetEntryLimitsRequest)(nil),      // 24: admin.v1.SetEntryLimitsRequest
+	(*EntryLimits)(nil),                // 25: admin.v1.EntryLimits
+	(*ExportSessionResponse)(nil),      // 26: admin.v1.ExportSessionResponse
+	(*GetHistoryRequest)(nil),          // 27: admin.v1.GetHistoryRequest
+	(*HistoryTeam)(nil),                // 28: admin.v1.HistoryTeam
+	(*HistoryPlayer)(nil),              // 29: admin.v1.HistoryPlayer
+	(*GameHistory)(nil),                // 30: admin.v1.GameHistory
+	(*GetHistoryResponse)(nil),         // 31: admin.v1.GetHistoryResponse
+	(*GetPlayerHistoryRequest)(nil),    // 32: admin.v1.GetPlayerHistoryRequest
+	(*PlayerGameHistory)(nil),          // 33: admin.v1.PlayerGameHistory
+	(*GetPlayerHistoryResponse)(nil),   // 34: admin.v1.GetPlayerHistoryResponse
+	(*MemberKnowledge)(nil),            // 35: admin.v1.MemberKnowledge
+	(*QuestionDifficulty)(nil),         // 36: admin.v1.QuestionDifficulty
+	(*Relationship)(nil),               // 37: admin.v1.Relationship
+	(*GetAnalyticsResponse)(nil),       // 38: admin.v1.GetAnalyticsResponse
+	(*v1.Choice)(nil),                  // 39: common.v1.Choice
+	(v1.Result)(0),                     // 40: common.v1.Result
+	(*v1.Award)(nil),                   // 41: common.v1.Award
+	(*emptypb.Empty)(nil),              // 42: google.protobuf.Empty
 }
 var file_admin_v1_admin_proto_depIdxs = []int32{
 	1,  // 0: admin.v1.OpenEntryResponse.entered_users:type_name -> admin.v1.User
-	11, // 1: admin.v1.StartQuestResponse.choices:type_name -> common.v1.Choice
-	11, // 2: admin.v1.TeamAnswer.answer:type_name -> common.v1.Choice
+	39, // 1: admin.v1.StartQuestResponse.choices:type_name -> common.v1.Choice
+	39, // 2: admin.v1.TeamAnswer.answer:type_name -> common.v1.Choice
 	6,  // 3: admin.v1.CheckAnswersResponse.answers:type_name -> admin.v1.TeamAnswer
-	11, // 4: admin.v1.CheckAnswersResponse.correct_choice:type_name -> common.v1.Choice
+	39, // 4: admin.v1.CheckAnswersResponse.correct_choice:type_name -> common.v1.Choice
 	8,  // 5: admin.v1.TeamStats.members_stats:type_name -> admin.v1.UserStats
-	12, // 6: admin.v1.EndQuestResponse.result:type_name -> common.v1.Result
+	40, // 6: admin.v1.EndQuestResponse.result:type_name -> common.v1.Result
 	9,  // 7: admin.v1.EndQuestResponse.stats:type_name -> admin.v1.TeamStats
-	13, // 8: admin.v1.AdminService.RegistAdminUser:input_type -> google.protobuf.Empty
-	13, // 9: admin.v1.AdminService.OpenEntry:input_type -> google.protobuf.Empty
-	13, // 10: admin.v1.AdminService.CloseEntry:input_type -> google.protobuf.Empty
-	3,  // 11: admin.v1.AdminService.RejectUser:input_type -> admin.v1.RejectUserRequest
-	4,  // 12: admin.v1.AdminService.ChangeTeam:input_type -> admin.v1.ChangeTeamRequest
-	13, // 13: admin.v1.AdminService.StartQuest:input_type -> google.protobuf.Empty
-	13, // 14: admin.v1.AdminService.ReadyQuiz:input_type -> google.protobuf.Empty
-	13, // 15: admin.v1.AdminService.CheckAnswers:input_type -> google.protobuf.Empty
-	13, // 16: admin.v1.AdminService.NextQuiz:input_type -> google.protobuf.Empty
-	13, // 17: admin.v1.AdminService.EndQuest:input_type -> google.protobuf.Empty
-	0,  // 18: admin.v1.AdminService.RegistAdminUser:output_type -> admin.v1.RegistAdminUserResponse
-	2,  // 19: admin.v1.AdminService.OpenEntry:output_type -> admin.v1.OpenEntryResponse
-	13, // 20: admin.v1.AdminService.CloseEntry:output_type -> google.protobuf.Empty
-	13, // 21: admin.v1.AdminService.RejectUser:output_type -> google.protobuf.Empty
-	13, // 22: admin.v1.AdminService.ChangeTeam:output_type -> google.protobuf.Empty
-	5,  // 23: admin.v1.AdminService.StartQuest:output_type -> admin.v1.StartQuestResponse
-	13, // 24: admin.v1.AdminService.ReadyQuiz:output_type -> google.protobuf.Empty
-	7,  // 25: admin.v1.AdminService.CheckAnswers:output_type -> admin.v1.CheckAnswersResponse
-	13, // 26: admin.v1.AdminService.NextQuiz:output_type -> google.protobuf.Empty
-	10, // 27: admin.v1.AdminService.EndQuest:output_type -> admin.v1.EndQuestResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	41, // 8: admin.v1.EndQuestResponse.awards:type_name -> common.v1.Award
+	11, // 9: admin.v1.ListQuestionsResponse.questions:type_name -> admin.v1.ProfileQuestion
+	15, // 10: admin.v1.ListFlaggedAnswersResponse.answers:type_name -> admin.v1.FlaggedAnswer
+	21, // 11: admin.v1.AwardRules.fastest_answerer:type_name -> admin.v1.AwardRule
+	21, // 12: admin.v1.AwardRules.most_mysterious:type_name -> admin.v1.AwardRule
+	21, // 13: admin.v1.AwardRules.best_hint_giver:type_name -> admin.v1.AwardRule
+	21, // 14: admin.v1.AwardRules.comeback_team:type_name -> admin.v1.AwardRule
+	21, // 15: admin.v1.AwardRules.perfect_streak:type_name -> admin.v1.AwardRule
+	20, // 16: admin.v1.GameRules.result_thresholds:type_name -> admin.v1.ResultThresholds
+	22, // 17: admin.v1.GameRules.awards:type_name -> admin.v1.AwardRules
+	23, // 18: admin.v1.GameHistory.rules:type_name -> admin.v1.GameRules
+	28, // 19: admin.v1.GameHistory.teams:type_name -> admin.v1.HistoryTeam
+	29, // 20: admin.v1.GameHistory.players:type_name -> admin.v1.HistoryPlayer
+	30, // 21: admin.v1.GetHistoryResponse.games:type_name -> admin.v1.GameHistory
+	33, // 22: admin.v1.GetPlayerHistoryResponse.games:type_name -> admin.v1.PlayerGameHistory
+	35, // 23: admin.v1.GetAnalyticsResponse.most_known:type_name -> admin.v1.MemberKnowledge
+	35, // 24: admin.v1.GetAnalyticsResponse.least_known:type_name -> admin.v1.MemberKnowledge
+	36, // 25: admin.v1.GetAnalyticsResponse.hardest_questions:type_name -> admin.v1.QuestionDifficulty
+	37, // 26: admin.v1.GetAnalyticsResponse.relationships:type_name -> admin.v1.Relationship
+	42, // 27: admin.v1.AdminService.RegistAdminUser:input_type -> google.protobuf.Empty
+	42, // 28: admin.v1.AdminService.OpenEntry:input_type -> google.protobuf.Empty
+	42, // 29: admin.v1.AdminService.CloseEntry:input_type -> google.protobuf.Empty
+	3,  // 30: admin.v1.AdminService.RejectUser:input_type -> admin.v1.RejectUserRequest
+	4,  // 31: admin.v1.AdminService.ChangeTeam:input_type -> admin.v1.ChangeTeamRequest
+	42, // 32: admin.v1.AdminService.StartQuest:input_type -> google.protobuf.Empty
+	42, // 33: admin.v1.AdminService.ReadyQuiz:input_type -> google.protobuf.Empty
+	42, // 34: admin.v1.AdminService.CheckAnswers:input_type -> google.protobuf.Empty
+	42, // 35: admin.v1.AdminService.NextQuiz:input_type -> google.protobuf.Empty
+	42, // 36: admin.v1.AdminService.EndQuest:input_type -> google.protobuf.Empty
+	42, // 37: admin.v1.AdminService.ListQuestions:input_type -> google.protobuf.Empty
+	11, // 38: admin.v1.AdminService.CreateQuestion:input_type -> admin.v1.ProfileQuestion
+	11, // 39: admin.v1.AdminService.UpdateQuestion:input_type -> admin.v1.ProfileQuestion
+	13, // 40: admin.v1.AdminService.DeleteQuestion:input_type -> admin.v1.DeleteQuestionRequest
+	14, // 41: admin.v1.AdminService.ReorderQuestions:input_type -> admin.v1.ReorderQuestionsRequest
+	42, // 42: admin.v1.AdminService.ListFlaggedAnswers:input_type -> google.protobuf.Empty
+	17, // 43: admin.v1.AdminService.EditUserAnswer:input_type -> admin.v1.EditUserAnswerRequest
+	18, // 44: admin.v1.AdminService.RemoveUserAnswer:input_type -> admin.v1.RemoveUserAnswerRequest
+	19, // 45: admin.v1.AdminService.RemoveUserImage:input_type -> admin.v1.RemoveUserImageRequest
+	42, // 46: admin.v1.AdminService.GetRules:input_type -> google.protobuf.Empty
+	23, // 47: admin.v1.AdminService.SetRules:input_type -> admin.v1.GameRules
+	24, // 48: admin.v1.AdminService.SetEntryLimits:input_type -> admin.v1.SetEntryLimitsRequest
+	42, // 49: admin.v1.AdminService.ExportSession:input_type -> google.protobuf.Empty
+	27, // 50: admin.v1.AdminService.GetHistory:input_type -> admin.v1.GetHistoryRequest
+	32, // 51: admin.v1.AdminService.GetPlayerHistory:input_type -> admin.v1.GetPlayerHistoryRequest
+	42, // 52: admin.v1.AdminService.GetAnalytics:input_type -> google.protobuf.Empty
+	0,  // 53: admin.v1.AdminService.RegistAdminUser:output_type -> admin.v1.RegistAdminUserResponse
+	2,  // 54: admin.v1.AdminService.OpenEntry:output_type -> admin.v1.OpenEntryResponse
+	42, // 55: admin.v1.AdminService.CloseEntry:output_type -> google.protobuf.Empty
+	42, // 56: admin.v1.AdminService.RejectUser:output_type -> google.protobuf.Empty
+	42, // 57: admin.v1.AdminService.ChangeTeam:output_type -> google.protobuf.Empty
+	5,  // 58: admin.v1.AdminService.StartQuest:output_type -> admin.v1.StartQuestResponse
+	42, // 59: admin.v1.AdminService.ReadyQuiz:output_type -> google.protobuf.Empty
+	7,  // 60: admin.v1.AdminService.CheckAnswers:output_type -> admin.v1.CheckAnswersResponse
+	42, // 61: admin.v1.AdminService.NextQuiz:output_type -> google.protobuf.Empty
+	10, // 62: admin.v1.AdminService.EndQuest:output_type -> admin.v1.EndQuestResponse
+	12, // 63: admin.v1.AdminService.ListQuestions:output_type -> admin.v1.ListQuestionsResponse
+	11, // 64: admin.v1.AdminService.CreateQuestion:output_type -> admin.v1.ProfileQuestion
+	11, // 65: admin.v1.AdminService.UpdateQuestion:output_type -> admin.v1.ProfileQuestion
+	42, // 66: admin.v1.AdminService.DeleteQuestion:output_type -> google.protobuf.Empty
+	12, // 67: admin.v1.AdminService.ReorderQuestions:output_type -> admin.v1.ListQuestionsResponse
+	16, // 68: admin.v1.AdminService.ListFlaggedAnswers:output_type -> admin.v1.ListFlaggedAnswersResponse
+	42, // 69: admin.v1.AdminService.EditUserAnswer:output_type -> google.protobuf.Empty
+	42, // 70: admin.v1.AdminService.RemoveUserAnswer:output_type -> google.protobuf.Empty
+	42, // 71: admin.v1.AdminService.RemoveUserImage:output_type -> google.protobuf.Empty
+	23, // 72: admin.v1.AdminService.GetRules:output_type -> admin.v1.GameRules
+	23, // 73: admin.v1.AdminService.SetRules:output_type -> admin.v1.GameRules
+	25, // 74: admin.v1.AdminService.SetEntryLimits:output_type -> admin.v1.EntryLimits
+	26, // 75: admin.v1.AdminService.ExportSession:output_type -> admin.v1.ExportSessionResponse
+	31, // 76: admin.v1.AdminService.GetHistory:output_type -> admin.v1.GetHistoryResponse
+	34, // 77: admin.v1.AdminService.GetPlayerHistory:output_type -> admin.v1.GetPlayerHistoryResponse
+	38, // 78: admin.v1.AdminService.GetAnalytics:output_type -> admin.v1.GetAnalyticsResponse
+	53, // [53:79] is the sub-list for method output_type
+	27, // [27:53] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_admin_v1_admin_proto_init() }
@@ -833,13 +3074,14 @@ func file_admin_v1_admin_proto_init() {
 	if File_admin_v1_admin_proto != nil {
 		return
 	}
+	file_admin_v1_admin_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
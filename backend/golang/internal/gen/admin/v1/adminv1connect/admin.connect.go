@@ -56,6 +56,51 @@ const (
 	AdminServiceNextQuizProcedure = "/admin.v1.AdminService/NextQuiz"
 	// AdminServiceEndQuestProcedure is the fully-qualified name of the AdminService's EndQuest RPC.
 	AdminServiceEndQuestProcedure = "/admin.v1.AdminService/EndQuest"
+	// AdminServiceListQuestionsProcedure is the fully-qualified name of the AdminService's
+	// ListQuestions RPC.
+	AdminServiceListQuestionsProcedure = "/admin.v1.AdminService/ListQuestions"
+	// AdminServiceCreateQuestionProcedure is the fully-qualified name of the AdminService's
+	// CreateQuestion RPC.
+	AdminServiceCreateQuestionProcedure = "/admin.v1.AdminService/CreateQuestion"
+	// AdminServiceUpdateQuestionProcedure is the fully-qualified name of the AdminService's
+	// UpdateQuestion RPC.
+	AdminServiceUpdateQuestionProcedure = "/admin.v1.AdminService/UpdateQuestion"
+	// AdminServiceDeleteQuestionProcedure is the fully-qualified name of the AdminService's
+	// DeleteQuestion RPC.
+	AdminServiceDeleteQuestionProcedure = "/admin.v1.AdminService/DeleteQuestion"
+	// AdminServiceReorderQuestionsProcedure is the fully-qualified name of the AdminService's
+	// ReorderQuestions RPC.
+	AdminServiceReorderQuestionsProcedure = "/admin.v1.AdminService/ReorderQuestions"
+	// AdminServiceListFlaggedAnswersProcedure is the fully-qualified name of the AdminService's
+	// ListFlaggedAnswers RPC.
+	AdminServiceListFlaggedAnswersProcedure = "/admin.v1.AdminService/ListFlaggedAnswers"
+	// AdminServiceEditUserAnswerProcedure is the fully-qualified name of the AdminService's
+	// EditUserAnswer RPC.
+	AdminServiceEditUserAnswerProcedure = "/admin.v1.AdminService/EditUserAnswer"
+	// AdminServiceRemoveUserAnswerProcedure is the fully-qualified name of the AdminService's
+	// RemoveUserAnswer RPC.
+	AdminServiceRemoveUserAnswerProcedure = "/admin.v1.AdminService/RemoveUserAnswer"
+	// AdminServiceRemoveUserImageProcedure is the fully-qualified name of the AdminService's
+	// RemoveUserImage RPC.
+	AdminServiceRemoveUserImageProcedure = "/admin.v1.AdminService/RemoveUserImage"
+	// AdminServiceGetRulesProcedure is the fully-qualified name of the AdminService's GetRules RPC.
+	AdminServiceGetRulesProcedure = "/admin.v1.AdminService/GetRules"
+	// AdminServiceSetRulesProcedure is the fully-qualified name of the AdminService's SetRules RPC.
+	AdminServiceSetRulesProcedure = "/admin.v1.AdminService/SetRules"
+	// AdminServiceSetEntryLimitsProcedure is the fully-qualified name of the AdminService's
+	// SetEntryLimits RPC.
+	AdminServiceSetEntryLimitsProcedure = "/admin.v1.AdminService/SetEntryLimits"
+	// AdminServiceExportSessionProcedure is the fully-qualified name of the AdminService's
+	// ExportSession RPC.
+	AdminServiceExportSessionProcedure = "/admin.v1.AdminService/ExportSession"
+	// AdminServiceGetHistoryProcedure is the fully-qualified name of the AdminService's GetHistory RPC.
+	AdminServiceGetHistoryProcedure = "/admin.v1.AdminService/GetHistory"
+	// AdminServiceGetPlayerHistoryProcedure is the fully-qualified name of the AdminService's
+	// GetPlayerHistory RPC.
+	AdminServiceGetPlayerHistoryProcedure = "/admin.v1.AdminService/GetPlayerHistory"
+	// AdminServiceGetAnalyticsProcedure is the fully-qualified name of the AdminService's GetAnalytics
+	// RPC.
+	AdminServiceGetAnalyticsProcedure = "/admin.v1.AdminService/GetAnalytics"
 )
 
 // AdminServiceClient is a client for the admin.v1.AdminService service.
@@ -70,6 +115,22 @@ type AdminServiceClient interface {
 	CheckAnswers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.CheckAnswersResponse], error)
 	NextQuiz(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
 	EndQuest(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.EndQuestResponse], error)
+	ListQuestions(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListQuestionsResponse], error)
+	CreateQuestion(context.Context, *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error)
+	UpdateQuestion(context.Context, *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error)
+	DeleteQuestion(context.Context, *connect.Request[v1.DeleteQuestionRequest]) (*connect.Response[emptypb.Empty], error)
+	ReorderQuestions(context.Context, *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ListQuestionsResponse], error)
+	ListFlaggedAnswers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListFlaggedAnswersResponse], error)
+	EditUserAnswer(context.Context, *connect.Request[v1.EditUserAnswerRequest]) (*connect.Response[emptypb.Empty], error)
+	RemoveUserAnswer(context.Context, *connect.Request[v1.RemoveUserAnswerRequest]) (*connect.Response[emptypb.Empty], error)
+	RemoveUserImage(context.Context, *connect.Request[v1.RemoveUserImageRequest]) (*connect.Response[emptypb.Empty], error)
+	GetRules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GameRules], error)
+	SetRules(context.Context, *connect.Request[v1.GameRules]) (*connect.Response[v1.GameRules], error)
+	SetEntryLimits(context.Context, *connect.Request[v1.SetEntryLimitsRequest]) (*connect.Response[v1.EntryLimits], error)
+	ExportSession(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.ExportSessionResponse], error)
+	GetHistory(context.Context, *connect.Request[v1.GetHistoryRequest]) (*connect.Response[v1.GetHistoryResponse], error)
+	GetPlayerHistory(context.Context, *connect.Request[v1.GetPlayerHistoryRequest]) (*connect.Response[v1.GetPlayerHistoryResponse], error)
+	GetAnalytics(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAnalyticsResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the admin.v1.AdminService service. By default, it
@@ -143,21 +204,133 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(adminServiceMethods.ByName("EndQuest")),
 			connect.WithClientOptions(opts...),
 		),
+		listQuestions: connect.NewClient[emptypb.Empty, v1.ListQuestionsResponse](
+			httpClient,
+			baseURL+AdminServiceListQuestionsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListQuestions")),
+			connect.WithClientOptions(opts...),
+		),
+		createQuestion: connect.NewClient[v1.ProfileQuestion, v1.ProfileQuestion](
+			httpClient,
+			baseURL+AdminServiceCreateQuestionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateQuestion")),
+			connect.WithClientOptions(opts...),
+		),
+		updateQuestion: connect.NewClient[v1.ProfileQuestion, v1.ProfileQuestion](
+			httpClient,
+			baseURL+AdminServiceUpdateQuestionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UpdateQuestion")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteQuestion: connect.NewClient[v1.DeleteQuestionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceDeleteQuestionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DeleteQuestion")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderQuestions: connect.NewClient[v1.ReorderQuestionsRequest, v1.ListQuestionsResponse](
+			httpClient,
+			baseURL+AdminServiceReorderQuestionsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ReorderQuestions")),
+			connect.WithClientOptions(opts...),
+		),
+		listFlaggedAnswers: connect.NewClient[emptypb.Empty, v1.ListFlaggedAnswersResponse](
+			httpClient,
+			baseURL+AdminServiceListFlaggedAnswersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListFlaggedAnswers")),
+			connect.WithClientOptions(opts...),
+		),
+		editUserAnswer: connect.NewClient[v1.EditUserAnswerRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceEditUserAnswerProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("EditUserAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		removeUserAnswer: connect.NewClient[v1.RemoveUserAnswerRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceRemoveUserAnswerProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RemoveUserAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		removeUserImage: connect.NewClient[v1.RemoveUserImageRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AdminServiceRemoveUserImageProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RemoveUserImage")),
+			connect.WithClientOptions(opts...),
+		),
+		getRules: connect.NewClient[emptypb.Empty, v1.GameRules](
+			httpClient,
+			baseURL+AdminServiceGetRulesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetRules")),
+			connect.WithClientOptions(opts...),
+		),
+		setRules: connect.NewClient[v1.GameRules, v1.GameRules](
+			httpClient,
+			baseURL+AdminServiceSetRulesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetRules")),
+			connect.WithClientOptions(opts...),
+		),
+		setEntryLimits: connect.NewClient[v1.SetEntryLimitsRequest, v1.EntryLimits](
+			httpClient,
+			baseURL+AdminServiceSetEntryLimitsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SetEntryLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		exportSession: connect.NewClient[emptypb.Empty, v1.ExportSessionResponse](
+			httpClient,
+			baseURL+AdminServiceExportSessionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ExportSession")),
+			connect.WithClientOptions(opts...),
+		),
+		getHistory: connect.NewClient[v1.GetHistoryRequest, v1.GetHistoryResponse](
+			httpClient,
+			baseURL+AdminServiceGetHistoryProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlayerHistory: connect.NewClient[v1.GetPlayerHistoryRequest, v1.GetPlayerHistoryResponse](
+			httpClient,
+			baseURL+AdminServiceGetPlayerHistoryProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetPlayerHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getAnalytics: connect.NewClient[emptypb.Empty, v1.GetAnalyticsResponse](
+			httpClient,
+			baseURL+AdminServiceGetAnalyticsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetAnalytics")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	registAdminUser *connect.Client[emptypb.Empty, v1.RegistAdminUserResponse]
-	openEntry       *connect.Client[emptypb.Empty, v1.OpenEntryResponse]
-	closeEntry      *connect.Client[emptypb.Empty, emptypb.Empty]
-	rejectUser      *connect.Client[v1.RejectUserRequest, emptypb.Empty]
-	changeTeam      *connect.Client[v1.ChangeTeamRequest, emptypb.Empty]
-	startQuest      *connect.Client[emptypb.Empty, v1.StartQuestResponse]
-	readyQuiz       *connect.Client[emptypb.Empty, emptypb.Empty]
-	checkAnswers    *connect.Client[emptypb.Empty, v1.CheckAnswersResponse]
-	nextQuiz        *connect.Client[emptypb.Empty, emptypb.Empty]
-	endQuest        *connect.Client[emptypb.Empty, v1.EndQuestResponse]
+	registAdminUser    *connect.Client[emptypb.Empty, v1.RegistAdminUserResponse]
+	openEntry          *connect.Client[emptypb.Empty, v1.OpenEntryResponse]
+	closeEntry         *connect.Client[emptypb.Empty, emptypb.Empty]
+	rejectUser         *connect.Client[v1.RejectUserRequest, emptypb.Empty]
+	changeTeam         *connect.Client[v1.ChangeTeamRequest, emptypb.Empty]
+	startQuest         *connect.Client[emptypb.Empty, v1.StartQuestResponse]
+	readyQuiz          *connect.Client[emptypb.Empty, emptypb.Empty]
+	checkAnswers       *connect.Client[emptypb.Empty, v1.CheckAnswersResponse]
+	nextQuiz           *connect.Client[emptypb.Empty, emptypb.Empty]
+	endQuest           *connect.Client[emptypb.Empty, v1.EndQuestResponse]
+	listQuestions      *connect.Client[emptypb.Empty, v1.ListQuestionsResponse]
+	createQuestion     *connect.Client[v1.ProfileQuestion, v1.ProfileQuestion]
+	updateQuestion     *connect.Client[v1.ProfileQuestion, v1.ProfileQuestion]
+	deleteQuestion     *connect.Client[v1.DeleteQuestionRequest, emptypb.Empty]
+	reorderQuestions   *connect.Client[v1.ReorderQuestionsRequest, v1.ListQuestionsResponse]
+	listFlaggedAnswers *connect.Client[emptypb.Empty, v1.ListFlaggedAnswersResponse]
+	editUserAnswer     *connect.Client[v1.EditUserAnswerRequest, emptypb.Empty]
+	removeUserAnswer   *connect.Client[v1.RemoveUserAnswerRequest, emptypb.Empty]
+	removeUserImage    *connect.Client[v1.RemoveUserImageRequest, emptypb.Empty]
+	getRules           *connect.Client[emptypb.Empty, v1.GameRules]
+	setRules           *connect.Client[v1.GameRules, v1.GameRules]
+	setEntryLimits     *connect.Client[v1.SetEntryLimitsRequest, v1.EntryLimits]
+	exportSession      *connect.Client[emptypb.Empty, v1.ExportSessionResponse]
+	getHistory         *connect.Client[v1.GetHistoryRequest, v1.GetHistoryResponse]
+	getPlayerHistory   *connect.Client[v1.GetPlayerHistoryRequest, v1.GetPlayerHistoryResponse]
+	getAnalytics       *connect.Client[emptypb.Empty, v1.GetAnalyticsResponse]
 }
 
 // RegistAdminUser calls admin.v1.AdminService.RegistAdminUser.
@@ -210,6 +383,86 @@ func (c *adminServiceClient) EndQuest(ctx context.Context, req *connect.Request[
 	return c.endQuest.CallUnary(ctx, req)
 }
 
+// ListQuestions calls admin.v1.AdminService.ListQuestions.
+func (c *adminServiceClient) ListQuestions(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListQuestionsResponse], error) {
+	return c.listQuestions.CallUnary(ctx, req)
+}
+
+// CreateQuestion calls admin.v1.AdminService.CreateQuestion.
+func (c *adminServiceClient) CreateQuestion(ctx context.Context, req *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error) {
+	return c.createQuestion.CallUnary(ctx, req)
+}
+
+// UpdateQuestion calls admin.v1.AdminService.UpdateQuestion.
+func (c *adminServiceClient) UpdateQuestion(ctx context.Context, req *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error) {
+	return c.updateQuestion.CallUnary(ctx, req)
+}
+
+// DeleteQuestion calls admin.v1.AdminService.DeleteQuestion.
+func (c *adminServiceClient) DeleteQuestion(ctx context.Context, req *connect.Request[v1.DeleteQuestionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteQuestion.CallUnary(ctx, req)
+}
+
+// ReorderQuestions calls admin.v1.AdminService.ReorderQuestions.
+func (c *adminServiceClient) ReorderQuestions(ctx context.Context, req *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ListQuestionsResponse], error) {
+	return c.reorderQuestions.CallUnary(ctx, req)
+}
+
+// ListFlaggedAnswers calls admin.v1.AdminService.ListFlaggedAnswers.
+func (c *adminServiceClient) ListFlaggedAnswers(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListFlaggedAnswersResponse], error) {
+	return c.listFlaggedAnswers.CallUnary(ctx, req)
+}
+
+// EditUserAnswer calls admin.v1.AdminService.EditUserAnswer.
+func (c *adminServiceClient) EditUserAnswer(ctx context.Context, req *connect.Request[v1.EditUserAnswerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.editUserAnswer.CallUnary(ctx, req)
+}
+
+// RemoveUserAnswer calls admin.v1.AdminService.RemoveUserAnswer.
+func (c *adminServiceClient) RemoveUserAnswer(ctx context.Context, req *connect.Request[v1.RemoveUserAnswerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeUserAnswer.CallUnary(ctx, req)
+}
+
+// RemoveUserImage calls admin.v1.AdminService.RemoveUserImage.
+func (c *adminServiceClient) RemoveUserImage(ctx context.Context, req *connect.Request[v1.RemoveUserImageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeUserImage.CallUnary(ctx, req)
+}
+
+// GetRules calls admin.v1.AdminService.GetRules.
+func (c *adminServiceClient) GetRules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GameRules], error) {
+	return c.getRules.CallUnary(ctx, req)
+}
+
+// SetRules calls admin.v1.AdminService.SetRules.
+func (c *adminServiceClient) SetRules(ctx context.Context, req *connect.Request[v1.GameRules]) (*connect.Response[v1.GameRules], error) {
+	return c.setRules.CallUnary(ctx, req)
+}
+
+// SetEntryLimits calls admin.v1.AdminService.SetEntryLimits.
+func (c *adminServiceClient) SetEntryLimits(ctx context.Context, req *connect.Request[v1.SetEntryLimitsRequest]) (*connect.Response[v1.EntryLimits], error) {
+	return c.setEntryLimits.CallUnary(ctx, req)
+}
+
+// ExportSession calls admin.v1.AdminService.ExportSession.
+func (c *adminServiceClient) ExportSession(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.ExportSessionResponse], error) {
+	return c.exportSession.CallServerStream(ctx, req)
+}
+
+// GetHistory calls admin.v1.AdminService.GetHistory.
+func (c *adminServiceClient) GetHistory(ctx context.Context, req *connect.Request[v1.GetHistoryRequest]) (*connect.Response[v1.GetHistoryResponse], error) {
+	return c.getHistory.CallUnary(ctx, req)
+}
+
+// GetPlayerHistory calls admin.v1.AdminService.GetPlayerHistory.
+func (c *adminServiceClient) GetPlayerHistory(ctx context.Context, req *connect.Request[v1.GetPlayerHistoryRequest]) (*connect.Response[v1.GetPlayerHistoryResponse], error) {
+	return c.getPlayerHistory.CallUnary(ctx, req)
+}
+
+// GetAnalytics calls admin.v1.AdminService.GetAnalytics.
+func (c *adminServiceClient) GetAnalytics(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAnalyticsResponse], error) {
+	return c.getAnalytics.CallUnary(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the admin.v1.AdminService service.
 type AdminServiceHandler interface {
 	RegistAdminUser(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RegistAdminUserResponse], error)
@@ -222,6 +475,22 @@ type AdminServiceHandler interface {
 	CheckAnswers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.CheckAnswersResponse], error)
 	NextQuiz(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
 	EndQuest(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.EndQuestResponse], error)
+	ListQuestions(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListQuestionsResponse], error)
+	CreateQuestion(context.Context, *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error)
+	UpdateQuestion(context.Context, *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error)
+	DeleteQuestion(context.Context, *connect.Request[v1.DeleteQuestionRequest]) (*connect.Response[emptypb.Empty], error)
+	ReorderQuestions(context.Context, *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ListQuestionsResponse], error)
+	ListFlaggedAnswers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListFlaggedAnswersResponse], error)
+	EditUserAnswer(context.Context, *connect.Request[v1.EditUserAnswerRequest]) (*connect.Response[emptypb.Empty], error)
+	RemoveUserAnswer(context.Context, *connect.Request[v1.RemoveUserAnswerRequest]) (*connect.Response[emptypb.Empty], error)
+	RemoveUserImage(context.Context, *connect.Request[v1.RemoveUserImageRequest]) (*connect.Response[emptypb.Empty], error)
+	GetRules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GameRules], error)
+	SetRules(context.Context, *connect.Request[v1.GameRules]) (*connect.Response[v1.GameRules], error)
+	SetEntryLimits(context.Context, *connect.Request[v1.SetEntryLimitsRequest]) (*connect.Response[v1.EntryLimits], error)
+	ExportSession(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.ExportSessionResponse]) error
+	GetHistory(context.Context, *connect.Request[v1.GetHistoryRequest]) (*connect.Response[v1.GetHistoryResponse], error)
+	GetPlayerHistory(context.Context, *connect.Request[v1.GetPlayerHistoryRequest]) (*connect.Response[v1.GetPlayerHistoryResponse], error)
+	GetAnalytics(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAnalyticsResponse], error)
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -291,6 +560,102 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(adminServiceMethods.ByName("EndQuest")),
 		connect.WithHandlerOptions(opts...),
 	)
+	adminServiceListQuestionsHandler := connect.NewUnaryHandler(
+		AdminServiceListQuestionsProcedure,
+		svc.ListQuestions,
+		connect.WithSchema(adminServiceMethods.ByName("ListQuestions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceCreateQuestionHandler := connect.NewUnaryHandler(
+		AdminServiceCreateQuestionProcedure,
+		svc.CreateQuestion,
+		connect.WithSchema(adminServiceMethods.ByName("CreateQuestion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUpdateQuestionHandler := connect.NewUnaryHandler(
+		AdminServiceUpdateQuestionProcedure,
+		svc.UpdateQuestion,
+		connect.WithSchema(adminServiceMethods.ByName("UpdateQuestion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDeleteQuestionHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteQuestionProcedure,
+		svc.DeleteQuestion,
+		connect.WithSchema(adminServiceMethods.ByName("DeleteQuestion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReorderQuestionsHandler := connect.NewUnaryHandler(
+		AdminServiceReorderQuestionsProcedure,
+		svc.ReorderQuestions,
+		connect.WithSchema(adminServiceMethods.ByName("ReorderQuestions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListFlaggedAnswersHandler := connect.NewUnaryHandler(
+		AdminServiceListFlaggedAnswersProcedure,
+		svc.ListFlaggedAnswers,
+		connect.WithSchema(adminServiceMethods.ByName("ListFlaggedAnswers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceEditUserAnswerHandler := connect.NewUnaryHandler(
+		AdminServiceEditUserAnswerProcedure,
+		svc.EditUserAnswer,
+		connect.WithSchema(adminServiceMethods.ByName("EditUserAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRemoveUserAnswerHandler := connect.NewUnaryHandler(
+		AdminServiceRemoveUserAnswerProcedure,
+		svc.RemoveUserAnswer,
+		connect.WithSchema(adminServiceMethods.ByName("RemoveUserAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRemoveUserImageHandler := connect.NewUnaryHandler(
+		AdminServiceRemoveUserImageProcedure,
+		svc.RemoveUserImage,
+		connect.WithSchema(adminServiceMethods.ByName("RemoveUserImage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetRulesHandler := connect.NewUnaryHandler(
+		AdminServiceGetRulesProcedure,
+		svc.GetRules,
+		connect.WithSchema(adminServiceMethods.ByName("GetRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetRulesHandler := connect.NewUnaryHandler(
+		AdminServiceSetRulesProcedure,
+		svc.SetRules,
+		connect.WithSchema(adminServiceMethods.ByName("SetRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSetEntryLimitsHandler := connect.NewUnaryHandler(
+		AdminServiceSetEntryLimitsProcedure,
+		svc.SetEntryLimits,
+		connect.WithSchema(adminServiceMethods.ByName("SetEntryLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceExportSessionHandler := connect.NewServerStreamHandler(
+		AdminServiceExportSessionProcedure,
+		svc.ExportSession,
+		connect.WithSchema(adminServiceMethods.ByName("ExportSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetHistoryHandler := connect.NewUnaryHandler(
+		AdminServiceGetHistoryProcedure,
+		svc.GetHistory,
+		connect.WithSchema(adminServiceMethods.ByName("GetHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetPlayerHistoryHandler := connect.NewUnaryHandler(
+		AdminServiceGetPlayerHistoryProcedure,
+		svc.GetPlayerHistory,
+		connect.WithSchema(adminServiceMethods.ByName("GetPlayerHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetAnalyticsHandler := connect.NewUnaryHandler(
+		AdminServiceGetAnalyticsProcedure,
+		svc.GetAnalytics,
+		connect.WithSchema(adminServiceMethods.ByName("GetAnalytics")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/admin.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AdminServiceRegistAdminUserProcedure:
@@ -313,6 +678,38 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceNextQuizHandler.ServeHTTP(w, r)
 		case AdminServiceEndQuestProcedure:
 			adminServiceEndQuestHandler.ServeHTTP(w, r)
+		case AdminServiceListQuestionsProcedure:
+			adminServiceListQuestionsHandler.ServeHTTP(w, r)
+		case AdminServiceCreateQuestionProcedure:
+			adminServiceCreateQuestionHandler.ServeHTTP(w, r)
+		case AdminServiceUpdateQuestionProcedure:
+			adminServiceUpdateQuestionHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteQuestionProcedure:
+			adminServiceDeleteQuestionHandler.ServeHTTP(w, r)
+		case AdminServiceReorderQuestionsProcedure:
+			adminServiceReorderQuestionsHandler.ServeHTTP(w, r)
+		case AdminServiceListFlaggedAnswersProcedure:
+			adminServiceListFlaggedAnswersHandler.ServeHTTP(w, r)
+		case AdminServiceEditUserAnswerProcedure:
+			adminServiceEditUserAnswerHandler.ServeHTTP(w, r)
+		case AdminServiceRemoveUserAnswerProcedure:
+			adminServiceRemoveUserAnswerHandler.ServeHTTP(w, r)
+		case AdminServiceRemoveUserImageProcedure:
+			adminServiceRemoveUserImageHandler.ServeHTTP(w, r)
+		case AdminServiceGetRulesProcedure:
+			adminServiceGetRulesHandler.ServeHTTP(w, r)
+		case AdminServiceSetRulesProcedure:
+			adminServiceSetRulesHandler.ServeHTTP(w, r)
+		case AdminServiceSetEntryLimitsProcedure:
+			adminServiceSetEntryLimitsHandler.ServeHTTP(w, r)
+		case AdminServiceExportSessionProcedure:
+			adminServiceExportSessionHandler.ServeHTTP(w, r)
+		case AdminServiceGetHistoryProcedure:
+			adminServiceGetHistoryHandler.ServeHTTP(w, r)
+		case AdminServiceGetPlayerHistoryProcedure:
+			adminServiceGetPlayerHistoryHandler.ServeHTTP(w, r)
+		case AdminServiceGetAnalyticsProcedure:
+			adminServiceGetAnalyticsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -361,3 +758,67 @@ func (UnimplementedAdminServiceHandler) NextQuiz(context.Context, *connect.Reque
 func (UnimplementedAdminServiceHandler) EndQuest(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.EndQuestResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.EndQuest is not implemented"))
 }
+
+func (UnimplementedAdminServiceHandler) ListQuestions(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListQuestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListQuestions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateQuestion(context.Context, *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.CreateQuestion is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UpdateQuestion(context.Context, *connect.Request[v1.ProfileQuestion]) (*connect.Response[v1.ProfileQuestion], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.UpdateQuestion is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteQuestion(context.Context, *connect.Request[v1.DeleteQuestionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.DeleteQuestion is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReorderQuestions(context.Context, *connect.Request[v1.ReorderQuestionsRequest]) (*connect.Response[v1.ListQuestionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ReorderQuestions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListFlaggedAnswers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListFlaggedAnswersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListFlaggedAnswers is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) EditUserAnswer(context.Context, *connect.Request[v1.EditUserAnswerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.EditUserAnswer is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RemoveUserAnswer(context.Context, *connect.Request[v1.RemoveUserAnswerRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.RemoveUserAnswer is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RemoveUserImage(context.Context, *connect.Request[v1.RemoveUserImageRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.RemoveUserImage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetRules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GameRules], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetRules is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetRules(context.Context, *connect.Request[v1.GameRules]) (*connect.Response[v1.GameRules], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SetRules is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SetEntryLimits(context.Context, *connect.Request[v1.SetEntryLimitsRequest]) (*connect.Response[v1.EntryLimits], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SetEntryLimits is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ExportSession(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.ExportSessionResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ExportSession is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetHistory(context.Context, *connect.Request[v1.GetHistoryRequest]) (*connect.Response[v1.GetHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetHistory is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetPlayerHistory(context.Context, *connect.Request[v1.GetPlayerHistoryRequest]) (*connect.Response[v1.GetPlayerHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetPlayerHistory is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetAnalytics(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetAnalyticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetAnalytics is not implemented"))
+}
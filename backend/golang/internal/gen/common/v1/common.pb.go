@@ -82,6 +82,65 @@ func (Result) EnumDescriptor() ([]byte, []int) {
 	return file_common_v1_common_proto_rawDescGZIP(), []int{0}
 }
 
+// 結果発表で贈る賞
+type AwardKind int32
+
+const (
+	AwardKind_AWARD_KIND_UNSPECIFIED      AwardKind = 0
+	AwardKind_AWARD_KIND_FASTEST_ANSWERER AwardKind = 1
+	AwardKind_AWARD_KIND_MOST_MYSTERIOUS  AwardKind = 2
+	AwardKind_AWARD_KIND_BEST_HINT_GIVER  AwardKind = 3
+	AwardKind_AWARD_KIND_COMEBACK_TEAM    AwardKind = 4
+	AwardKind_AWARD_KIND_PERFECT_STREAK   AwardKind = 5
+)
+
+// Enum value maps for AwardKind.
+var (
+	AwardKind_name = map[int32]string{
+		0: "AWARD_KIND_UNSPECIFIED",
+		1: "AWARD_KIND_FASTEST_ANSWERER",
+		2: "AWARD_KIND_MOST_MYSTERIOUS",
+		3: "AWARD_KIND_BEST_HINT_GIVER",
+		4: "AWARD_KIND_COMEBACK_TEAM",
+		5: "AWARD_KIND_PERFECT_STREAK",
+	}
+	AwardKind_value = map[string]int32{
+		"AWARD_KIND_UNSPECIFIED":      0,
+		"AWARD_KIND_FASTEST_ANSWERER": 1,
+		"AWARD_KIND_MOST_MYSTERIOUS":  2,
+		"AWARD_KIND_BEST_HINT_GIVER":  3,
+		"AWARD_KIND_COMEBACK_TEAM":    4,
+		"AWARD_KIND_PERFECT_STREAK":   5,
+	}
+)
+
+func (x AwardKind) Enum() *AwardKind {
+	p := new(AwardKind)
+	*p = x
+	return p
+}
+
+func (x AwardKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AwardKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_v1_common_proto_enumTypes[1].Descriptor()
+}
+
+func (AwardKind) Type() protoreflect.EnumType {
+	return &file_common_v1_common_proto_enumTypes[1]
+}
+
+func (x AwardKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AwardKind.Descriptor instead.
+func (AwardKind) EnumDescriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
 type Choice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChoiceId      uint32                 `protobuf:"varint,1,opt,name=choice_id,json=choiceId,proto3" json:"choice_id,omitempty"`
@@ -134,6 +193,102 @@ func (x *Choice) GetChoiceText() string {
 	return ""
 }
 
+type Award struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  AwardKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=common.v1.AwardKind" json:"kind,omitempty"`
+	// チームの賞の場合は空
+	UserName  string `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	TeamId    uint32 `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamColor string `protobuf:"bytes,4,opt,name=team_color,json=teamColor,proto3" json:"team_color,omitempty"`
+	// 連続正解数、ひっくり返した点差、正解率の分母になった回答数のいずれか
+	Count uint32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 最も謎な人とヒント名人の正解率
+	CorrectRate float32 `protobuf:"fixed32,6,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	// 最速回答者の正解した回答の平均回答時間
+	ResponseTimeMs int64 `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Award) Reset() {
+	*x = Award{}
+	mi := &file_common_v1_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Award) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Award) ProtoMessage() {}
+
+func (x *Award) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Award.ProtoReflect.Descriptor instead.
+func (*Award) Descriptor() ([]byte, []int) {
+	return file_common_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Award) GetKind() AwardKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AwardKind_AWARD_KIND_UNSPECIFIED
+}
+
+func (x *Award) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Award) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Award) GetTeamColor() string {
+	if x != nil {
+		return x.TeamColor
+	}
+	return ""
+}
+
+func (x *Award) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Award) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+func (x *Award) GetResponseTimeMs() int64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
 var File_common_v1_common_proto protoreflect.FileDescriptor
 
 const file_common_v1_common_proto_rawDesc = "" +
@@ -142,7 +297,16 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\x06Choice\x12\x1b\n" +
 	"\tchoice_id\x18\x01 \x01(\rR\bchoiceId\x12\x1f\n" +
 	"\vchoice_text\x18\x02 \x01(\tR\n" +
-	"choiceText*d\n" +
+	"choiceText\"\xe9\x01\n" +
+	"\x05Award\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.common.v1.AwardKindR\x04kind\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x04 \x01(\tR\tteamColor\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\rR\x05count\x12!\n" +
+	"\fcorrect_rate\x18\x06 \x01(\x02R\vcorrectRate\x12(\n" +
+	"\x10response_time_ms\x18\a \x01(\x03R\x0eresponseTimeMs*d\n" +
 	"\x06Result\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPERFECT\x10\x01\x12\r\n" +
@@ -151,7 +315,14 @@ const file_common_v1_common_proto_rawDesc = "" +
 	"\aGOODJOB\x10\x04\x12\t\n" +
 	"\x05CLEAR\x10\x05\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x06BVZTgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/common/v1;commonv1b\x06proto3"
+	"\x06FAILED\x10\x06*\xc5\x01\n" +
+	"\tAwardKind\x12\x1a\n" +
+	"\x16AWARD_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bAWARD_KIND_FASTEST_ANSWERER\x10\x01\x12\x1e\n" +
+	"\x1aAWARD_KIND_MOST_MYSTERIOUS\x10\x02\x12\x1e\n" +
+	"\x1aAWARD_KIND_BEST_HINT_GIVER\x10\x03\x12\x1c\n" +
+	"\x18AWARD_KIND_COMEBACK_TEAM\x10\x04\x12\x1d\n" +
+	"\x19AWARD_KIND_PERFECT_STREAK\x10\x05BVZTgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/common/v1;commonv1b\x06proto3"
 
 var (
 	file_common_v1_common_proto_rawDescOnce sync.Once
@@ -165,18 +336,21 @@ func file_common_v1_common_proto_rawDescGZIP() []byte {
 	return file_common_v1_common_proto_rawDescData
 }
 
-var file_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_common_v1_common_proto_goTypes = []any{
 	(Result)(0),    // 0: common.v1.Result
-	(*Choice)(nil), // 1: common.v1.Choice
+	(AwardKind)(0), // 1: common.v1.AwardKind
+	(*Choice)(nil), // 2: common.v1.Choice
+	(*Award)(nil),  // 3: common.v1.Award
 }
 var file_common_v1_common_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: common.v1.Award.kind:type_name -> common.v1.AwardKind
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_common_v1_common_proto_init() }
@@ -189,8 +363,8 @@ func file_common_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_common_proto_rawDesc), len(file_common_v1_common_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
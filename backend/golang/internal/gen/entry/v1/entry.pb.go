@@ -23,8 +23,10 @@ const (
 )
 
 type EntryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserName      string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserName string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// 以前のゲームで受け取った鍵、送ると同じプレイヤーとして履歴を残す
+	PlayerKey     string `protobuf:"bytes,2,opt,name=player_key,json=playerKey,proto3" json:"player_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,10 +68,19 @@ func (x *EntryRequest) GetUserName() string {
 	return ""
 }
 
+func (x *EntryRequest) GetPlayerKey() string {
+	if x != nil {
+		return x.PlayerKey
+	}
+	return ""
+}
+
 type EntryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	ReconnectKey  string                 `protobuf:"bytes,3,opt,name=reconnect_key,json=reconnectKey,proto3" json:"reconnect_key,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken  string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	ReconnectKey string                 `protobuf:"bytes,3,opt,name=reconnect_key,json=reconnectKey,proto3" json:"reconnect_key,omitempty"`
+	// 次のゲームでも送れるように端末に保存しておく
+	PlayerKey     string `protobuf:"bytes,4,opt,name=player_key,json=playerKey,proto3" json:"player_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,6 +129,13 @@ func (x *EntryResponse) GetReconnectKey() string {
 	return ""
 }
 
+func (x *EntryResponse) GetPlayerKey() string {
+	if x != nil {
+		return x.PlayerKey
+	}
+	return ""
+}
+
 type ReconnectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReconnectKey  string                 `protobuf:"bytes,2,opt,name=reconnect_key,json=reconnectKey,proto3" json:"reconnect_key,omitempty"`
@@ -210,12 +228,16 @@ var File_entry_v1_entry_proto protoreflect.FileDescriptor
 
 const file_entry_v1_entry_proto_rawDesc = "" +
 	"\n" +
-	"\x14entry/v1/entry.proto\x12\bentry.v1\x1a\x1bbuf/validate/validate.proto\"4\n" +
+	"\x14entry/v1/entry.proto\x12\bentry.v1\x1a\x1bbuf/validate/validate.proto\"S\n" +
 	"\fEntryRequest\x12$\n" +
-	"\tuser_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\buserName\"W\n" +
+	"\tuser_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\buserName\x12\x1d\n" +
+	"\n" +
+	"player_key\x18\x02 \x01(\tR\tplayerKey\"v\n" +
 	"\rEntryResponse\x12!\n" +
 	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
-	"\rreconnect_key\x18\x03 \x01(\tR\freconnectKey\"@\n" +
+	"\rreconnect_key\x18\x03 \x01(\tR\freconnectKey\x12\x1d\n" +
+	"\n" +
+	"player_key\x18\x04 \x01(\tR\tplayerKey\"@\n" +
 	"\x10ReconnectRequest\x12,\n" +
 	"\rreconnect_key\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x10R\freconnectKey\"6\n" +
 	"\x11ReconnectResponse\x12!\n" +
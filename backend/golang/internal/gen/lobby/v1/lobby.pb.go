@@ -23,10 +23,12 @@ const (
 )
 
 type LobbyStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsAllReady    bool                   `protobuf:"varint,1,opt,name=is_all_ready,json=isAllReady,proto3" json:"is_all_ready,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	IsAllReady bool                   `protobuf:"varint,1,opt,name=is_all_ready,json=isAllReady,proto3" json:"is_all_ready,omitempty"`
+	// 管理者に画像を消されたので、撮り直してアップロードする必要がある
+	ImageUploadRequested bool `protobuf:"varint,2,opt,name=image_upload_requested,json=imageUploadRequested,proto3" json:"image_upload_requested,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *LobbyStatus) Reset() {
@@ -66,10 +68,18 @@ func (x *LobbyStatus) GetIsAllReady() bool {
 	return false
 }
 
+func (x *LobbyStatus) GetImageUploadRequested() bool {
+	if x != nil {
+		return x.ImageUploadRequested
+	}
+	return false
+}
+
 type RegistProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
 	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	Skip          bool                   `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,11 +128,21 @@ func (x *RegistProfileRequest) GetAnswer() string {
 	return ""
 }
 
+func (x *RegistProfileRequest) GetSkip() bool {
+	if x != nil {
+		return x.Skip
+	}
+	return false
+}
+
 type RegistProfileResponse struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	NextQuestionId   uint32                 `protobuf:"varint,1,opt,name=next_question_id,json=nextQuestionId,proto3" json:"next_question_id,omitempty"`
 	NextQuestionText string                 `protobuf:"bytes,2,opt,name=next_question_text,json=nextQuestionText,proto3" json:"next_question_text,omitempty"`
 	NoMoreAnswer     bool                   `protobuf:"varint,3,opt,name=no_more_answer,json=noMoreAnswer,proto3" json:"no_more_answer,omitempty"`
+	IsOptional       bool                   `protobuf:"varint,4,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	AnsweredCount    uint32                 `protobuf:"varint,5,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	TotalCount       uint32                 `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -178,18 +198,365 @@ func (x *RegistProfileResponse) GetNoMoreAnswer() bool {
 	return false
 }
 
+func (x *RegistProfileResponse) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *RegistProfileResponse) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *RegistProfileResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetNextQuestionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NextQuestionId   uint32                 `protobuf:"varint,1,opt,name=next_question_id,json=nextQuestionId,proto3" json:"next_question_id,omitempty"`
+	NextQuestionText string                 `protobuf:"bytes,2,opt,name=next_question_text,json=nextQuestionText,proto3" json:"next_question_text,omitempty"`
+	NoMoreAnswer     bool                   `protobuf:"varint,3,opt,name=no_more_answer,json=noMoreAnswer,proto3" json:"no_more_answer,omitempty"`
+	IsOptional       bool                   `protobuf:"varint,4,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	AnsweredCount    uint32                 `protobuf:"varint,5,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	TotalCount       uint32                 `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetNextQuestionResponse) Reset() {
+	*x = GetNextQuestionResponse{}
+	mi := &file_lobby_v1_lobby_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextQuestionResponse) ProtoMessage() {}
+
+func (x *GetNextQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lobby_v1_lobby_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextQuestionResponse.ProtoReflect.Descriptor instead.
+func (*GetNextQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetNextQuestionResponse) GetNextQuestionId() uint32 {
+	if x != nil {
+		return x.NextQuestionId
+	}
+	return 0
+}
+
+func (x *GetNextQuestionResponse) GetNextQuestionText() string {
+	if x != nil {
+		return x.NextQuestionText
+	}
+	return ""
+}
+
+func (x *GetNextQuestionResponse) GetNoMoreAnswer() bool {
+	if x != nil {
+		return x.NoMoreAnswer
+	}
+	return false
+}
+
+func (x *GetNextQuestionResponse) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *GetNextQuestionResponse) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *GetNextQuestionResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type MyProfileAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	QuestionText  string                 `protobuf:"bytes,2,opt,name=question_text,json=questionText,proto3" json:"question_text,omitempty"`
+	Answer        string                 `protobuf:"bytes,3,opt,name=answer,proto3" json:"answer,omitempty"`
+	IsAnswered    bool                   `protobuf:"varint,4,opt,name=is_answered,json=isAnswered,proto3" json:"is_answered,omitempty"`
+	IsOptional    bool                   `protobuf:"varint,5,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	IsSkipped     bool                   `protobuf:"varint,6,opt,name=is_skipped,json=isSkipped,proto3" json:"is_skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyProfileAnswer) Reset() {
+	*x = MyProfileAnswer{}
+	mi := &file_lobby_v1_lobby_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyProfileAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyProfileAnswer) ProtoMessage() {}
+
+func (x *MyProfileAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_lobby_v1_lobby_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyProfileAnswer.ProtoReflect.Descriptor instead.
+func (*MyProfileAnswer) Descriptor() ([]byte, []int) {
+	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MyProfileAnswer) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *MyProfileAnswer) GetQuestionText() string {
+	if x != nil {
+		return x.QuestionText
+	}
+	return ""
+}
+
+func (x *MyProfileAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *MyProfileAnswer) GetIsAnswered() bool {
+	if x != nil {
+		return x.IsAnswered
+	}
+	return false
+}
+
+func (x *MyProfileAnswer) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *MyProfileAnswer) GetIsSkipped() bool {
+	if x != nil {
+		return x.IsSkipped
+	}
+	return false
+}
+
+type ListMyProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answers       []*MyProfileAnswer     `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	IsEditable    bool                   `protobuf:"varint,2,opt,name=is_editable,json=isEditable,proto3" json:"is_editable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyProfileResponse) Reset() {
+	*x = ListMyProfileResponse{}
+	mi := &file_lobby_v1_lobby_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyProfileResponse) ProtoMessage() {}
+
+func (x *ListMyProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lobby_v1_lobby_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyProfileResponse.ProtoReflect.Descriptor instead.
+func (*ListMyProfileResponse) Descriptor() ([]byte, []int) {
+	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMyProfileResponse) GetAnswers() []*MyProfileAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *ListMyProfileResponse) GetIsEditable() bool {
+	if x != nil {
+		return x.IsEditable
+	}
+	return false
+}
+
+type UpdateProfileAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileAnswerRequest) Reset() {
+	*x = UpdateProfileAnswerRequest{}
+	mi := &file_lobby_v1_lobby_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileAnswerRequest) ProtoMessage() {}
+
+func (x *UpdateProfileAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lobby_v1_lobby_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileAnswerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateProfileAnswerRequest) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *UpdateProfileAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserName string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// サムネイルの署名付きURL、写真が無い場合は代わりに生成した画像のURL
+	ImageUrl      string `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_lobby_v1_lobby_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_lobby_v1_lobby_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamMember) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *TeamMember) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
 type GetTeamInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeamId        uint32                 `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	TeamColor     string                 `protobuf:"bytes,2,opt,name=team_color,json=teamColor,proto3" json:"team_color,omitempty"`
 	Members       []string               `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	MemberDetails []*TeamMember          `protobuf:"bytes,4,rep,name=member_details,json=memberDetails,proto3" json:"member_details,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTeamInfoResponse) Reset() {
 	*x = GetTeamInfoResponse{}
-	mi := &file_lobby_v1_lobby_proto_msgTypes[3]
+	mi := &file_lobby_v1_lobby_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +568,7 @@ func (x *GetTeamInfoResponse) String() string {
 func (*GetTeamInfoResponse) ProtoMessage() {}
 
 func (x *GetTeamInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lobby_v1_lobby_proto_msgTypes[3]
+	mi := &file_lobby_v1_lobby_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +581,7 @@ func (x *GetTeamInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTeamInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetTeamInfoResponse) Descriptor() ([]byte, []int) {
-	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{3}
+	return file_lobby_v1_lobby_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetTeamInfoResponse) GetTeamId() uint32 {
@@ -238,30 +605,80 @@ func (x *GetTeamInfoResponse) GetMembers() []string {
 	return nil
 }
 
+func (x *GetTeamInfoResponse) GetMemberDetails() []*TeamMember {
+	if x != nil {
+		return x.MemberDetails
+	}
+	return nil
+}
+
 var File_lobby_v1_lobby_proto protoreflect.FileDescriptor
 
 const file_lobby_v1_lobby_proto_rawDesc = "" +
 	"\n" +
-	"\x14lobby/v1/lobby.proto\x12\blobby.v1\x1a\x1bgoogle/protobuf/empty.proto\"/\n" +
+	"\x14lobby/v1/lobby.proto\x12\blobby.v1\x1a\x1bgoogle/protobuf/empty.proto\"e\n" +
 	"\vLobbyStatus\x12 \n" +
 	"\fis_all_ready\x18\x01 \x01(\bR\n" +
-	"isAllReady\"O\n" +
+	"isAllReady\x124\n" +
+	"\x16image_upload_requested\x18\x02 \x01(\bR\x14imageUploadRequested\"c\n" +
 	"\x14RegistProfileRequest\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\rR\n" +
 	"questionId\x12\x16\n" +
-	"\x06answer\x18\x02 \x01(\tR\x06answer\"\x95\x01\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12\x12\n" +
+	"\x04skip\x18\x03 \x01(\bR\x04skip\"\xfe\x01\n" +
 	"\x15RegistProfileResponse\x12(\n" +
 	"\x10next_question_id\x18\x01 \x01(\rR\x0enextQuestionId\x12,\n" +
 	"\x12next_question_text\x18\x02 \x01(\tR\x10nextQuestionText\x12$\n" +
-	"\x0eno_more_answer\x18\x03 \x01(\bR\fnoMoreAnswer\"g\n" +
+	"\x0eno_more_answer\x18\x03 \x01(\bR\fnoMoreAnswer\x12\x1f\n" +
+	"\vis_optional\x18\x04 \x01(\bR\n" +
+	"isOptional\x12%\n" +
+	"\x0eanswered_count\x18\x05 \x01(\rR\ransweredCount\x12\x1f\n" +
+	"\vtotal_count\x18\x06 \x01(\rR\n" +
+	"totalCount\"\x80\x02\n" +
+	"\x17GetNextQuestionResponse\x12(\n" +
+	"\x10next_question_id\x18\x01 \x01(\rR\x0enextQuestionId\x12,\n" +
+	"\x12next_question_text\x18\x02 \x01(\tR\x10nextQuestionText\x12$\n" +
+	"\x0eno_more_answer\x18\x03 \x01(\bR\fnoMoreAnswer\x12\x1f\n" +
+	"\vis_optional\x18\x04 \x01(\bR\n" +
+	"isOptional\x12%\n" +
+	"\x0eanswered_count\x18\x05 \x01(\rR\ransweredCount\x12\x1f\n" +
+	"\vtotal_count\x18\x06 \x01(\rR\n" +
+	"totalCount\"\xd0\x01\n" +
+	"\x0fMyProfileAnswer\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12#\n" +
+	"\rquestion_text\x18\x02 \x01(\tR\fquestionText\x12\x16\n" +
+	"\x06answer\x18\x03 \x01(\tR\x06answer\x12\x1f\n" +
+	"\vis_answered\x18\x04 \x01(\bR\n" +
+	"isAnswered\x12\x1f\n" +
+	"\vis_optional\x18\x05 \x01(\bR\n" +
+	"isOptional\x12\x1d\n" +
+	"\n" +
+	"is_skipped\x18\x06 \x01(\bR\tisSkipped\"m\n" +
+	"\x15ListMyProfileResponse\x123\n" +
+	"\aanswers\x18\x01 \x03(\v2\x19.lobby.v1.MyProfileAnswerR\aanswers\x12\x1f\n" +
+	"\vis_editable\x18\x02 \x01(\bR\n" +
+	"isEditable\"U\n" +
+	"\x1aUpdateProfileAnswerRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\"F\n" +
+	"\n" +
+	"TeamMember\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\"\xa4\x01\n" +
 	"\x13GetTeamInfoResponse\x12\x17\n" +
 	"\ateam_id\x18\x01 \x01(\rR\x06teamId\x12\x1d\n" +
 	"\n" +
 	"team_color\x18\x02 \x01(\tR\tteamColor\x12\x18\n" +
-	"\amembers\x18\x03 \x03(\tR\amembers2\x9f\x02\n" +
+	"\amembers\x18\x03 \x03(\tR\amembers\x12;\n" +
+	"\x0emember_details\x18\x04 \x03(\v2\x14.lobby.v1.TeamMemberR\rmemberDetails2\x8f\x04\n" +
 	"\fLobbyService\x12<\n" +
 	"\tJoinLobby\x12\x16.google.protobuf.Empty\x1a\x15.lobby.v1.LobbyStatus0\x01\x12P\n" +
-	"\rRegistProfile\x12\x1e.lobby.v1.RegistProfileRequest\x1a\x1f.lobby.v1.RegistProfileResponse\x129\n" +
+	"\rRegistProfile\x12\x1e.lobby.v1.RegistProfileRequest\x1a\x1f.lobby.v1.RegistProfileResponse\x12L\n" +
+	"\x0fGetNextQuestion\x12\x16.google.protobuf.Empty\x1a!.lobby.v1.GetNextQuestionResponse\x12H\n" +
+	"\rListMyProfile\x12\x16.google.protobuf.Empty\x1a\x1f.lobby.v1.ListMyProfileResponse\x12V\n" +
+	"\x13UpdateProfileAnswer\x12$.lobby.v1.UpdateProfileAnswerRequest\x1a\x19.lobby.v1.MyProfileAnswer\x129\n" +
 	"\aIsReady\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12D\n" +
 	"\vGetTeamInfo\x12\x16.google.protobuf.Empty\x1a\x1d.lobby.v1.GetTeamInfoResponseBTZRgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/lobby/v1;lobbyv1b\x06proto3"
 
@@ -277,28 +694,41 @@ func file_lobby_v1_lobby_proto_rawDescGZIP() []byte {
 	return file_lobby_v1_lobby_proto_rawDescData
 }
 
-var file_lobby_v1_lobby_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lobby_v1_lobby_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_lobby_v1_lobby_proto_goTypes = []any{
-	(*LobbyStatus)(nil),           // 0: lobby.v1.LobbyStatus
-	(*RegistProfileRequest)(nil),  // 1: lobby.v1.RegistProfileRequest
-	(*RegistProfileResponse)(nil), // 2: lobby.v1.RegistProfileResponse
-	(*GetTeamInfoResponse)(nil),   // 3: lobby.v1.GetTeamInfoResponse
-	(*emptypb.Empty)(nil),         // 4: google.protobuf.Empty
+	(*LobbyStatus)(nil),                // 0: lobby.v1.LobbyStatus
+	(*RegistProfileRequest)(nil),       // 1: lobby.v1.RegistProfileRequest
+	(*RegistProfileResponse)(nil),      // 2: lobby.v1.RegistProfileResponse
+	(*GetNextQuestionResponse)(nil),    // 3: lobby.v1.GetNextQuestionResponse
+	(*MyProfileAnswer)(nil),            // 4: lobby.v1.MyProfileAnswer
+	(*ListMyProfileResponse)(nil),      // 5: lobby.v1.ListMyProfileResponse
+	(*UpdateProfileAnswerRequest)(nil), // 6: lobby.v1.UpdateProfileAnswerRequest
+	(*TeamMember)(nil),                 // 7: lobby.v1.TeamMember
+	(*GetTeamInfoResponse)(nil),        // 8: lobby.v1.GetTeamInfoResponse
+	(*emptypb.Empty)(nil),              // 9: google.protobuf.Empty
 }
 var file_lobby_v1_lobby_proto_depIdxs = []int32{
-	4, // 0: lobby.v1.LobbyService.JoinLobby:input_type -> google.protobuf.Empty
-	1, // 1: lobby.v1.LobbyService.RegistProfile:input_type -> lobby.v1.RegistProfileRequest
-	4, // 2: lobby.v1.LobbyService.IsReady:input_type -> google.protobuf.Empty
-	4, // 3: lobby.v1.LobbyService.GetTeamInfo:input_type -> google.protobuf.Empty
-	0, // 4: lobby.v1.LobbyService.JoinLobby:output_type -> lobby.v1.LobbyStatus
-	2, // 5: lobby.v1.LobbyService.RegistProfile:output_type -> lobby.v1.RegistProfileResponse
-	4, // 6: lobby.v1.LobbyService.IsReady:output_type -> google.protobuf.Empty
-	3, // 7: lobby.v1.LobbyService.GetTeamInfo:output_type -> lobby.v1.GetTeamInfoResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: lobby.v1.ListMyProfileResponse.answers:type_name -> lobby.v1.MyProfileAnswer
+	7, // 1: lobby.v1.GetTeamInfoResponse.member_details:type_name -> lobby.v1.TeamMember
+	9, // 2: lobby.v1.LobbyService.JoinLobby:input_type -> google.protobuf.Empty
+	1, // 3: lobby.v1.LobbyService.RegistProfile:input_type -> lobby.v1.RegistProfileRequest
+	9, // 4: lobby.v1.LobbyService.GetNextQuestion:input_type -> google.protobuf.Empty
+	9, // 5: lobby.v1.LobbyService.ListMyProfile:input_type -> google.protobuf.Empty
+	6, // 6: lobby.v1.LobbyService.UpdateProfileAnswer:input_type -> lobby.v1.UpdateProfileAnswerRequest
+	9, // 7: lobby.v1.LobbyService.IsReady:input_type -> google.protobuf.Empty
+	9, // 8: lobby.v1.LobbyService.GetTeamInfo:input_type -> google.protobuf.Empty
+	0, // 9: lobby.v1.LobbyService.JoinLobby:output_type -> lobby.v1.LobbyStatus
+	2, // 10: lobby.v1.LobbyService.RegistProfile:output_type -> lobby.v1.RegistProfileResponse
+	3, // 11: lobby.v1.LobbyService.GetNextQuestion:output_type -> lobby.v1.GetNextQuestionResponse
+	5, // 12: lobby.v1.LobbyService.ListMyProfile:output_type -> lobby.v1.ListMyProfileResponse
+	4, // 13: lobby.v1.LobbyService.UpdateProfileAnswer:output_type -> lobby.v1.MyProfileAnswer
+	9, // 14: lobby.v1.LobbyService.IsReady:output_type -> google.protobuf.Empty
+	8, // 15: lobby.v1.LobbyService.GetTeamInfo:output_type -> lobby.v1.GetTeamInfoResponse
+	9, // [9:16] is the sub-list for method output_type
+	2, // [2:9] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_lobby_v1_lobby_proto_init() }
@@ -312,7 +742,7 @@ func file_lobby_v1_lobby_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lobby_v1_lobby_proto_rawDesc), len(file_lobby_v1_lobby_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
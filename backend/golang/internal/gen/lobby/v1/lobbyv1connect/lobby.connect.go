@@ -39,6 +39,15 @@ const (
 	// LobbyServiceRegistProfileProcedure is the fully-qualified name of the LobbyService's
 	// RegistProfile RPC.
 	LobbyServiceRegistProfileProcedure = "/lobby.v1.LobbyService/RegistProfile"
+	// LobbyServiceGetNextQuestionProcedure is the fully-qualified name of the LobbyService's
+	// GetNextQuestion RPC.
+	LobbyServiceGetNextQuestionProcedure = "/lobby.v1.LobbyService/GetNextQuestion"
+	// LobbyServiceListMyProfileProcedure is the fully-qualified name of the LobbyService's
+	// ListMyProfile RPC.
+	LobbyServiceListMyProfileProcedure = "/lobby.v1.LobbyService/ListMyProfile"
+	// LobbyServiceUpdateProfileAnswerProcedure is the fully-qualified name of the LobbyService's
+	// UpdateProfileAnswer RPC.
+	LobbyServiceUpdateProfileAnswerProcedure = "/lobby.v1.LobbyService/UpdateProfileAnswer"
 	// LobbyServiceIsReadyProcedure is the fully-qualified name of the LobbyService's IsReady RPC.
 	LobbyServiceIsReadyProcedure = "/lobby.v1.LobbyService/IsReady"
 	// LobbyServiceGetTeamInfoProcedure is the fully-qualified name of the LobbyService's GetTeamInfo
@@ -50,6 +59,9 @@ const (
 type LobbyServiceClient interface {
 	JoinLobby(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.LobbyStatus], error)
 	RegistProfile(context.Context, *connect.Request[v1.RegistProfileRequest]) (*connect.Response[v1.RegistProfileResponse], error)
+	GetNextQuestion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNextQuestionResponse], error)
+	ListMyProfile(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMyProfileResponse], error)
+	UpdateProfileAnswer(context.Context, *connect.Request[v1.UpdateProfileAnswerRequest]) (*connect.Response[v1.MyProfileAnswer], error)
 	IsReady(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
 	GetTeamInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetTeamInfoResponse], error)
 }
@@ -77,6 +89,24 @@ func NewLobbyServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(lobbyServiceMethods.ByName("RegistProfile")),
 			connect.WithClientOptions(opts...),
 		),
+		getNextQuestion: connect.NewClient[emptypb.Empty, v1.GetNextQuestionResponse](
+			httpClient,
+			baseURL+LobbyServiceGetNextQuestionProcedure,
+			connect.WithSchema(lobbyServiceMethods.ByName("GetNextQuestion")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyProfile: connect.NewClient[emptypb.Empty, v1.ListMyProfileResponse](
+			httpClient,
+			baseURL+LobbyServiceListMyProfileProcedure,
+			connect.WithSchema(lobbyServiceMethods.ByName("ListMyProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfileAnswer: connect.NewClient[v1.UpdateProfileAnswerRequest, v1.MyProfileAnswer](
+			httpClient,
+			baseURL+LobbyServiceUpdateProfileAnswerProcedure,
+			connect.WithSchema(lobbyServiceMethods.ByName("UpdateProfileAnswer")),
+			connect.WithClientOptions(opts...),
+		),
 		isReady: connect.NewClient[emptypb.Empty, emptypb.Empty](
 			httpClient,
 			baseURL+LobbyServiceIsReadyProcedure,
@@ -94,10 +124,13 @@ func NewLobbyServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 
 // lobbyServiceClient implements LobbyServiceClient.
 type lobbyServiceClient struct {
-	joinLobby     *connect.Client[emptypb.Empty, v1.LobbyStatus]
-	registProfile *connect.Client[v1.RegistProfileRequest, v1.RegistProfileResponse]
-	isReady       *connect.Client[emptypb.Empty, emptypb.Empty]
-	getTeamInfo   *connect.Client[emptypb.Empty, v1.GetTeamInfoResponse]
+	joinLobby           *connect.Client[emptypb.Empty, v1.LobbyStatus]
+	registProfile       *connect.Client[v1.RegistProfileRequest, v1.RegistProfileResponse]
+	getNextQuestion     *connect.Client[emptypb.Empty, v1.GetNextQuestionResponse]
+	listMyProfile       *connect.Client[emptypb.Empty, v1.ListMyProfileResponse]
+	updateProfileAnswer *connect.Client[v1.UpdateProfileAnswerRequest, v1.MyProfileAnswer]
+	isReady             *connect.Client[emptypb.Empty, emptypb.Empty]
+	getTeamInfo         *connect.Client[emptypb.Empty, v1.GetTeamInfoResponse]
 }
 
 // JoinLobby calls lobby.v1.LobbyService.JoinLobby.
@@ -110,6 +143,21 @@ func (c *lobbyServiceClient) RegistProfile(ctx context.Context, req *connect.Req
 	return c.registProfile.CallUnary(ctx, req)
 }
 
+// GetNextQuestion calls lobby.v1.LobbyService.GetNextQuestion.
+func (c *lobbyServiceClient) GetNextQuestion(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNextQuestionResponse], error) {
+	return c.getNextQuestion.CallUnary(ctx, req)
+}
+
+// ListMyProfile calls lobby.v1.LobbyService.ListMyProfile.
+func (c *lobbyServiceClient) ListMyProfile(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMyProfileResponse], error) {
+	return c.listMyProfile.CallUnary(ctx, req)
+}
+
+// UpdateProfileAnswer calls lobby.v1.LobbyService.UpdateProfileAnswer.
+func (c *lobbyServiceClient) UpdateProfileAnswer(ctx context.Context, req *connect.Request[v1.UpdateProfileAnswerRequest]) (*connect.Response[v1.MyProfileAnswer], error) {
+	return c.updateProfileAnswer.CallUnary(ctx, req)
+}
+
 // IsReady calls lobby.v1.LobbyService.IsReady.
 func (c *lobbyServiceClient) IsReady(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
 	return c.isReady.CallUnary(ctx, req)
@@ -124,6 +172,9 @@ func (c *lobbyServiceClient) GetTeamInfo(ctx context.Context, req *connect.Reque
 type LobbyServiceHandler interface {
 	JoinLobby(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.LobbyStatus]) error
 	RegistProfile(context.Context, *connect.Request[v1.RegistProfileRequest]) (*connect.Response[v1.RegistProfileResponse], error)
+	GetNextQuestion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNextQuestionResponse], error)
+	ListMyProfile(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMyProfileResponse], error)
+	UpdateProfileAnswer(context.Context, *connect.Request[v1.UpdateProfileAnswerRequest]) (*connect.Response[v1.MyProfileAnswer], error)
 	IsReady(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error)
 	GetTeamInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetTeamInfoResponse], error)
 }
@@ -147,6 +198,24 @@ func NewLobbyServiceHandler(svc LobbyServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(lobbyServiceMethods.ByName("RegistProfile")),
 		connect.WithHandlerOptions(opts...),
 	)
+	lobbyServiceGetNextQuestionHandler := connect.NewUnaryHandler(
+		LobbyServiceGetNextQuestionProcedure,
+		svc.GetNextQuestion,
+		connect.WithSchema(lobbyServiceMethods.ByName("GetNextQuestion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lobbyServiceListMyProfileHandler := connect.NewUnaryHandler(
+		LobbyServiceListMyProfileProcedure,
+		svc.ListMyProfile,
+		connect.WithSchema(lobbyServiceMethods.ByName("ListMyProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	lobbyServiceUpdateProfileAnswerHandler := connect.NewUnaryHandler(
+		LobbyServiceUpdateProfileAnswerProcedure,
+		svc.UpdateProfileAnswer,
+		connect.WithSchema(lobbyServiceMethods.ByName("UpdateProfileAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
 	lobbyServiceIsReadyHandler := connect.NewUnaryHandler(
 		LobbyServiceIsReadyProcedure,
 		svc.IsReady,
@@ -165,6 +234,12 @@ func NewLobbyServiceHandler(svc LobbyServiceHandler, opts ...connect.HandlerOpti
 			lobbyServiceJoinLobbyHandler.ServeHTTP(w, r)
 		case LobbyServiceRegistProfileProcedure:
 			lobbyServiceRegistProfileHandler.ServeHTTP(w, r)
+		case LobbyServiceGetNextQuestionProcedure:
+			lobbyServiceGetNextQuestionHandler.ServeHTTP(w, r)
+		case LobbyServiceListMyProfileProcedure:
+			lobbyServiceListMyProfileHandler.ServeHTTP(w, r)
+		case LobbyServiceUpdateProfileAnswerProcedure:
+			lobbyServiceUpdateProfileAnswerHandler.ServeHTTP(w, r)
 		case LobbyServiceIsReadyProcedure:
 			lobbyServiceIsReadyHandler.ServeHTTP(w, r)
 		case LobbyServiceGetTeamInfoProcedure:
@@ -186,6 +261,18 @@ func (UnimplementedLobbyServiceHandler) RegistProfile(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lobby.v1.LobbyService.RegistProfile is not implemented"))
 }
 
+func (UnimplementedLobbyServiceHandler) GetNextQuestion(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetNextQuestionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lobby.v1.LobbyService.GetNextQuestion is not implemented"))
+}
+
+func (UnimplementedLobbyServiceHandler) ListMyProfile(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMyProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lobby.v1.LobbyService.ListMyProfile is not implemented"))
+}
+
+func (UnimplementedLobbyServiceHandler) UpdateProfileAnswer(context.Context, *connect.Request[v1.UpdateProfileAnswerRequest]) (*connect.Response[v1.MyProfileAnswer], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lobby.v1.LobbyService.UpdateProfileAnswer is not implemented"))
+}
+
 func (UnimplementedLobbyServiceHandler) IsReady(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("lobby.v1.LobbyService.IsReady is not implemented"))
 }
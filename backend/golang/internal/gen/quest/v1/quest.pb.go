@@ -7,6 +7,7 @@
 package questv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	v1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,18 +24,73 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Lifeline int32
+
+const (
+	Lifeline_UNSPECIFIED      Lifeline = 0
+	Lifeline_FIFTY_FIFTY      Lifeline = 1
+	Lifeline_ASK_THE_AUDIENCE Lifeline = 2
+	Lifeline_DOUBLE_POINTS    Lifeline = 3
+)
+
+// Enum value maps for Lifeline.
+var (
+	Lifeline_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "FIFTY_FIFTY",
+		2: "ASK_THE_AUDIENCE",
+		3: "DOUBLE_POINTS",
+	}
+	Lifeline_value = map[string]int32{
+		"UNSPECIFIED":      0,
+		"FIFTY_FIFTY":      1,
+		"ASK_THE_AUDIENCE": 2,
+		"DOUBLE_POINTS":    3,
+	}
+)
+
+func (x Lifeline) Enum() *Lifeline {
+	p := new(Lifeline)
+	*p = x
+	return p
+}
+
+func (x Lifeline) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Lifeline) Descriptor() protoreflect.EnumDescriptor {
+	return file_quest_v1_quest_proto_enumTypes[0].Descriptor()
+}
+
+func (Lifeline) Type() protoreflect.EnumType {
+	return &file_quest_v1_quest_proto_enumTypes[0]
+}
+
+func (x Lifeline) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Lifeline.Descriptor instead.
+func (Lifeline) EnumDescriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{0}
+}
+
 type StartQuestResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	TargetUserImageId string                 `protobuf:"bytes,1,opt,name=target_user_image_id,json=targetUserImageId,proto3" json:"target_user_image_id,omitempty"`
-	TargetTeamId      uint32                 `protobuf:"varint,2,opt,name=target_team_id,json=targetTeamId,proto3" json:"target_team_id,omitempty"`
-	QuestionId        uint32                 `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
-	Question          string                 `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
-	Choices           []*v1.Choice           `protobuf:"bytes,5,rep,name=choices,proto3" json:"choices,omitempty"`
-	CanAnswer         bool                   `protobuf:"varint,6,opt,name=can_answer,json=canAnswer,proto3" json:"can_answer,omitempty"`
-	IsTarget          bool                   `protobuf:"varint,7,opt,name=is_target,json=isTarget,proto3" json:"is_target,omitempty"`
-	LastTime          int32                  `protobuf:"varint,8,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TargetUserImageId  string                 `protobuf:"bytes,1,opt,name=target_user_image_id,json=targetUserImageId,proto3" json:"target_user_image_id,omitempty"`
+	TargetTeamId       uint32                 `protobuf:"varint,2,opt,name=target_team_id,json=targetTeamId,proto3" json:"target_team_id,omitempty"`
+	QuestionId         uint32                 `protobuf:"varint,3,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Question           string                 `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
+	Choices            []*v1.Choice           `protobuf:"bytes,5,rep,name=choices,proto3" json:"choices,omitempty"`
+	CanAnswer          bool                   `protobuf:"varint,6,opt,name=can_answer,json=canAnswer,proto3" json:"can_answer,omitempty"`
+	IsTarget           bool                   `protobuf:"varint,7,opt,name=is_target,json=isTarget,proto3" json:"is_target,omitempty"`
+	LastTime           int32                  `protobuf:"varint,8,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
+	AvailableLifelines []Lifeline             `protobuf:"varint,9,rep,packed,name=available_lifelines,json=availableLifelines,proto3,enum=quest.v1.Lifeline" json:"available_lifelines,omitempty"`
+	// 認証ヘッダ無しで画像を取得できる、期限付きの署名付きURL
+	TargetUserImageUrl string `protobuf:"bytes,10,opt,name=target_user_image_url,json=targetUserImageUrl,proto3" json:"target_user_image_url,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *StartQuestResponse) Reset() {
@@ -123,6 +179,20 @@ func (x *StartQuestResponse) GetLastTime() int32 {
 	return 0
 }
 
+func (x *StartQuestResponse) GetAvailableLifelines() []Lifeline {
+	if x != nil {
+		return x.AvailableLifelines
+	}
+	return nil
+}
+
+func (x *StartQuestResponse) GetTargetUserImageUrl() string {
+	if x != nil {
+		return x.TargetUserImageUrl
+	}
+	return ""
+}
+
 type AnswerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
@@ -279,19 +349,142 @@ func (x *TakeHintRequest) GetHint() string {
 	return ""
 }
 
+type UseLifelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Lifeline      Lifeline               `protobuf:"varint,2,opt,name=lifeline,proto3,enum=quest.v1.Lifeline" json:"lifeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UseLifelineRequest) Reset() {
+	*x = UseLifelineRequest{}
+	mi := &file_quest_v1_quest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseLifelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseLifelineRequest) ProtoMessage() {}
+
+func (x *UseLifelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseLifelineRequest.ProtoReflect.Descriptor instead.
+func (*UseLifelineRequest) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UseLifelineRequest) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *UseLifelineRequest) GetLifeline() Lifeline {
+	if x != nil {
+		return x.Lifeline
+	}
+	return Lifeline_UNSPECIFIED
+}
+
+type UseLifelineResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Choices            []*v1.Choice           `protobuf:"bytes,1,rep,name=choices,proto3" json:"choices,omitempty"`
+	AnswerCount        []int32                `protobuf:"varint,2,rep,packed,name=answer_count,json=answerCount,proto3" json:"answer_count,omitempty"`
+	IsDoublePoints     bool                   `protobuf:"varint,3,opt,name=is_double_points,json=isDoublePoints,proto3" json:"is_double_points,omitempty"`
+	AvailableLifelines []Lifeline             `protobuf:"varint,4,rep,packed,name=available_lifelines,json=availableLifelines,proto3,enum=quest.v1.Lifeline" json:"available_lifelines,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UseLifelineResponse) Reset() {
+	*x = UseLifelineResponse{}
+	mi := &file_quest_v1_quest_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UseLifelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseLifelineResponse) ProtoMessage() {}
+
+func (x *UseLifelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseLifelineResponse.ProtoReflect.Descriptor instead.
+func (*UseLifelineResponse) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UseLifelineResponse) GetChoices() []*v1.Choice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *UseLifelineResponse) GetAnswerCount() []int32 {
+	if x != nil {
+		return x.AnswerCount
+	}
+	return nil
+}
+
+func (x *UseLifelineResponse) GetIsDoublePoints() bool {
+	if x != nil {
+		return x.IsDoublePoints
+	}
+	return false
+}
+
+func (x *UseLifelineResponse) GetAvailableLifelines() []Lifeline {
+	if x != nil {
+		return x.AvailableLifelines
+	}
+	return nil
+}
+
 type GetResultResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        v1.Result              `protobuf:"varint,1,opt,name=result,proto3,enum=common.v1.Result" json:"result,omitempty"`
 	TeamOrder     uint32                 `protobuf:"varint,2,opt,name=team_order,json=teamOrder,proto3" json:"team_order,omitempty"`
 	PersonalOrder uint32                 `protobuf:"varint,3,opt,name=personal_order,json=personalOrder,proto3" json:"personal_order,omitempty"`
 	PersonalRate  float32                `protobuf:"fixed32,4,opt,name=personal_rate,json=personalRate,proto3" json:"personal_rate,omitempty"`
+	Awards        []*v1.Award            `protobuf:"bytes,5,rep,name=awards,proto3" json:"awards,omitempty"`
+	// awardsのうち、自分か自分のチームが受賞したもの
+	MyAwards      []*v1.Award `protobuf:"bytes,6,rep,name=my_awards,json=myAwards,proto3" json:"my_awards,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetResultResponse) Reset() {
 	*x = GetResultResponse{}
-	mi := &file_quest_v1_quest_proto_msgTypes[4]
+	mi := &file_quest_v1_quest_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +496,7 @@ func (x *GetResultResponse) String() string {
 func (*GetResultResponse) ProtoMessage() {}
 
 func (x *GetResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_quest_v1_quest_proto_msgTypes[4]
+	mi := &file_quest_v1_quest_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +509,7 @@ func (x *GetResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetResultResponse.ProtoReflect.Descriptor instead.
 func (*GetResultResponse) Descriptor() ([]byte, []int) {
-	return file_quest_v1_quest_proto_rawDescGZIP(), []int{4}
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetResultResponse) GetResult() v1.Result {
@@ -347,11 +540,457 @@ func (x *GetResultResponse) GetPersonalRate() float32 {
 	return 0
 }
 
+func (x *GetResultResponse) GetAwards() []*v1.Award {
+	if x != nil {
+		return x.Awards
+	}
+	return nil
+}
+
+func (x *GetResultResponse) GetMyAwards() []*v1.Award {
+	if x != nil {
+		return x.MyAwards
+	}
+	return nil
+}
+
+// 自分についてのクイズに他の参加者が出した回答
+type OthersAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserName      string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	TeamId        uint32                 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamColor     string                 `protobuf:"bytes,3,opt,name=team_color,json=teamColor,proto3" json:"team_color,omitempty"`
+	Answer        *v1.Choice             `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	IsCorrect     bool                   `protobuf:"varint,5,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OthersAnswer) Reset() {
+	*x = OthersAnswer{}
+	mi := &file_quest_v1_quest_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OthersAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OthersAnswer) ProtoMessage() {}
+
+func (x *OthersAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OthersAnswer.ProtoReflect.Descriptor instead.
+func (*OthersAnswer) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OthersAnswer) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *OthersAnswer) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *OthersAnswer) GetTeamColor() string {
+	if x != nil {
+		return x.TeamColor
+	}
+	return ""
+}
+
+func (x *OthersAnswer) GetAnswer() *v1.Choice {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *OthersAnswer) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+type QuizAboutMe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        uint32                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Question      string                 `protobuf:"bytes,2,opt,name=question,proto3" json:"question,omitempty"`
+	CorrectChoice *v1.Choice             `protobuf:"bytes,3,opt,name=correct_choice,json=correctChoice,proto3" json:"correct_choice,omitempty"`
+	Hint          string                 `protobuf:"bytes,4,opt,name=hint,proto3" json:"hint,omitempty"`
+	AnsweredCount uint32                 `protobuf:"varint,5,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount  uint32                 `protobuf:"varint,6,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	Answers       []*OthersAnswer        `protobuf:"bytes,7,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuizAboutMe) Reset() {
+	*x = QuizAboutMe{}
+	mi := &file_quest_v1_quest_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuizAboutMe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizAboutMe) ProtoMessage() {}
+
+func (x *QuizAboutMe) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizAboutMe.ProtoReflect.Descriptor instead.
+func (*QuizAboutMe) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QuizAboutMe) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *QuizAboutMe) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *QuizAboutMe) GetCorrectChoice() *v1.Choice {
+	if x != nil {
+		return x.CorrectChoice
+	}
+	return nil
+}
+
+func (x *QuizAboutMe) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *QuizAboutMe) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *QuizAboutMe) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *QuizAboutMe) GetAnswers() []*OthersAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type MyAnswer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Number         uint32                 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	TargetUserName string                 `protobuf:"bytes,2,opt,name=target_user_name,json=targetUserName,proto3" json:"target_user_name,omitempty"`
+	Question       string                 `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Answer         *v1.Choice             `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	CorrectChoice  *v1.Choice             `protobuf:"bytes,5,opt,name=correct_choice,json=correctChoice,proto3" json:"correct_choice,omitempty"`
+	IsCorrect      bool                   `protobuf:"varint,6,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	// カウントダウンの開始から回答までの時間
+	ResponseTimeMs int64 `protobuf:"varint,7,opt,name=response_time_ms,json=responseTimeMs,proto3" json:"response_time_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MyAnswer) Reset() {
+	*x = MyAnswer{}
+	mi := &file_quest_v1_quest_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyAnswer) ProtoMessage() {}
+
+func (x *MyAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyAnswer.ProtoReflect.Descriptor instead.
+func (*MyAnswer) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MyAnswer) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MyAnswer) GetTargetUserName() string {
+	if x != nil {
+		return x.TargetUserName
+	}
+	return ""
+}
+
+func (x *MyAnswer) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *MyAnswer) GetAnswer() *v1.Choice {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *MyAnswer) GetCorrectChoice() *v1.Choice {
+	if x != nil {
+		return x.CorrectChoice
+	}
+	return nil
+}
+
+func (x *MyAnswer) GetIsCorrect() bool {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return false
+}
+
+func (x *MyAnswer) GetResponseTimeMs() int64 {
+	if x != nil {
+		return x.ResponseTimeMs
+	}
+	return 0
+}
+
+type KnownPerson struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserName      string                 `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	TeamId        uint32                 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	TeamColor     string                 `protobuf:"bytes,3,opt,name=team_color,json=teamColor,proto3" json:"team_color,omitempty"`
+	AnsweredCount uint32                 `protobuf:"varint,4,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount  uint32                 `protobuf:"varint,5,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	CorrectRate   float32                `protobuf:"fixed32,6,opt,name=correct_rate,json=correctRate,proto3" json:"correct_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KnownPerson) Reset() {
+	*x = KnownPerson{}
+	mi := &file_quest_v1_quest_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KnownPerson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnownPerson) ProtoMessage() {}
+
+func (x *KnownPerson) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnownPerson.ProtoReflect.Descriptor instead.
+func (*KnownPerson) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KnownPerson) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *KnownPerson) GetTeamId() uint32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *KnownPerson) GetTeamColor() string {
+	if x != nil {
+		return x.TeamColor
+	}
+	return ""
+}
+
+func (x *KnownPerson) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *KnownPerson) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *KnownPerson) GetCorrectRate() float32 {
+	if x != nil {
+		return x.CorrectRate
+	}
+	return 0
+}
+
+type GetPersonalReportResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuizzesAboutMe []*QuizAboutMe         `protobuf:"bytes,1,rep,name=quizzes_about_me,json=quizzesAboutMe,proto3" json:"quizzes_about_me,omitempty"`
+	MyAnswers      []*MyAnswer            `protobuf:"bytes,2,rep,name=my_answers,json=myAnswers,proto3" json:"my_answers,omitempty"`
+	// 今のチームのメンバーが、これまでのゲームでよく知っていた人から順に並ぶ
+	// このゲームでは自分のチームのメンバーについてのクイズには答えないので、以前別のチームだった時の回答から数え、記録が無ければ空になる
+	KnownPeople           []*KnownPerson `protobuf:"bytes,3,rep,name=known_people,json=knownPeople,proto3" json:"known_people,omitempty"`
+	AnsweredCount         uint32         `protobuf:"varint,4,opt,name=answered_count,json=answeredCount,proto3" json:"answered_count,omitempty"`
+	CorrectCount          uint32         `protobuf:"varint,5,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`
+	AverageResponseTimeMs int64          `protobuf:"varint,6,opt,name=average_response_time_ms,json=averageResponseTimeMs,proto3" json:"average_response_time_ms,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetPersonalReportResponse) Reset() {
+	*x = GetPersonalReportResponse{}
+	mi := &file_quest_v1_quest_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonalReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonalReportResponse) ProtoMessage() {}
+
+func (x *GetPersonalReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_v1_quest_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonalReportResponse.ProtoReflect.Descriptor instead.
+func (*GetPersonalReportResponse) Descriptor() ([]byte, []int) {
+	return file_quest_v1_quest_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPersonalReportResponse) GetQuizzesAboutMe() []*QuizAboutMe {
+	if x != nil {
+		return x.QuizzesAboutMe
+	}
+	return nil
+}
+
+func (x *GetPersonalReportResponse) GetMyAnswers() []*MyAnswer {
+	if x != nil {
+		return x.MyAnswers
+	}
+	return nil
+}
+
+func (x *GetPersonalReportResponse) GetKnownPeople() []*KnownPerson {
+	if x != nil {
+		return x.KnownPeople
+	}
+	return nil
+}
+
+func (x *GetPersonalReportResponse) GetAnsweredCount() uint32 {
+	if x != nil {
+		return x.AnsweredCount
+	}
+	return 0
+}
+
+func (x *GetPersonalReportResponse) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *GetPersonalReportResponse) GetAverageResponseTimeMs() int64 {
+	if x != nil {
+		return x.AverageResponseTimeMs
+	}
+	return 0
+}
+
 var File_quest_v1_quest_proto protoreflect.FileDescriptor
 
 const file_quest_v1_quest_proto_rawDesc = "" +
 	"\n" +
-	"\x14quest/v1/quest.proto\x12\bquest.v1\x1a\x16common/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xae\x02\n" +
+	"\x14quest/v1/quest.proto\x12\bquest.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16common/v1/common.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xa6\x03\n" +
 	"\x12StartQuestResponse\x12/\n" +
 	"\x14target_user_image_id\x18\x01 \x01(\tR\x11targetUserImageId\x12$\n" +
 	"\x0etarget_team_id\x18\x02 \x01(\rR\ftargetTeamId\x12\x1f\n" +
@@ -362,7 +1001,10 @@ const file_quest_v1_quest_proto_rawDesc = "" +
 	"\n" +
 	"can_answer\x18\x06 \x01(\bR\tcanAnswer\x12\x1b\n" +
 	"\tis_target\x18\a \x01(\bR\bisTarget\x12\x1b\n" +
-	"\tlast_time\x18\b \x01(\x05R\blastTime\"[\n" +
+	"\tlast_time\x18\b \x01(\x05R\blastTime\x12C\n" +
+	"\x13available_lifelines\x18\t \x03(\x0e2\x12.quest.v1.LifelineR\x12availableLifelines\x121\n" +
+	"\x15target_user_image_url\x18\n" +
+	" \x01(\tR\x12targetUserImageUrl\"[\n" +
 	"\rAnswerRequest\x12\x1f\n" +
 	"\vquestion_id\x18\x01 \x01(\rR\n" +
 	"questionId\x12)\n" +
@@ -374,19 +1016,79 @@ const file_quest_v1_quest_proto_rawDesc = "" +
 	"teamAnswer\x12!\n" +
 	"\fanswer_count\x18\x03 \x03(\x05R\vanswerCount\"%\n" +
 	"\x0fTakeHintRequest\x12\x12\n" +
-	"\x04hint\x18\x01 \x01(\tR\x04hint\"\xa9\x01\n" +
+	"\x04hint\x18\x01 \x01(\tR\x04hint\"q\n" +
+	"\x12UseLifelineRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12:\n" +
+	"\blifeline\x18\x02 \x01(\x0e2\x12.quest.v1.LifelineB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\blifeline\"\xd4\x01\n" +
+	"\x13UseLifelineResponse\x12+\n" +
+	"\achoices\x18\x01 \x03(\v2\x11.common.v1.ChoiceR\achoices\x12!\n" +
+	"\fanswer_count\x18\x02 \x03(\x05R\vanswerCount\x12(\n" +
+	"\x10is_double_points\x18\x03 \x01(\bR\x0eisDoublePoints\x12C\n" +
+	"\x13available_lifelines\x18\x04 \x03(\x0e2\x12.quest.v1.LifelineR\x12availableLifelines\"\x82\x02\n" +
 	"\x11GetResultResponse\x12)\n" +
 	"\x06result\x18\x01 \x01(\x0e2\x11.common.v1.ResultR\x06result\x12\x1d\n" +
 	"\n" +
 	"team_order\x18\x02 \x01(\rR\tteamOrder\x12%\n" +
 	"\x0epersonal_order\x18\x03 \x01(\rR\rpersonalOrder\x12#\n" +
-	"\rpersonal_rate\x18\x04 \x01(\x02R\fpersonalRate2\x92\x02\n" +
+	"\rpersonal_rate\x18\x04 \x01(\x02R\fpersonalRate\x12(\n" +
+	"\x06awards\x18\x05 \x03(\v2\x10.common.v1.AwardR\x06awards\x12-\n" +
+	"\tmy_awards\x18\x06 \x03(\v2\x10.common.v1.AwardR\bmyAwards\"\xad\x01\n" +
+	"\fOthersAnswer\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x03 \x01(\tR\tteamColor\x12)\n" +
+	"\x06answer\x18\x04 \x01(\v2\x11.common.v1.ChoiceR\x06answer\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x05 \x01(\bR\tisCorrect\"\x8d\x02\n" +
+	"\vQuizAboutMe\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\rR\x06number\x12\x1a\n" +
+	"\bquestion\x18\x02 \x01(\tR\bquestion\x128\n" +
+	"\x0ecorrect_choice\x18\x03 \x01(\v2\x11.common.v1.ChoiceR\rcorrectChoice\x12\x12\n" +
+	"\x04hint\x18\x04 \x01(\tR\x04hint\x12%\n" +
+	"\x0eanswered_count\x18\x05 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\x06 \x01(\rR\fcorrectCount\x120\n" +
+	"\aanswers\x18\a \x03(\v2\x16.quest.v1.OthersAnswerR\aanswers\"\x96\x02\n" +
+	"\bMyAnswer\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\rR\x06number\x12(\n" +
+	"\x10target_user_name\x18\x02 \x01(\tR\x0etargetUserName\x12\x1a\n" +
+	"\bquestion\x18\x03 \x01(\tR\bquestion\x12)\n" +
+	"\x06answer\x18\x04 \x01(\v2\x11.common.v1.ChoiceR\x06answer\x128\n" +
+	"\x0ecorrect_choice\x18\x05 \x01(\v2\x11.common.v1.ChoiceR\rcorrectChoice\x12\x1d\n" +
+	"\n" +
+	"is_correct\x18\x06 \x01(\bR\tisCorrect\x12(\n" +
+	"\x10response_time_ms\x18\a \x01(\x03R\x0eresponseTimeMs\"\xd1\x01\n" +
+	"\vKnownPerson\x12\x1b\n" +
+	"\tuser_name\x18\x01 \x01(\tR\buserName\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\rR\x06teamId\x12\x1d\n" +
+	"\n" +
+	"team_color\x18\x03 \x01(\tR\tteamColor\x12%\n" +
+	"\x0eanswered_count\x18\x04 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\x05 \x01(\rR\fcorrectCount\x12!\n" +
+	"\fcorrect_rate\x18\x06 \x01(\x02R\vcorrectRate\"\xce\x02\n" +
+	"\x19GetPersonalReportResponse\x12?\n" +
+	"\x10quizzes_about_me\x18\x01 \x03(\v2\x15.quest.v1.QuizAboutMeR\x0equizzesAboutMe\x121\n" +
+	"\n" +
+	"my_answers\x18\x02 \x03(\v2\x12.quest.v1.MyAnswerR\tmyAnswers\x128\n" +
+	"\fknown_people\x18\x03 \x03(\v2\x15.quest.v1.KnownPersonR\vknownPeople\x12%\n" +
+	"\x0eanswered_count\x18\x04 \x01(\rR\ransweredCount\x12#\n" +
+	"\rcorrect_count\x18\x05 \x01(\rR\fcorrectCount\x127\n" +
+	"\x18average_response_time_ms\x18\x06 \x01(\x03R\x15averageResponseTimeMs*U\n" +
+	"\bLifeline\x12\x0f\n" +
+	"\vUNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vFIFTY_FIFTY\x10\x01\x12\x14\n" +
+	"\x10ASK_THE_AUDIENCE\x10\x02\x12\x11\n" +
+	"\rDOUBLE_POINTS\x10\x032\xb0\x03\n" +
 	"\fQuestService\x12D\n" +
 	"\n" +
 	"StartQuest\x12\x16.google.protobuf.Empty\x1a\x1c.quest.v1.StartQuestResponse0\x01\x12;\n" +
 	"\x06Answer\x12\x17.quest.v1.AnswerRequest\x1a\x18.quest.v1.AnswerResponse\x12=\n" +
 	"\bTakeHint\x12\x19.quest.v1.TakeHintRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
-	"\tGetResult\x12\x16.google.protobuf.Empty\x1a\x1b.quest.v1.GetResultResponseBTZRgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/quest/v1;questv1b\x06proto3"
+	"\tGetResult\x12\x16.google.protobuf.Empty\x1a\x1b.quest.v1.GetResultResponse\x12J\n" +
+	"\vUseLifeline\x12\x1c.quest.v1.UseLifelineRequest\x1a\x1d.quest.v1.UseLifelineResponse\x12P\n" +
+	"\x11GetPersonalReport\x12\x16.google.protobuf.Empty\x1a#.quest.v1.GetPersonalReportResponseBTZRgithub.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/quest/v1;questv1b\x06proto3"
 
 var (
 	file_quest_v1_quest_proto_rawDescOnce sync.Once
@@ -400,35 +1102,63 @@ func file_quest_v1_quest_proto_rawDescGZIP() []byte {
 	return file_quest_v1_quest_proto_rawDescData
 }
 
-var file_quest_v1_quest_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_quest_v1_quest_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_quest_v1_quest_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_quest_v1_quest_proto_goTypes = []any{
-	(*StartQuestResponse)(nil), // 0: quest.v1.StartQuestResponse
-	(*AnswerRequest)(nil),      // 1: quest.v1.AnswerRequest
-	(*AnswerResponse)(nil),     // 2: quest.v1.AnswerResponse
-	(*TakeHintRequest)(nil),    // 3: quest.v1.TakeHintRequest
-	(*GetResultResponse)(nil),  // 4: quest.v1.GetResultResponse
-	(*v1.Choice)(nil),          // 5: common.v1.Choice
-	(v1.Result)(0),             // 6: common.v1.Result
-	(*emptypb.Empty)(nil),      // 7: google.protobuf.Empty
+	(Lifeline)(0),                     // 0: quest.v1.Lifeline
+	(*StartQuestResponse)(nil),        // 1: quest.v1.StartQuestResponse
+	(*AnswerRequest)(nil),             // 2: quest.v1.AnswerRequest
+	(*AnswerResponse)(nil),            // 3: quest.v1.AnswerResponse
+	(*TakeHintRequest)(nil),           // 4: quest.v1.TakeHintRequest
+	(*UseLifelineRequest)(nil),        // 5: quest.v1.UseLifelineRequest
+	(*UseLifelineResponse)(nil),       // 6: quest.v1.UseLifelineResponse
+	(*GetResultResponse)(nil),         // 7: quest.v1.GetResultResponse
+	(*OthersAnswer)(nil),              // 8: quest.v1.OthersAnswer
+	(*QuizAboutMe)(nil),               // 9: quest.v1.QuizAboutMe
+	(*MyAnswer)(nil),                  // 10: quest.v1.MyAnswer
+	(*KnownPerson)(nil),               // 11: quest.v1.KnownPerson
+	(*GetPersonalReportResponse)(nil), // 12: quest.v1.GetPersonalReportResponse
+	(*v1.Choice)(nil),                 // 13: common.v1.Choice
+	(v1.Result)(0),                    // 14: common.v1.Result
+	(*v1.Award)(nil),                  // 15: common.v1.Award
+	(*emptypb.Empty)(nil),             // 16: google.protobuf.Empty
 }
 var file_quest_v1_quest_proto_depIdxs = []int32{
-	5, // 0: quest.v1.StartQuestResponse.choices:type_name -> common.v1.Choice
-	5, // 1: quest.v1.AnswerRequest.answer:type_name -> common.v1.Choice
-	5, // 2: quest.v1.AnswerResponse.team_answer:type_name -> common.v1.Choice
-	6, // 3: quest.v1.GetResultResponse.result:type_name -> common.v1.Result
-	7, // 4: quest.v1.QuestService.StartQuest:input_type -> google.protobuf.Empty
-	1, // 5: quest.v1.QuestService.Answer:input_type -> quest.v1.AnswerRequest
-	3, // 6: quest.v1.QuestService.TakeHint:input_type -> quest.v1.TakeHintRequest
-	7, // 7: quest.v1.QuestService.GetResult:input_type -> google.protobuf.Empty
-	0, // 8: quest.v1.QuestService.StartQuest:output_type -> quest.v1.StartQuestResponse
-	2, // 9: quest.v1.QuestService.Answer:output_type -> quest.v1.AnswerResponse
-	7, // 10: quest.v1.QuestService.TakeHint:output_type -> google.protobuf.Empty
-	4, // 11: quest.v1.QuestService.GetResult:output_type -> quest.v1.GetResultResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	13, // 0: quest.v1.StartQuestResponse.choices:type_name -> common.v1.Choice
+	0,  // 1: quest.v1.StartQuestResponse.available_lifelines:type_name -> quest.v1.Lifeline
+	13, // 2: quest.v1.AnswerRequest.answer:type_name -> common.v1.Choice
+	13, // 3: quest.v1.AnswerResponse.team_answer:type_name -> common.v1.Choice
+	0,  // 4: quest.v1.UseLifelineRequest.lifeline:type_name -> quest.v1.Lifeline
+	13, // 5: quest.v1.UseLifelineResponse.choices:type_name -> common.v1.Choice
+	0,  // 6: quest.v1.UseLifelineResponse.available_lifelines:type_name -> quest.v1.Lifeline
+	14, // 7: quest.v1.GetResultResponse.result:type_name -> common.v1.Result
+	15, // 8: quest.v1.GetResultResponse.awards:type_name -> common.v1.Award
+	15, // 9: quest.v1.GetResultResponse.my_awards:type_name -> common.v1.Award
+	13, // 10: quest.v1.OthersAnswer.answer:type_name -> common.v1.Choice
+	13, // 11: quest.v1.QuizAboutMe.correct_choice:type_name -> common.v1.Choice
+	8,  // 12: quest.v1.QuizAboutMe.answers:type_name -> quest.v1.OthersAnswer
+	13, // 13: quest.v1.MyAnswer.answer:type_name -> common.v1.Choice
+	13, // 14: quest.v1.MyAnswer.correct_choice:type_name -> common.v1.Choice
+	9,  // 15: quest.v1.GetPersonalReportResponse.quizzes_about_me:type_name -> quest.v1.QuizAboutMe
+	10, // 16: quest.v1.GetPersonalReportResponse.my_answers:type_name -> quest.v1.MyAnswer
+	11, // 17: quest.v1.GetPersonalReportResponse.known_people:type_name -> quest.v1.KnownPerson
+	16, // 18: quest.v1.QuestService.StartQuest:input_type -> google.protobuf.Empty
+	2,  // 19: quest.v1.QuestService.Answer:input_type -> quest.v1.AnswerRequest
+	4,  // 20: quest.v1.QuestService.TakeHint:input_type -> quest.v1.TakeHintRequest
+	16, // 21: quest.v1.QuestService.GetResult:input_type -> google.protobuf.Empty
+	5,  // 22: quest.v1.QuestService.UseLifeline:input_type -> quest.v1.UseLifelineRequest
+	16, // 23: quest.v1.QuestService.GetPersonalReport:input_type -> google.protobuf.Empty
+	1,  // 24: quest.v1.QuestService.StartQuest:output_type -> quest.v1.StartQuestResponse
+	3,  // 25: quest.v1.QuestService.Answer:output_type -> quest.v1.AnswerResponse
+	16, // 26: quest.v1.QuestService.TakeHint:output_type -> google.protobuf.Empty
+	7,  // 27: quest.v1.QuestService.GetResult:output_type -> quest.v1.GetResultResponse
+	6,  // 28: quest.v1.QuestService.UseLifeline:output_type -> quest.v1.UseLifelineResponse
+	12, // 29: quest.v1.QuestService.GetPersonalReport:output_type -> quest.v1.GetPersonalReportResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_quest_v1_quest_proto_init() }
@@ -441,13 +1171,14 @@ func file_quest_v1_quest_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quest_v1_quest_proto_rawDesc), len(file_quest_v1_quest_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      1,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_quest_v1_quest_proto_goTypes,
 		DependencyIndexes: file_quest_v1_quest_proto_depIdxs,
+		EnumInfos:         file_quest_v1_quest_proto_enumTypes,
 		MessageInfos:      file_quest_v1_quest_proto_msgTypes,
 	}.Build()
 	File_quest_v1_quest_proto = out.File
@@ -42,6 +42,12 @@ const (
 	QuestServiceTakeHintProcedure = "/quest.v1.QuestService/TakeHint"
 	// QuestServiceGetResultProcedure is the fully-qualified name of the QuestService's GetResult RPC.
 	QuestServiceGetResultProcedure = "/quest.v1.QuestService/GetResult"
+	// QuestServiceUseLifelineProcedure is the fully-qualified name of the QuestService's UseLifeline
+	// RPC.
+	QuestServiceUseLifelineProcedure = "/quest.v1.QuestService/UseLifeline"
+	// QuestServiceGetPersonalReportProcedure is the fully-qualified name of the QuestService's
+	// GetPersonalReport RPC.
+	QuestServiceGetPersonalReportProcedure = "/quest.v1.QuestService/GetPersonalReport"
 )
 
 // QuestServiceClient is a client for the quest.v1.QuestService service.
@@ -50,6 +56,8 @@ type QuestServiceClient interface {
 	Answer(context.Context, *connect.Request[v1.AnswerRequest]) (*connect.Response[v1.AnswerResponse], error)
 	TakeHint(context.Context, *connect.Request[v1.TakeHintRequest]) (*connect.Response[emptypb.Empty], error)
 	GetResult(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetResultResponse], error)
+	UseLifeline(context.Context, *connect.Request[v1.UseLifelineRequest]) (*connect.Response[v1.UseLifelineResponse], error)
+	GetPersonalReport(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPersonalReportResponse], error)
 }
 
 // NewQuestServiceClient constructs a client for the quest.v1.QuestService service. By default, it
@@ -87,15 +95,29 @@ func NewQuestServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(questServiceMethods.ByName("GetResult")),
 			connect.WithClientOptions(opts...),
 		),
+		useLifeline: connect.NewClient[v1.UseLifelineRequest, v1.UseLifelineResponse](
+			httpClient,
+			baseURL+QuestServiceUseLifelineProcedure,
+			connect.WithSchema(questServiceMethods.ByName("UseLifeline")),
+			connect.WithClientOptions(opts...),
+		),
+		getPersonalReport: connect.NewClient[emptypb.Empty, v1.GetPersonalReportResponse](
+			httpClient,
+			baseURL+QuestServiceGetPersonalReportProcedure,
+			connect.WithSchema(questServiceMethods.ByName("GetPersonalReport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // questServiceClient implements QuestServiceClient.
 type questServiceClient struct {
-	startQuest *connect.Client[emptypb.Empty, v1.StartQuestResponse]
-	answer     *connect.Client[v1.AnswerRequest, v1.AnswerResponse]
-	takeHint   *connect.Client[v1.TakeHintRequest, emptypb.Empty]
-	getResult  *connect.Client[emptypb.Empty, v1.GetResultResponse]
+	startQuest        *connect.Client[emptypb.Empty, v1.StartQuestResponse]
+	answer            *connect.Client[v1.AnswerRequest, v1.AnswerResponse]
+	takeHint          *connect.Client[v1.TakeHintRequest, emptypb.Empty]
+	getResult         *connect.Client[emptypb.Empty, v1.GetResultResponse]
+	useLifeline       *connect.Client[v1.UseLifelineRequest, v1.UseLifelineResponse]
+	getPersonalReport *connect.Client[emptypb.Empty, v1.GetPersonalReportResponse]
 }
 
 // StartQuest calls quest.v1.QuestService.StartQuest.
@@ -118,12 +140,24 @@ func (c *questServiceClient) GetResult(ctx context.Context, req *connect.Request
 	return c.getResult.CallUnary(ctx, req)
 }
 
+// UseLifeline calls quest.v1.QuestService.UseLifeline.
+func (c *questServiceClient) UseLifeline(ctx context.Context, req *connect.Request[v1.UseLifelineRequest]) (*connect.Response[v1.UseLifelineResponse], error) {
+	return c.useLifeline.CallUnary(ctx, req)
+}
+
+// GetPersonalReport calls quest.v1.QuestService.GetPersonalReport.
+func (c *questServiceClient) GetPersonalReport(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPersonalReportResponse], error) {
+	return c.getPersonalReport.CallUnary(ctx, req)
+}
+
 // QuestServiceHandler is an implementation of the quest.v1.QuestService service.
 type QuestServiceHandler interface {
 	StartQuest(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.StartQuestResponse]) error
 	Answer(context.Context, *connect.Request[v1.AnswerRequest]) (*connect.Response[v1.AnswerResponse], error)
 	TakeHint(context.Context, *connect.Request[v1.TakeHintRequest]) (*connect.Response[emptypb.Empty], error)
 	GetResult(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetResultResponse], error)
+	UseLifeline(context.Context, *connect.Request[v1.UseLifelineRequest]) (*connect.Response[v1.UseLifelineResponse], error)
+	GetPersonalReport(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPersonalReportResponse], error)
 }
 
 // NewQuestServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -157,6 +191,18 @@ func NewQuestServiceHandler(svc QuestServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(questServiceMethods.ByName("GetResult")),
 		connect.WithHandlerOptions(opts...),
 	)
+	questServiceUseLifelineHandler := connect.NewUnaryHandler(
+		QuestServiceUseLifelineProcedure,
+		svc.UseLifeline,
+		connect.WithSchema(questServiceMethods.ByName("UseLifeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	questServiceGetPersonalReportHandler := connect.NewUnaryHandler(
+		QuestServiceGetPersonalReportProcedure,
+		svc.GetPersonalReport,
+		connect.WithSchema(questServiceMethods.ByName("GetPersonalReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/quest.v1.QuestService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case QuestServiceStartQuestProcedure:
@@ -167,6 +213,10 @@ func NewQuestServiceHandler(svc QuestServiceHandler, opts ...connect.HandlerOpti
 			questServiceTakeHintHandler.ServeHTTP(w, r)
 		case QuestServiceGetResultProcedure:
 			questServiceGetResultHandler.ServeHTTP(w, r)
+		case QuestServiceUseLifelineProcedure:
+			questServiceUseLifelineHandler.ServeHTTP(w, r)
+		case QuestServiceGetPersonalReportProcedure:
+			questServiceGetPersonalReportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -191,3 +241,11 @@ func (UnimplementedQuestServiceHandler) TakeHint(context.Context, *connect.Reque
 func (UnimplementedQuestServiceHandler) GetResult(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetResultResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("quest.v1.QuestService.GetResult is not implemented"))
 }
+
+func (UnimplementedQuestServiceHandler) UseLifeline(context.Context, *connect.Request[v1.UseLifelineRequest]) (*connect.Response[v1.UseLifelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("quest.v1.QuestService.UseLifeline is not implemented"))
+}
+
+func (UnimplementedQuestServiceHandler) GetPersonalReport(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPersonalReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("quest.v1.QuestService.GetPersonalReport is not implemented"))
+}
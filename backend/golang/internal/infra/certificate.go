@@ -2,37 +2,120 @@ package infra
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"log"
+	"math/big"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/crypto/acme/autocert"
 )
 
-func CreateCertificateFromFiles(certFile, keyFile string) ([]tls.Certificate, error) {
-	// ContainsFuncでどちらかのファイルが存在しなかった場合にtrueを返す
-	if slices.ContainsFunc([]string{certFile, keyFile}, func(file string) bool {
+// 証明書ファイルの更新を確認する間隔の既定値
+const DefaultCertificateReloadInterval time.Duration = 30 * time.Second
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func statFiles(files ...string) ([]fileStamp, error) {
+	stamps := make([]fileStamp, 0, len(files))
+	for _, file := range files {
+		// certbotなどはシンボリックリンクを張り替えるので、リンク先の情報を見る
 		info, err := os.Stat(file)
 		if err != nil {
-			return os.IsNotExist(err)
+			return nil, err
 		}
-		return info.IsDir()
-	}) {
-		return nil, errors.New("cert file or key file is not exists.")
+		if info.IsDir() {
+			return nil, fmt.Errorf("%s is a directory", file)
+		}
+		stamps = append(stamps, fileStamp{modTime: info.ModTime(), size: info.Size()})
 	}
-	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	return stamps, nil
+}
+
+// 証明書と鍵のファイルを定期的に確認して、更新されていれば読み込み直す
+// 読み込みに失敗した場合は直前の証明書を使い続けるので、更新中に証明書と鍵が食い違っていても止まらない
+type certificateReloader struct {
+	certFile string
+	keyFile  string
+	current  atomic.Pointer[tls.Certificate]
+	stamps   []fileStamp
+}
+
+func (cr *certificateReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return cr.current.Load(), nil
+}
+
+func (cr *certificateReloader) reloadIfChanged() {
+	stamps, err := statFiles(cr.certFile, cr.keyFile)
 	if err != nil {
-		return nil, err
+		log.Printf("failed to check certificate files, keep using the current one: %v", err)
+		return
+	}
+	if slices.Equal(stamps, cr.stamps) {
+		return
+	}
+	// 失敗した場合も同じ内容で何度も読み込み直さないように、確認した時点の情報を覚えておく
+	cr.stamps = stamps
+	certificate, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		log.Printf("failed to reload certificate, keep using the current one: %v", err)
+		return
 	}
+	cr.current.Store(&certificate)
+	log.Printf("reloaded certificate from %s (expires at %s)", cr.certFile, certificate.Leaf.NotAfter.Format(time.RFC3339))
+}
 
-	return []tls.Certificate{certificate}, nil
+func (cr *certificateReloader) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cr.reloadIfChanged()
+		}
+	}
+}
+
+// 起動時に読み込めない場合はエラーを返し、以降はintervalごとにファイルの更新を確認する
+// 戻り値のCancelFuncで確認を止める
+func CreateCertificateFromFiles(certFile, keyFile string, interval time.Duration) (func(*tls.ClientHelloInfo) (*tls.Certificate, error), context.CancelFunc, error) {
+	stamps, err := statFiles(certFile, keyFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cert file or key file is not exists: %w", err)
+	}
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	reloader := &certificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		stamps:   stamps,
+	}
+	reloader.current.Store(&certificate)
+	ctx, cancel := context.WithCancel(context.Background())
+	go reloader.watch(ctx, interval)
+	return reloader.getCertificate, cancel, nil
 }
 
 func CreateCertificateWithAutoCert(domain string) (func(*tls.ClientHelloInfo) (*tls.Certificate, error), context.CancelFunc, error) {
@@ -99,3 +182,239 @@ func CreateCertificateWithAutoCert(domain string) (func(*tls.ClientHelloInfo) (*
 	}
 	return getCertificate, cancel, nil
 }
+
+// 自己署名の証明書の保存先のファイル名
+const (
+	SelfSignedCAFile     string = "ca.pem"
+	SelfSignedCAKeyFile  string = "ca-key.pem"
+	SelfSignedCertFile   string = "server.pem"
+	SelfSignedKeyFile    string = "server-key.pem"
+	selfSignedCommonName string = "Cursed Frame Local CA"
+)
+
+// 期限がこれより短くなったら作り直す
+const SelfSignedRenewBefore time.Duration = 30 * 24 * time.Hour
+
+// iOSなどはサーバ証明書の有効期間が398日を超えると信頼しないので、それより短くする
+const (
+	SelfSignedCAValidity   time.Duration = 10 * 365 * 24 * time.Hour
+	SelfSignedCertValidity time.Duration = 397 * 24 * time.Hour
+)
+
+// マシンのホスト名とループバック以外のIPアドレス、localhostを返す
+func LocalHostNames() []string {
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		hosts = append(hosts, hostname)
+		// mDNSで名前解決できる環境のために.local付きの名前も含める
+		if !strings.Contains(hostname, ".") {
+			hosts = append(hosts, hostname+".local")
+		}
+	}
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return hosts
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() || ipNet.IP.IsLinkLocalUnicast() {
+			continue
+		}
+		hosts = append(hosts, ipNet.IP.String())
+	}
+	return hosts
+}
+
+func writePEM(file string, blockType string, der []byte, perm os.FileMode) error {
+	return os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), perm)
+}
+
+// 保存済みの証明書と鍵が読めて、期限に余裕があるものだけ使う
+func loadCachedKeyPair(certFile string, keyFile string) (*tls.Certificate, *x509.Certificate, error) {
+	pair, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if time.Until(leaf.NotAfter) < SelfSignedRenewBefore {
+		return nil, nil, errors.New("certificate expires soon")
+	}
+	pair.Leaf = leaf
+	return &pair, leaf, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+// CAの名前制約を配信するホスト名とIPアドレスだけに絞り、端末に信頼させたCAで他のサイトの証明書を作れないようにする
+// 許可する名前が無い種類は、空のままだと無制限になるので全て除外する
+func applyNameConstraints(template *x509.Certificate, hosts []string) {
+	template.PermittedDNSDomainsCritical = true
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			template.PermittedIPRanges = append(template.PermittedIPRanges, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+		} else {
+			template.PermittedDNSDomains = append(template.PermittedDNSDomains, host)
+		}
+	}
+	if len(template.PermittedDNSDomains) == 0 {
+		template.ExcludedDNSDomains = []string{""}
+	}
+	if len(template.PermittedIPRanges) == 0 {
+		template.ExcludedIPRanges = []*net.IPNet{
+			{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(0, 32)},
+			{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)},
+		}
+	}
+}
+
+// 保存済みのCAの名前制約が全てのホストを許可しているか
+// 名前制約が無い古いCAは作り直す
+func caCoversHosts(caCert *x509.Certificate, hosts []string) bool {
+	if !caCert.PermittedDNSDomainsCritical {
+		return false
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			if !slices.ContainsFunc(caCert.PermittedIPRanges, func(r *net.IPNet) bool { return r.Contains(ip) }) {
+				return false
+			}
+		} else if !slices.Contains(caCert.PermittedDNSDomains, host) {
+			return false
+		}
+	}
+	return true
+}
+
+func createSelfSignedCA(dir string, hosts []string) (*tls.Certificate, *x509.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	hostname, _ := os.Hostname()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   selfSignedCommonName,
+			Organization: []string{"Cursed Frame"},
+			// 複数のマシンのCAを入れた端末でも見分けられるようにホスト名を入れる
+			OrganizationalUnit: []string{hostname},
+		},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(SelfSignedCAValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	applyNameConstraints(template, hosts)
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = writePEM(filepath.Join(dir, SelfSignedCAKeyFile), "PRIVATE KEY", keyDER, 0o600); err != nil {
+		return nil, nil, err
+	}
+	if err = writePEM(filepath.Join(dir, SelfSignedCAFile), "CERTIFICATE", der, 0o644); err != nil {
+		return nil, nil, err
+	}
+	return loadCachedKeyPair(filepath.Join(dir, SelfSignedCAFile), filepath.Join(dir, SelfSignedCAKeyFile))
+}
+
+func createSelfSignedServerCert(dir string, ca *tls.Certificate, caCert *x509.Certificate, hosts []string) (*tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: hosts[0], Organization: []string{"Cursed Frame"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(SelfSignedCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, ca.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err = writePEM(filepath.Join(dir, SelfSignedKeyFile), "PRIVATE KEY", keyDER, 0o600); err != nil {
+		return nil, err
+	}
+	if err = writePEM(filepath.Join(dir, SelfSignedCertFile), "CERTIFICATE", der, 0o644); err != nil {
+		return nil, err
+	}
+	pair, _, err := loadCachedKeyPair(filepath.Join(dir, SelfSignedCertFile), filepath.Join(dir, SelfSignedKeyFile))
+	return pair, err
+}
+
+// 保存済みのサーバ証明書が今のCAで署名されていて、全てのホストを含んでいるか
+func coversHosts(leaf *x509.Certificate, caCert *x509.Certificate, hosts []string) bool {
+	if leaf.CheckSignatureFrom(caCert) != nil {
+		return false
+	}
+	for _, host := range hosts {
+		if leaf.VerifyHostname(host) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// LAN内で使うためのローカルCAとサーバ証明書をdirに作って使い回す
+// CAは一度端末に信頼させたら使い続けたいので期限が近づくか、名前制約がhostsを含まなくなるまで作り直さず、
+// サーバ証明書はIPアドレスが変わるなどしてhostsを含まなくなった場合に作り直す
+// 戻り値のCA証明書はDER形式で、端末にインストールしてもらうために配布する
+func CreateSelfSignedCertificate(dir string, hosts []string) ([]tls.Certificate, []byte, error) {
+	if len(hosts) == 0 {
+		return nil, nil, errors.New("no host name for self-signed certificate")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, nil, err
+	}
+	ca, caCert, err := loadCachedKeyPair(filepath.Join(dir, SelfSignedCAFile), filepath.Join(dir, SelfSignedCAKeyFile))
+	if err != nil || !caCoversHosts(caCert, hosts) {
+		if ca, caCert, err = createSelfSignedCA(dir, hosts); err != nil {
+			return nil, nil, fmt.Errorf("failed to create local CA: %w", err)
+		}
+	}
+	server, leaf, err := loadCachedKeyPair(filepath.Join(dir, SelfSignedCertFile), filepath.Join(dir, SelfSignedKeyFile))
+	if err != nil || !coversHosts(leaf, caCert, hosts) {
+		if server, err = createSelfSignedServerCert(dir, ca, caCert, hosts); err != nil {
+			return nil, nil, fmt.Errorf("failed to create server certificate: %w", err)
+		}
+	}
+	// 端末がCAを辿れるようにチェーンに含める
+	server.Certificate = append(server.Certificate, ca.Certificate[0])
+	return []tls.Certificate{*server}, ca.Certificate[0], nil
+}
@@ -0,0 +1,142 @@
+package infra
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// commonNameの自己署名証明書と鍵をPEMで書き出す
+// 更新を確実に検知させるため、更新日時はmodTimeに揃える
+func writeKeyPair(t *testing.T, certFile string, keyFile string, commonName string, modTime time.Time) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), modTime)
+	writeFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), modTime)
+}
+
+func writeFile(t *testing.T, file string, data []byte, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(file, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func servedCommonName(t *testing.T, getCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)) string {
+	t.Helper()
+	certificate, err := getCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return certificate.Leaf.Subject.CommonName
+}
+
+func TestCreateCertificateFromFilesReloadsRenewedFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	writeKeyPair(t, certFile, keyFile, "first", time.Now().Add(-time.Hour))
+	getCertificate, cancel, err := CreateCertificateFromFiles(certFile, keyFile, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cancel()
+	if got := servedCommonName(t, getCertificate); got != "first" {
+		t.Fatalf("served certificate = %q, want first", got)
+	}
+
+	writeKeyPair(t, certFile, keyFile, "renewed", time.Now())
+	deadline := time.Now().Add(5 * time.Second)
+	for servedCommonName(t, getCertificate) != "renewed" {
+		if time.Now().After(deadline) {
+			t.Fatal("renewed certificate was not served")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// 確認の間隔を待たずにreloadIfChangedを呼べるように、直接組み立てる
+func newTestReloader(t *testing.T) *certificateReloader {
+	t.Helper()
+	dir := t.TempDir()
+	cr := &certificateReloader{certFile: filepath.Join(dir, "cert.pem"), keyFile: filepath.Join(dir, "key.pem")}
+	writeKeyPair(t, cr.certFile, cr.keyFile, "first", time.Now().Add(-time.Hour))
+	cr.reloadIfChanged()
+	if got := servedCommonName(t, cr.getCertificate); got != "first" {
+		t.Fatalf("served certificate = %q, want first", got)
+	}
+	return cr
+}
+
+// 証明書と鍵の片方だけが更新された途中の状態では、前の証明書を使い続ける
+func TestCertificateReloaderKeepsCurrentOnBrokenPair(t *testing.T) {
+	cr := newTestReloader(t)
+	other := t.TempDir()
+	writeKeyPair(t, filepath.Join(other, "cert.pem"), filepath.Join(other, "key.pem"), "mismatched", time.Now())
+	data, err := os.ReadFile(filepath.Join(other, "cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, cr.certFile, data, time.Now())
+
+	cr.reloadIfChanged()
+	if got := servedCommonName(t, cr.getCertificate); got != "first" {
+		t.Errorf("served certificate = %q, want first to be kept", got)
+	}
+
+	if err := os.Remove(cr.certFile); err != nil {
+		t.Fatal(err)
+	}
+	cr.reloadIfChanged()
+	if got := servedCommonName(t, cr.getCertificate); got != "first" {
+		t.Errorf("served certificate = %q after the file was removed, want first to be kept", got)
+	}
+}
+
+func TestCreateCertificateFromFilesWithoutFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, _, err := CreateCertificateFromFiles(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), time.Hour); err == nil {
+		t.Error("CreateCertificateFromFiles() error = nil, want error for missing files")
+	}
+	if _, _, err := CreateCertificateFromFiles(dir, dir, time.Hour); err == nil {
+		t.Error("CreateCertificateFromFiles() error = nil, want error for directories")
+	}
+}
+
+func TestCertificateReloaderIgnoresUnchangedFiles(t *testing.T) {
+	cr := newTestReloader(t)
+	served, _ := cr.getCertificate(nil)
+	cr.reloadIfChanged()
+	if again, _ := cr.getCertificate(nil); again != served {
+		t.Error("certificate was reloaded although the files were not changed")
+	}
+}
@@ -0,0 +1,108 @@
+package infra
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+)
+
+// caで署名したhostsのサーバ証明書を作る、名前制約を確かめるためにCreateSelfSignedCertificateを通さない
+func signServerCert(t *testing.T, ca *tls.Certificate, caCert *x509.Certificate, hosts ...string) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: caCert.SerialNumber,
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, ca.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf
+}
+
+func TestSelfSignedCANameConstraints(t *testing.T) {
+	tests := []struct {
+		name    string
+		caHosts []string
+		leaf    []string
+		wantErr bool
+	}{
+		{name: "served host name", caHosts: []string{"quiz-pc", "192.168.1.10"}, leaf: []string{"quiz-pc"}},
+		{name: "served IP address", caHosts: []string{"quiz-pc", "192.168.1.10"}, leaf: []string{"192.168.1.10"}},
+		{name: "other domain", caHosts: []string{"quiz-pc", "192.168.1.10"}, leaf: []string{"example.com"}, wantErr: true},
+		{name: "other IP address", caHosts: []string{"quiz-pc", "192.168.1.10"}, leaf: []string{"192.168.1.11"}, wantErr: true},
+		// 許可したホスト名が無ければ、どのドメインの証明書も作れない
+		{name: "domain without permitted names", caHosts: []string{"192.168.1.10"}, leaf: []string{"example.com"}, wantErr: true},
+		{name: "IP address without permitted ranges", caHosts: []string{"quiz-pc"}, leaf: []string{"10.0.0.1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca, caCert, err := createSelfSignedCA(t.TempDir(), tt.caHosts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			leaf := signServerCert(t, ca, caCert, tt.leaf...)
+			roots := x509.NewCertPool()
+			roots.AddCert(caCert)
+			_, err = leaf.Verify(x509.VerifyOptions{DNSName: tt.leaf[0], Roots: roots})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Verify(%v) error = %v, wantErr %v", tt.leaf, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateSelfSignedCertificateRecreatesCAForNewHosts(t *testing.T) {
+	dir := t.TempDir()
+	_, firstCA, err := CreateSelfSignedCertificate(dir, []string{"quiz-pc", "192.168.1.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, sameCA, err := CreateSelfSignedCertificate(dir, []string{"quiz-pc", "192.168.1.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(firstCA) != string(sameCA) {
+		t.Error("CA was recreated for the same hosts")
+	}
+	certs, newCA, err := CreateSelfSignedCertificate(dir, []string{"quiz-pc", "192.168.1.20"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(firstCA) == string(newCA) {
+		t.Fatal("CA was kept although its name constraints do not cover the new address")
+	}
+	caCert, err := x509.ParseCertificate(newCA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	if _, err := certs[0].Leaf.Verify(x509.VerifyOptions{DNSName: "192.168.1.20", Roots: roots}); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+}
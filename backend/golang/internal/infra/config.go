@@ -0,0 +1,378 @@
+package infra
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 設定ファイルとして読み込める拡張子
+var ConfigExts = []string{".yaml", ".yml", ".json", ".toml"}
+
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file" toml:"cert_file"`
+	KeyFile  string `yaml:"key_file" toml:"key_file"`
+	// autocertで証明書を取得するドメイン、起動時の案内の表示にも使う
+	Domain   string `yaml:"domain" toml:"domain"`
+	AutoCert bool   `yaml:"autocert" toml:"autocert"`
+	// cert_fileとkey_fileの更新を確認する間隔
+	ReloadInterval time.Duration `yaml:"reload_interval" toml:"reload_interval"`
+	// LAN内で使うためにローカルCAで署名した証明書を作る
+	SelfSigned bool `yaml:"self_signed" toml:"self_signed"`
+}
+
+type RateLimitConfig struct {
+	// 参加者数がこれより少ない場合もこの人数分は許容する
+	MinUsers int `yaml:"min_users" toml:"min_users"`
+	// ゲスト向けAPIの参加者一人あたりの１秒間のリクエスト数
+	PerUser float64 `yaml:"per_user" toml:"per_user"`
+	// 参加者一人あたりの瞬間的に許容するリクエスト数
+	BurstPerUser int `yaml:"burst_per_user" toml:"burst_per_user"`
+}
+
+type QuestionnaireConfig struct {
+	// 参加者一人あたりの質問数（0の場合は全ての質問）
+	PerGuest int `yaml:"per_guest" toml:"per_guest"`
+	// 参加者全員に共通して聞く質問数
+	Common int `yaml:"common" toml:"common"`
+}
+
+// 顔が判別できなくなるほど小さくしないための下限
+const MinImageOutputSize int = 128
+
+// S3互換のオブジェクトストレージの接続先
+type S3Config struct {
+	// 例: https://s3.ap-northeast-1.amazonaws.com、http://localhost:9000
+	Endpoint string `yaml:"endpoint" toml:"endpoint"`
+	Region   string `yaml:"region" toml:"region"`
+	Bucket   string `yaml:"bucket" toml:"bucket"`
+	// バケット内で画像を置く場所、バケット全体を使わないように必須にする
+	Prefix          string `yaml:"prefix" toml:"prefix"`
+	AccessKeyID     string `yaml:"access_key_id" toml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key" toml:"secret_access_key"`
+	// trueの場合はバケット名をホスト名ではなくパスに含める（MinIOなど）
+	PathStyle bool `yaml:"path_style" toml:"path_style"`
+	// trueの場合は終了時に、今回の起動の間にアップロードされた画像を消す
+	CleanupOnExit bool `yaml:"cleanup_on_exit" toml:"cleanup_on_exit"`
+}
+
+type ImagesConfig struct {
+	// 画像の保存先（local、memory、s3）
+	Store string   `yaml:"store" toml:"store"`
+	S3    S3Config `yaml:"s3" toml:"s3"`
+	// 署名付き画像URLの有効期間、１問の出題中は有効なようにする
+	URLTTL time.Duration `yaml:"url_ttl" toml:"url_ttl"`
+	// アップロードを受け付けるファイルの大きさの上限（バイト）
+	MaxUploadBytes int64 `yaml:"max_upload_bytes" toml:"max_upload_bytes"`
+	// 保存する画像の長辺のピクセル数
+	OutputSize int `yaml:"output_size" toml:"output_size"`
+}
+
+// 空の場合は起動毎にランダムな文字列を含むパスを生成する
+type PathsConfig struct {
+	Admin string `yaml:"admin" toml:"admin"`
+	Guest string `yaml:"guest" toml:"guest"`
+}
+
+type Config struct {
+	Listen string `yaml:"listen" toml:"listen"`
+	// 参加者に案内するURLの起点（例: https://example.com）、リバースプロキシなどの後ろで動かす場合に指定する
+	PublicURL string      `yaml:"public_url" toml:"public_url"`
+	Paths     PathsConfig `yaml:"paths" toml:"paths"`
+	// 参加者数の見込み、0の場合は未定
+	UserNum int `yaml:"user_num" toml:"user_num"`
+	// 参加者数の上限、0の場合は無制限
+	MaxUserNum int `yaml:"max_user_num" toml:"max_user_num"`
+	TeamNum    int `yaml:"team_num" toml:"team_num"`
+	// 再起動後も残すデータの保存先、空の場合はユーザ設定ディレクトリ配下
+	DataDir string `yaml:"data_dir" toml:"data_dir"`
+	// 画像やゲーム中のDBを置く一時ディレクトリを作る場所、空の場合はOSの既定の場所
+	TempDir string `yaml:"temp_dir" toml:"temp_dir"`
+	// 終了時にゲームのデータをZIPで書き出す先（ファイルかディレクトリ）、空の場合は書き出さない
+	ExportPath    string              `yaml:"export_path" toml:"export_path"`
+	TLS           TLSConfig           `yaml:"tls" toml:"tls"`
+	RateLimit     RateLimitConfig     `yaml:"rate_limit" toml:"rate_limit"`
+	Questionnaire QuestionnaireConfig `yaml:"questionnaire" toml:"questionnaire"`
+	Images        ImagesConfig        `yaml:"images" toml:"images"`
+	Questions     string              `yaml:"questions" toml:"questions"`
+	// trueの場合は質問パックで保存されている質問を置き換え、falseの場合は今回の起動の間だけ使う
+	ReplaceQuestions bool           `yaml:"replace_questions" toml:"replace_questions"`
+	DenyList         string         `yaml:"deny_list" toml:"deny_list"`
+	RulesFile        string         `yaml:"rules_file" toml:"rules_file"`
+	Rules            core.GameRules `yaml:"rules" toml:"rules"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Listen:  ":8888",
+		TeamNum: 2,
+		RateLimit: RateLimitConfig{
+			MinUsers:     10,
+			PerUser:      5,
+			BurstPerUser: 20,
+		},
+		Questionnaire: QuestionnaireConfig{
+			PerGuest: 0,
+			Common:   2,
+		},
+		TLS: TLSConfig{
+			ReloadInterval: DefaultCertificateReloadInterval,
+		},
+		Images: ImagesConfig{
+			Store:          ImageStoreLocal,
+			URLTTL:         15 * time.Minute,
+			MaxUploadBytes: core.DefaultMaxUploadBytes,
+			OutputSize:     core.DefaultImageOutputSize,
+			S3: S3Config{
+				Region:    "us-east-1",
+				PathStyle: true,
+			},
+		},
+		Rules: core.DefaultGameRules(),
+	}
+}
+
+// 環境変数で上書きできる設定の項目と環境変数名
+// ゲームのルールはPCF_RULE_*で別に扱うのでここには含めない
+type ConfigKey struct {
+	Key string
+	Env string
+}
+
+func ConfigKeys(prefix string) []ConfigKey {
+	keys := []ConfigKey{
+		{Key: "listen"},
+		{Key: "public_url"},
+		{Key: "paths.admin"},
+		{Key: "paths.guest"},
+		{Key: "user_num"},
+		{Key: "max_user_num"},
+		{Key: "team_num"},
+		{Key: "data_dir"},
+		{Key: "temp_dir"},
+		{Key: "export_path"},
+		// 以前からある環境変数名はそのまま使えるようにする
+		{Key: "tls.cert_file", Env: prefix + "SSL_CERT_FILE"},
+		{Key: "tls.key_file", Env: prefix + "SSL_KEY_FILE"},
+		{Key: "tls.domain", Env: prefix + "DOMAIN"},
+		{Key: "tls.autocert"},
+		{Key: "tls.reload_interval"},
+		{Key: "tls.self_signed"},
+		{Key: "rate_limit.min_users"},
+		{Key: "rate_limit.per_user"},
+		{Key: "rate_limit.burst_per_user"},
+		{Key: "questionnaire.per_guest"},
+		{Key: "questionnaire.common"},
+		{Key: "images.url_ttl"},
+		{Key: "images.max_upload_bytes"},
+		{Key: "images.output_size"},
+		{Key: "images.store"},
+		{Key: "images.s3.endpoint"},
+		{Key: "images.s3.region"},
+		{Key: "images.s3.bucket"},
+		{Key: "images.s3.prefix"},
+		{Key: "images.s3.access_key_id"},
+		{Key: "images.s3.secret_access_key"},
+		{Key: "images.s3.path_style"},
+		{Key: "images.s3.cleanup_on_exit"},
+		{Key: "questions"},
+		{Key: "replace_questions"},
+		{Key: "deny_list", Env: prefix + "DENYLIST"},
+		{Key: "rules_file", Env: prefix + "RULES"},
+	}
+	for i := range keys {
+		if keys[i].Env == "" {
+			keys[i].Env = prefix + strings.ToUpper(strings.ReplaceAll(keys[i].Key, ".", "_"))
+		}
+	}
+	return keys
+}
+
+// YAML/JSONかTOMLで書かれた設定ファイルを読み込み、書かれている項目だけbaseを上書きする
+// ファイル内の相対パスは設定ファイルのあるディレクトリからの相対パスとして扱う
+func LoadConfig(path string, base Config) (Config, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if !slices.Contains(ConfigExts, ext) {
+		return base, fmt.Errorf("%s: unsupported config format (supported: %s)", path, strings.Join(ConfigExts, ", "))
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return base, err
+	}
+	config := base
+	if len(bytes.TrimSpace(data)) == 0 {
+		return config, nil
+	}
+	if ext == ".toml" {
+		meta, err := toml.Decode(string(data), &config)
+		if err != nil {
+			var parseErr toml.ParseError
+			if errors.As(err, &parseErr) {
+				return base, fmt.Errorf("%s:%d: %s", path, parseErr.Position.Line, parseErr.Message)
+			}
+			return base, fmt.Errorf("%s: %w", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			unknown := make([]string, 0, len(undecoded))
+			for _, key := range undecoded {
+				unknown = append(unknown, key.String())
+			}
+			return base, fmt.Errorf("%s: unknown keys: %s", path, strings.Join(unknown, ", "))
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&config); err != nil {
+			return base, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	dir := filepath.Dir(path)
+	paths := func(c *Config) []*string {
+		return []*string{&c.DataDir, &c.TempDir, &c.ExportPath, &c.TLS.CertFile, &c.TLS.KeyFile, &c.Questions, &c.DenyList, &c.RulesFile}
+	}
+	// baseから引き継いだだけの値はそのままにする
+	before := paths(&base)
+	for i, p := range paths(&config) {
+		if *p != "" && *p != *before[i] && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	}
+	return config, nil
+}
+
+// keyの項目だけをvalueで上書きする
+func OverrideConfig(config Config, key string, value string) (Config, error) {
+	overridden := config
+	if err := overrideByKey(&overridden, key, value); err != nil {
+		return config, err
+	}
+	return overridden, nil
+}
+
+// .区切りのkeyから１項目だけのYAMLのノードを組み立ててデコードする
+// 値は文字列のまま渡すので、パスに:や#が含まれていてもYAMLとして解釈されない
+func overrideByKey(out any, key string, value string) error {
+	parts := strings.Split(key, ".")
+	node := &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+	for i := len(parts) - 1; i >= 0; i-- {
+		node = &yaml.Node{
+			Kind:    yaml.MappingNode,
+			Content: []*yaml.Node{{Kind: yaml.ScalarNode, Value: parts[i]}, node},
+		}
+	}
+	// KnownFieldsはDecoderにしか無いので、一度YAMLに書き出してから読み直す
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(out); err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	return nil
+}
+
+// 問題のある項目を全てまとめて返す
+func (c Config) Validate() error {
+	errs := make([]error, 0)
+	if _, _, err := net.SplitHostPort(c.Listen); err != nil {
+		errs = append(errs, fmt.Errorf("listen must be host:port, but got %q", c.Listen))
+	}
+	if c.PublicURL != "" {
+		if u, err := url.Parse(c.PublicURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("public_url must be like https://example.com, but got %q", c.PublicURL))
+		}
+	}
+	if err := ValidatePaths(c.Paths.Admin, c.Paths.Guest); err != nil {
+		errs = append(errs, err)
+	}
+	if c.UserNum < 0 {
+		errs = append(errs, fmt.Errorf("user_num must not be negative, but got %d", c.UserNum))
+	}
+	if c.MaxUserNum < 0 {
+		errs = append(errs, fmt.Errorf("max_user_num must not be negative, but got %d", c.MaxUserNum))
+	} else if c.MaxUserNum > 0 && c.UserNum > c.MaxUserNum {
+		errs = append(errs, fmt.Errorf("user_num %d exceeds max_user_num %d", c.UserNum, c.MaxUserNum))
+	}
+	if c.TeamNum < model.MinTeamNum || c.TeamNum > model.MaxTeamNum {
+		errs = append(errs, fmt.Errorf("team_num must be between %d and %d, but got %d", model.MinTeamNum, model.MaxTeamNum, c.TeamNum))
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("tls.cert_file and tls.key_file must be specified together"))
+	}
+	if c.TLS.ReloadInterval < time.Second {
+		errs = append(errs, fmt.Errorf("tls.reload_interval must be at least 1s, but got %s", c.TLS.ReloadInterval))
+	}
+	tlsModes := 0
+	for _, enabled := range []bool{c.TLS.CertFile != "", c.TLS.AutoCert, c.TLS.SelfSigned} {
+		if enabled {
+			tlsModes++
+		}
+	}
+	if tlsModes > 1 {
+		errs = append(errs, errors.New("only one of tls.cert_file, tls.autocert and tls.self_signed can be used"))
+	}
+	if c.Images.URLTTL < time.Minute {
+		errs = append(errs, fmt.Errorf("images.url_ttl must be at least 1m, but got %s", c.Images.URLTTL))
+	}
+	if c.Images.MaxUploadBytes <= 0 {
+		errs = append(errs, fmt.Errorf("images.max_upload_bytes must be positive, but got %d", c.Images.MaxUploadBytes))
+	}
+	if c.Images.OutputSize < MinImageOutputSize || c.Images.OutputSize > core.MaxSourceDimension {
+		errs = append(errs, fmt.Errorf("images.output_size must be between %d and %d, but got %d", MinImageOutputSize, core.MaxSourceDimension, c.Images.OutputSize))
+	}
+	if !slices.Contains(ImageStoreKinds, c.Images.Store) {
+		errs = append(errs, fmt.Errorf("images.store must be one of %s, but got %q", strings.Join(ImageStoreKinds, ", "), c.Images.Store))
+	} else if c.Images.Store == ImageStoreS3 {
+		if u, err := url.Parse(c.Images.S3.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("images.s3.endpoint must be like https://s3.example.com, but got %q", c.Images.S3.Endpoint))
+		}
+		if c.Images.S3.Region == "" {
+			errs = append(errs, errors.New("images.s3.region is required"))
+		}
+		if c.Images.S3.Bucket == "" {
+			errs = append(errs, errors.New("images.s3.bucket is required"))
+		}
+		if strings.Trim(c.Images.S3.Prefix, "/") == "" {
+			errs = append(errs, errors.New("images.s3.prefix is required"))
+		}
+		if c.Images.S3.AccessKeyID == "" || c.Images.S3.SecretAccessKey == "" {
+			errs = append(errs, errors.New("images.s3.access_key_id and images.s3.secret_access_key are required"))
+		}
+	}
+	if c.RateLimit.MinUsers <= 0 {
+		errs = append(errs, fmt.Errorf("rate_limit.min_users must be positive, but got %d", c.RateLimit.MinUsers))
+	}
+	if c.RateLimit.PerUser <= 0 {
+		errs = append(errs, fmt.Errorf("rate_limit.per_user must be positive, but got %v", c.RateLimit.PerUser))
+	}
+	if c.RateLimit.BurstPerUser <= 0 {
+		errs = append(errs, fmt.Errorf("rate_limit.burst_per_user must be positive, but got %d", c.RateLimit.BurstPerUser))
+	}
+	if _, err := core.NewQuestionnairePolicy(c.Questionnaire.PerGuest, c.Questionnaire.Common); err != nil {
+		errs = append(errs, fmt.Errorf("questionnaire: %w", err))
+	}
+	if err := c.Rules.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("rules: %w", err))
+	} else if c.UserNum > 0 {
+		// 参加者数が未定の場合は、受付の締め切り時にチームの人数を確認する
+		if err := c.Rules.ValidateFor(c.UserNum, c.TeamNum); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
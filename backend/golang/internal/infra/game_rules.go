@@ -0,0 +1,78 @@
+package infra
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+// 環境変数やフラグで個別に上書きできるルールの項目
+// ネストした項目は.で区切る（例: result_thresholds.clear）
+var GameRuleKeys = []string{
+	"countdown_seconds",
+	"hint_bonus_seconds",
+	"max_hint_length",
+	"answer_timeout",
+	"max_choice_num",
+	"lobby_tick",
+	"min_team_user",
+	"result_thresholds.excellent",
+	"result_thresholds.great",
+	"result_thresholds.good_job",
+	"result_thresholds.clear",
+	"awards.fastest_answerer.enabled",
+	"awards.fastest_answerer.min_count",
+	"awards.most_mysterious.enabled",
+	"awards.most_mysterious.min_count",
+	"awards.best_hint_giver.enabled",
+	"awards.best_hint_giver.min_count",
+	"awards.comeback_team.enabled",
+	"awards.comeback_team.min_count",
+	"awards.perfect_streak.enabled",
+	"awards.perfect_streak.min_count",
+}
+
+// ルール項目に対応する環境変数名（例: PCF_RULE_RESULT_THRESHOLDS_CLEAR）
+func GameRuleEnvName(prefix string, key string) string {
+	return prefix + "RULE_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+}
+
+func decodeGameRules(data []byte, rules *core.GameRules) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(rules); err != nil {
+		return err
+	}
+	return nil
+}
+
+// YAMLかJSONで書かれたルールファイルを読み込み、書かれている項目だけbaseを上書きする
+func LoadGameRules(path string, base core.GameRules) (core.GameRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return base, err
+	}
+	rules := base
+	if len(bytes.TrimSpace(data)) == 0 {
+		return rules, nil
+	}
+	if err := decodeGameRules(data, &rules); err != nil {
+		return base, fmt.Errorf("%s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// keyの項目だけをvalueで上書きする
+// 型変換はYAMLのデコーダに任せるので、answer_timeoutなどの時間は"3s"の形式で書く
+func OverrideGameRule(rules core.GameRules, key string, value string) (core.GameRules, error) {
+	overridden := rules
+	if err := overrideByKey(&overridden, key, value); err != nil {
+		return rules, err
+	}
+	return overridden, nil
+}
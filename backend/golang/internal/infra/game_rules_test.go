@@ -0,0 +1,94 @@
+package infra
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+func writeRulesFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// ファイルに書かれていない項目は既定値のまま残る
+func TestLoadGameRulesOverridesOnlyWrittenFields(t *testing.T) {
+	path := writeRulesFile(t, "rules.yaml", "countdown_seconds: 45\nanswer_timeout: 2s\nresult_thresholds:\n  clear: 0.2\n")
+	rules, err := LoadGameRules(path, core.DefaultGameRules())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := core.DefaultGameRules()
+	want.CountdownSeconds = 45
+	want.AnswerTimeout = 2 * time.Second
+	want.ResultThresholds.Clear = 0.2
+	if rules != want {
+		t.Errorf("LoadGameRules() = %+v, want %+v", rules, want)
+	}
+}
+
+func TestLoadGameRulesReadsJSON(t *testing.T) {
+	path := writeRulesFile(t, "rules.json", `{"max_choice_num": 6, "result_thresholds": {"great": 0.8}}`)
+	rules, err := LoadGameRules(path, core.DefaultGameRules())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.MaxChoiceNum != 6 || rules.ResultThresholds.Great != 0.8 {
+		t.Errorf("LoadGameRules() = %+v, want max_choice_num 6 and great 0.8", rules)
+	}
+}
+
+// 綴り間違いの項目を黙って無視しないこと
+func TestLoadGameRulesRejectsUnknownField(t *testing.T) {
+	path := writeRulesFile(t, "rules.yaml", "countdown_second: 45\n")
+	rules, err := LoadGameRules(path, core.DefaultGameRules())
+	if err == nil {
+		t.Fatal("LoadGameRules() error = nil, want error for an unknown field")
+	}
+	if rules != core.DefaultGameRules() {
+		t.Errorf("LoadGameRules() = %+v, want the base rules on error", rules)
+	}
+}
+
+func TestOverrideGameRule(t *testing.T) {
+	rules, err := OverrideGameRule(core.DefaultGameRules(), "result_thresholds.good_job", "0.6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err = OverrideGameRule(rules, "lobby_tick", "10s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.ResultThresholds.GoodJob != 0.6 || rules.LobbyTick != 10*time.Second {
+		t.Errorf("OverrideGameRule() = %+v, want good_job 0.6 and lobby_tick 10s", rules)
+	}
+	if rules.ResultThresholds.Great != core.DefaultGameRules().ResultThresholds.Great {
+		t.Errorf("OverrideGameRule() changed a sibling field: %+v", rules.ResultThresholds)
+	}
+
+	// 賞の設定は２段の入れ子になっている
+	rules, err = OverrideGameRule(rules, "awards.comeback_team.enabled", "false")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.Awards.ComebackTeam.Enabled || rules.Awards.ComebackTeam.MinCount != core.DefaultAwardRules().ComebackTeam.MinCount {
+		t.Errorf("OverrideGameRule() awards.comeback_team = %+v, want only enabled to change", rules.Awards.ComebackTeam)
+	}
+
+	if _, err := OverrideGameRule(rules, "min_team_user", "three"); err == nil {
+		t.Error("OverrideGameRule() error = nil, want error for a non-numeric value")
+	}
+}
+
+func TestGameRuleEnvName(t *testing.T) {
+	if got := GameRuleEnvName("PCF_", "result_thresholds.clear"); got != "PCF_RULE_RESULT_THRESHOLDS_CLEAR" {
+		t.Errorf("GameRuleEnvName() = %q", got)
+	}
+}
@@ -0,0 +1,210 @@
+package infra
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+// images.storeに指定できる画像の保存先
+const (
+	ImageStoreLocal  string = "local"
+	ImageStoreMemory string = "memory"
+	ImageStoreS3     string = "s3"
+)
+
+var ImageStoreKinds = []string{ImageStoreLocal, ImageStoreMemory, ImageStoreS3}
+
+// 書き込み途中のファイルの接頭辞、Listには含めない
+const localImageTempPrefix string = ".tmp-"
+
+// ディレクトリに画像をファイルとして置く
+type LocalImageStore struct {
+	dir string
+}
+
+// キーはファイル名として使うので、ディレクトリを辿れるキーは受け付けない
+func validateImageKey(key string) error {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		return fmt.Errorf("Invalid image key %q", key)
+	}
+	return nil
+}
+
+// 読み込み中のリクエストに書きかけの画像を返さないように、一時ファイルに書いてから置き換える
+func (lis *LocalImageStore) Put(key string, data []byte) error {
+	if err := validateImageKey(key); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(lis.dir, localImageTempPrefix+"*")
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err = os.Rename(f.Name(), filepath.Join(lis.dir, key)); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+func (lis *LocalImageStore) Get(key string) (*model.StoredImage, error) {
+	if err := validateImageKey(key); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(lis.dir, key))
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return model.NewStoredImage(key, info.Size(), info.ModTime(), f), nil
+}
+
+func (lis *LocalImageStore) Delete(key string) error {
+	if err := validateImageKey(key); err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(lis.dir, key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (lis *LocalImageStore) List(prefix string) ([]string, error) {
+	entries, err := os.ReadDir(lis.dir)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, localImageTempPrefix) || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		keys = append(keys, name)
+	}
+	return keys, nil
+}
+
+func NewLocalImageStore(dir string) *LocalImageStore {
+	return &LocalImageStore{
+		dir: dir,
+	}
+}
+
+type memoryImage struct {
+	data    []byte
+	modTime time.Time
+}
+
+// 画像をメモリに置く、ファイルを残したくない動作確認やテストで使う
+type MemoryImageStore struct {
+	images map[string]memoryImage
+	mu     sync.RWMutex
+}
+
+func (mis *MemoryImageStore) Put(key string, data []byte) error {
+	if err := validateImageKey(key); err != nil {
+		return err
+	}
+	mis.mu.Lock()
+	defer mis.mu.Unlock()
+	// 呼び出し側が後でスライスを書き換えても影響しないように複製する
+	mis.images[key] = memoryImage{
+		data:    bytes.Clone(data),
+		modTime: time.Now(),
+	}
+	return nil
+}
+
+func (mis *MemoryImageStore) Get(key string) (*model.StoredImage, error) {
+	if err := validateImageKey(key); err != nil {
+		return nil, err
+	}
+	mis.mu.RLock()
+	defer mis.mu.RUnlock()
+	img, ok := mis.images[key]
+	if !ok {
+		return nil, &fs.PathError{Op: "get", Path: key, Err: fs.ErrNotExist}
+	}
+	return model.NewStoredImage(key, int64(len(img.data)), img.modTime, io.NopCloser(bytes.NewReader(img.data))), nil
+}
+
+func (mis *MemoryImageStore) Delete(key string) error {
+	if err := validateImageKey(key); err != nil {
+		return err
+	}
+	mis.mu.Lock()
+	defer mis.mu.Unlock()
+	delete(mis.images, key)
+	return nil
+}
+
+func (mis *MemoryImageStore) List(prefix string) ([]string, error) {
+	mis.mu.RLock()
+	defer mis.mu.RUnlock()
+	keys := make([]string, 0, len(mis.images))
+	for key := range mis.images {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func NewMemoryImageStore() *MemoryImageStore {
+	return &MemoryImageStore{
+		images: make(map[string]memoryImage),
+		mu:     sync.RWMutex{},
+	}
+}
+
+// 設定に従って画像の保存先を作る、localの場合はlocalDirに置く
+// 返す関数は終了時に呼び、s3でcleanup_on_exitの場合はゲーム中にアップロードされた画像を保存先から消す
+func NewImageStore(config ImagesConfig, localDir string) (usecase.IImageStore, func() error, error) {
+	switch config.Store {
+	case ImageStoreLocal, "":
+		// ディレクトリごと消されるので、個別に消す必要は無い
+		return NewLocalImageStore(localDir), func() error { return nil }, nil
+	case ImageStoreMemory:
+		return NewMemoryImageStore(), func() error { return nil }, nil
+	case ImageStoreS3:
+		store, err := NewS3ImageStore(config.S3)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err = store.CheckBucket(); err != nil {
+			return nil, nil, err
+		}
+		if !config.S3.CleanupOnExit {
+			return store, func() error { return nil }, nil
+		}
+		return store, store.DeleteWritten, nil
+	default:
+		return nil, nil, fmt.Errorf("images.store must be one of %s, but got %q", strings.Join(ImageStoreKinds, ", "), config.Store)
+	}
+}
@@ -0,0 +1,111 @@
+package infra
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+func TestValidateImageKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{key: "0f8fad5b-d9cb-469f-a165-70867728950e_large.jpg"},
+		{key: "", wantErr: true},
+		{key: "..", wantErr: true},
+		{key: "../secret", wantErr: true},
+		{key: "dir/image.jpg", wantErr: true},
+		{key: "/etc/passwd", wantErr: true},
+		{key: ".hidden", wantErr: true},
+		{key: localImageTempPrefix + "123", wantErr: true},
+	}
+	for _, tt := range tests {
+		if err := validateImageKey(tt.key); (err != nil) != tt.wantErr {
+			t.Errorf("validateImageKey(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+		}
+	}
+}
+
+func testImageStoreRoundTrip(t *testing.T, is usecase.IImageStore) {
+	t.Helper()
+	for _, key := range []string{"b_small.jpg", "a_large.jpg", "b_large.jpg"} {
+		if err := is.Put(key, []byte(key)); err != nil {
+			t.Fatalf("Put(%q) error = %v", key, err)
+		}
+	}
+	img, err := is.Get("a_large.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(img)
+	img.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "a_large.jpg" {
+		t.Errorf("Get() = %q, want %q", data, "a_large.jpg")
+	}
+
+	keys, err := is.List("b_")
+	if err != nil {
+		t.Fatal(err)
+	}
+	slices.Sort(keys)
+	if want := []string{"b_large.jpg", "b_small.jpg"}; !slices.Equal(keys, want) {
+		t.Errorf("List(\"b_\") = %v, want %v", keys, want)
+	}
+
+	if err := is.Delete("a_large.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := is.Get("a_large.jpg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Get() after Delete error = %v, want fs.ErrNotExist", err)
+	}
+	// 無いキーを消してもエラーにしない
+	if err := is.Delete("a_large.jpg"); err != nil {
+		t.Errorf("Delete() of a missing key error = %v", err)
+	}
+
+	for _, key := range []string{"../escape.jpg", ""} {
+		if err := is.Put(key, []byte("x")); err == nil {
+			t.Errorf("Put(%q) succeeded, want error", key)
+		}
+		if _, err := is.Get(key); err == nil {
+			t.Errorf("Get(%q) succeeded, want error", key)
+		}
+	}
+}
+
+func TestLocalImageStore(t *testing.T) {
+	testImageStoreRoundTrip(t, NewLocalImageStore(t.TempDir()))
+}
+
+func TestMemoryImageStore(t *testing.T) {
+	testImageStoreRoundTrip(t, NewMemoryImageStore())
+}
+
+// 書き込み途中の一時ファイルはListに含めない
+func TestLocalImageStoreListSkipsTempFiles(t *testing.T) {
+	dir := t.TempDir()
+	lis := NewLocalImageStore(dir)
+	if err := os.WriteFile(filepath.Join(dir, localImageTempPrefix+"a_large.jpg"), []byte("x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := lis.Put("a_large.jpg", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := lis.List("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a_large.jpg"}; !slices.Equal(keys, want) {
+		t.Errorf("List() = %v, want %v", keys, want)
+	}
+}
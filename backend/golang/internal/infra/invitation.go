@@ -0,0 +1,101 @@
+package infra
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+)
+
+// QRコードの画像の一辺のピクセル数の範囲
+const (
+	MinQRCodeSize     int = 64
+	MaxQRCodeSize     int = 1024
+	DefaultQRCodeSize int = 256
+)
+
+var urlPathPattern = regexp.MustCompile(`^(/[A-Za-z0-9._~-]+)+$`)
+
+// adminとguestのパスの検証、空の場合は起動毎にランダムに生成するので検証しない
+func ValidatePaths(adminPath string, guestPath string) error {
+	for _, p := range []struct{ name, path string }{{"paths.admin", adminPath}, {"paths.guest", guestPath}} {
+		if p.path != "" && !urlPathPattern.MatchString(p.path) {
+			return fmt.Errorf("%s must be like /path/to/page without trailing slash, but got %q", p.name, p.path)
+		}
+	}
+	if adminPath == "" || guestPath == "" {
+		return nil
+	}
+	// 片方がもう片方の配下にあると、ルーティングがどちらに振り分けられるか分からなくなる
+	if adminPath == guestPath || strings.HasPrefix(adminPath, guestPath+"/") || strings.HasPrefix(guestPath, adminPath+"/") {
+		return fmt.Errorf("paths.admin %q and paths.guest %q must not overlap", adminPath, guestPath)
+	}
+	return nil
+}
+
+// 外部から見える最初のIPv4アドレス、無ければ空文字
+func LocalIPv4() string {
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return ""
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.IsLoopback() || ipNet.IP.To4() == nil {
+			continue
+		}
+		return ipNet.IP.String()
+	}
+	return ""
+}
+
+// 参加者に案内するURL
+// publicURLが指定されていればそれを使い、無ければドメインかLANのIPアドレスとlistenのポートから組み立てる
+func InvitationURL(publicURL string, listen string, domain string, useTLS bool, path string) string {
+	if publicURL != "" {
+		return strings.TrimSuffix(publicURL, "/") + path
+	}
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+	host, port, _ := net.SplitHostPort(listen)
+	if domain != "" {
+		host = domain
+	} else if host == "" || host == "0.0.0.0" || host == "::" {
+		host = LocalIPv4()
+		if host == "" {
+			host = "localhost"
+		}
+	}
+	if (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		port = ""
+	}
+	u := url.URL{Scheme: scheme, Host: host, Path: path}
+	if port != "" {
+		u.Host = net.JoinHostPort(host, port)
+	}
+	return u.String()
+}
+
+// プロジェクタに映して読み取ってもらうので、多少欠けても読めるように誤り訂正レベルは高めにする
+func EncodeQRCodePNG(content string, size int) ([]byte, error) {
+	code, err := qrcode.New(content, qrcode.High)
+	if err != nil {
+		return nil, err
+	}
+	return code.PNG(size)
+}
+
+// ターミナルに表示するためのQRコード
+// 黒背景のターミナルを想定して、明るい部分を文字で描く
+func QRCodeASCII(content string) (string, error) {
+	code, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return "", err
+	}
+	return code.ToSmallString(false), nil
+}
@@ -0,0 +1,87 @@
+package infra
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestInvitationURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		publicURL string
+		listen    string
+		domain    string
+		useTLS    bool
+		want      string
+	}{
+		{name: "public url wins", publicURL: "https://party.example.com/", listen: ":8888", domain: "example.com", useTLS: true, want: "https://party.example.com/abc/guest"},
+		{name: "domain with tls on the default port", listen: ":443", domain: "example.com", useTLS: true, want: "https://example.com/abc/guest"},
+		{name: "domain on another port", listen: ":8888", domain: "example.com", useTLS: true, want: "https://example.com:8888/abc/guest"},
+		{name: "explicit host", listen: "192.168.1.10:80", want: "http://192.168.1.10/abc/guest"},
+		{name: "ipv6 host", listen: "[fd00::1]:8888", want: "http://[fd00::1]:8888/abc/guest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InvitationURL(tt.publicURL, tt.listen, tt.domain, tt.useTLS, "/abc/guest"); got != tt.want {
+				t.Errorf("InvitationURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// 全てのインターフェイスで待ち受ける場合は、参加者がアクセスできるホスト名に置き換える
+func TestInvitationURLReplacesWildcardHost(t *testing.T) {
+	for _, listen := range []string{":8888", "0.0.0.0:8888", "[::]:8888"} {
+		got := InvitationURL("", listen, "", false, "/abc/guest")
+		if strings.Contains(got, "0.0.0.0") || strings.Contains(got, "[::]") || strings.HasPrefix(got, "http://:") {
+			t.Errorf("InvitationURL(%q) = %q, want a reachable host", listen, got)
+		}
+		if !strings.HasSuffix(got, ":8888/abc/guest") {
+			t.Errorf("InvitationURL(%q) = %q, want the listen port and path kept", listen, got)
+		}
+	}
+}
+
+func TestValidatePaths(t *testing.T) {
+	valid := [][2]string{{"", ""}, {"/admin", ""}, {"/party/admin", "/party/guest"}, {"/admin", "/admins"}}
+	for _, p := range valid {
+		if err := ValidatePaths(p[0], p[1]); err != nil {
+			t.Errorf("ValidatePaths(%q, %q) error = %v, want nil", p[0], p[1], err)
+		}
+	}
+	invalid := [][2]string{{"admin", ""}, {"", "/guest/"}, {"/a b", ""}, {"/party", "/party"}, {"/party", "/party/guest"}}
+	for _, p := range invalid {
+		if err := ValidatePaths(p[0], p[1]); err == nil {
+			t.Errorf("ValidatePaths(%q, %q) error = nil, want error", p[0], p[1])
+		}
+	}
+}
+
+func TestResolvePaths(t *testing.T) {
+	adminPath, guestPath, err := ResolvePaths("/fixed/admin", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adminPath != "/fixed/admin" {
+		t.Errorf("adminPath = %q, want the fixed path kept", adminPath)
+	}
+	if !strings.HasSuffix(guestPath, "/guest") || ValidatePaths(adminPath, guestPath) != nil {
+		t.Errorf("guestPath = %q, want a generated path ending with /guest", guestPath)
+	}
+}
+
+func TestEncodeQRCodePNG(t *testing.T) {
+	data, err := EncodeQRCodePNG("https://example.com/abc/guest", MinQRCodeSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := img.Bounds(); b.Dx() != MinQRCodeSize || b.Dy() != MinQRCodeSize {
+		t.Errorf("QR code is %dx%d, want %dx%d", b.Dx(), b.Dy(), MinQRCodeSize, MinQRCodeSize)
+	}
+}
@@ -0,0 +1,325 @@
+package infra
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+)
+
+// 質問パックとして読み込める拡張子
+var QuestionPackExts = []string{".csv", ".tsv", ".json", ".yaml", ".yml"}
+
+// JSON/YAMLの質問パックの１要素
+// question_idは省略可能で、省略した場合は読み込み後に空いている番号を振る
+type questionPackEntry struct {
+	QuestionID    uint     `json:"question_id" yaml:"question_id"`
+	QuestionText  string   `json:"question_text" yaml:"question_text"`
+	QuizText      string   `json:"quiz_text" yaml:"quiz_text"`
+	SampleAnswers []string `json:"sample_answers" yaml:"sample_answers"`
+	IsOptional    bool     `json:"is_optional" yaml:"is_optional"`
+	line          int
+}
+
+// ディレクトリが指定された場合は、対応している拡張子のファイルをファイル名順に全て読み込む
+// エラーは「ファイル名:行番号: 内容」の形式で全てまとめて返す
+func LoadQuestionPacks(path string) ([]model.ProfileQuestion, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0)
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		// ReadDirはファイル名順で返ってくる
+		for _, entry := range entries {
+			if entry.IsDir() || !slices.Contains(QuestionPackExts, strings.ToLower(filepath.Ext(entry.Name()))) {
+				continue
+			}
+			files = append(files, filepath.Join(path, entry.Name()))
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("%s: no question pack found (supported: %s)", path, strings.Join(QuestionPackExts, ", "))
+		}
+	} else {
+		if !slices.Contains(QuestionPackExts, strings.ToLower(filepath.Ext(path))) {
+			return nil, fmt.Errorf("%s: unsupported question pack format (supported: %s)", path, strings.Join(QuestionPackExts, ", "))
+		}
+		files = append(files, path)
+	}
+
+	type located struct {
+		file  string
+		entry questionPackEntry
+	}
+	all := make([]located, 0)
+	errs := make([]error, 0)
+	for _, file := range files {
+		entries, err := readQuestionPack(file)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", file, err))
+			continue
+		}
+		if len(entries) == 0 {
+			errs = append(errs, fmt.Errorf("%s: no questions in pack", file))
+			continue
+		}
+		for _, entry := range entries {
+			all = append(all, located{file: file, entry: entry})
+		}
+	}
+
+	usedIDs := make(map[uint]string, len(all))
+	for _, l := range all {
+		if l.entry.QuestionID == 0 {
+			continue
+		}
+		where := fmt.Sprintf("%s:%d", l.file, l.entry.line)
+		if prev, ok := usedIDs[l.entry.QuestionID]; ok {
+			errs = append(errs, fmt.Errorf("%s: question_id %d is already used at %s", where, l.entry.QuestionID, prev))
+			continue
+		}
+		usedIDs[l.entry.QuestionID] = where
+	}
+
+	questions := make([]model.ProfileQuestion, 0, len(all))
+	var nextID uint = 1
+	for _, l := range all {
+		qid := l.entry.QuestionID
+		if qid == 0 {
+			for ; usedIDs[nextID] != ""; nextID++ {
+			}
+			qid = nextID
+			usedIDs[qid] = "auto"
+		}
+		sampleAnswers := make([]string, 0, len(l.entry.SampleAnswers))
+		for _, answer := range l.entry.SampleAnswers {
+			if strings.Contains(answer, repository.SampleAnswerSeparator) {
+				errs = append(errs, fmt.Errorf("%s:%d: sample answer %q must not contain %q", l.file, l.entry.line, answer, repository.SampleAnswerSeparator))
+				continue
+			}
+			if answer = strings.TrimSpace(answer); answer != "" {
+				sampleAnswers = append(sampleAnswers, answer)
+			}
+		}
+		question, err := model.NewProfileQuestion(qid, strings.TrimSpace(l.entry.QuestionText), strings.TrimSpace(l.entry.QuizText), sampleAnswers, l.entry.IsOptional)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %w", l.file, l.entry.line, err))
+			continue
+		}
+		questions = append(questions, *question)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return questions, nil
+}
+
+func readQuestionPack(file string) ([]questionPackEntry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".csv", ".tsv":
+		return readXSVQuestionPack(bytes.NewReader(data), xsvDelimiter(file))
+	case ".json":
+		return readJSONQuestionPack(data)
+	case ".yaml", ".yml":
+		return readYAMLQuestionPack(data)
+	default:
+		return nil, fmt.Errorf("unsupported question pack format %q", ext)
+	}
+}
+
+// CSV/TSVのカラムは埋め込みのProfileQuestion.csvと同じ、sort_orderは無視してファイル内の順番を使う
+func readXSVQuestionPack(r io.Reader, delimiter rune) ([]questionPackEntry, error) {
+	header, body, lines, err := readXSVFile(r, delimiter)
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+	for _, required := range []string{"question_text", "quiz_text"} {
+		if !slices.Contains(header, required) {
+			return nil, fmt.Errorf("line 1: missing %s column", required)
+		}
+	}
+	field := func(row []string, col string) string {
+		if idx := slices.Index(header, col); idx >= 0 && idx < len(row) {
+			return row[idx]
+		}
+		return ""
+	}
+	entries := make([]questionPackEntry, 0, len(body))
+	errs := make([]error, 0)
+	for i, row := range body {
+		entry := questionPackEntry{
+			QuestionText: field(row, "question_text"),
+			QuizText:     field(row, "quiz_text"),
+			line:         lines[i],
+		}
+		if qid := strings.TrimSpace(field(row, "question_id")); qid != "" {
+			n, err := strconv.ParseUint(qid, 10, 0)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: question_id: invalid integer %q", lines[i], qid))
+				continue
+			}
+			entry.QuestionID = uint(n)
+		}
+		if optional := strings.TrimSpace(field(row, "is_optional")); optional != "" {
+			b, err := strconv.ParseBool(optional)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("line %d: is_optional: invalid boolean %q", lines[i], optional))
+				continue
+			}
+			entry.IsOptional = b
+		}
+		entry.SampleAnswers = strings.Split(field(row, "sample_answer"), repository.SampleAnswerSeparator)
+		entries = append(entries, entry)
+	}
+	if len(errs) > 0 {
+		// 呼び出し元でファイル名を付けるので行番号のみ
+		return nil, errors.Join(errs...)
+	}
+	return entries, nil
+}
+
+func lineOfOffset(data []byte, offset int64) int {
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func readJSONQuestionPack(data []byte) ([]questionPackEntry, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, errors.New("empty file")
+	}
+	offset := int64(len(data) - len(bytes.TrimLeft(data, " \t\r\n")))
+	// 配列そのものか、questionsキーに配列を持つオブジェクトのどちらでも良い
+	if trimmed[0] == '{' {
+		var doc struct {
+			Questions json.RawMessage `json:"questions"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, jsonErrorWithLine(data, 0, err)
+		}
+		if doc.Questions == nil {
+			return nil, errors.New("line 1: missing questions array")
+		}
+		// questions配列の位置を行番号計算のために特定する
+		offset = int64(bytes.Index(data, doc.Questions))
+	}
+	dec := json.NewDecoder(bytes.NewReader(data[offset:]))
+	if tok, err := dec.Token(); err != nil {
+		return nil, jsonErrorWithLine(data, offset, err)
+	} else if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("line %d: expected an array of questions", lineOfOffset(data, offset))
+	}
+	entries := make([]questionPackEntry, 0)
+	errs := make([]error, 0)
+	for dec.More() {
+		// InputOffsetは直前のトークンの直後を指すので、区切りと空白を読み飛ばした位置を要素の開始とする
+		start := offset + dec.InputOffset()
+		start += int64(len(data[start:]) - len(bytes.TrimLeft(data[start:], ", \t\r\n")))
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineOfOffset(data, start), err)
+		}
+		entry := questionPackEntry{}
+		strict := json.NewDecoder(bytes.NewReader(raw))
+		strict.DisallowUnknownFields()
+		if err := strict.Decode(&entry); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", lineOfOffset(data, start), err))
+			continue
+		}
+		entry.line = lineOfOffset(data, start)
+		entries = append(entries, entry)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return entries, nil
+}
+
+// エラーのOffsetはデコーダに渡した位置からの相対値なので、baseで元データ上の位置に直す
+func jsonErrorWithLine(data []byte, base int64, err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return fmt.Errorf("line %d: %w", lineOfOffset(data, base+syntaxErr.Offset), err)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Errorf("line %d: %w", lineOfOffset(data, base+typeErr.Offset), err)
+	}
+	return err
+}
+
+func readYAMLQuestionPack(data []byte) ([]questionPackEntry, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		// yaml.v3のエラーは既に「yaml: line N: ...」の形式になっている
+		return nil, err
+	}
+	if len(root.Content) == 0 {
+		return nil, errors.New("empty file")
+	}
+	list := root.Content[0]
+	// 配列そのものか、questionsキーに配列を持つマッピングのどちらでも良い
+	if list.Kind == yaml.MappingNode {
+		var questions *yaml.Node
+		for i := 0; i+1 < len(list.Content); i += 2 {
+			if list.Content[i].Value == "questions" {
+				questions = list.Content[i+1]
+			}
+		}
+		if questions == nil {
+			return nil, fmt.Errorf("line %d: missing questions list", list.Line)
+		}
+		list = questions
+	}
+	if list.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("line %d: expected a list of questions", list.Line)
+	}
+	entries := make([]questionPackEntry, 0, len(list.Content))
+	errs := make([]error, 0)
+	for _, node := range list.Content {
+		entry := questionPackEntry{}
+		if node.Kind != yaml.MappingNode {
+			errs = append(errs, fmt.Errorf("line %d: expected a question mapping", node.Line))
+			continue
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			switch key {
+			case "question_id", "question_text", "quiz_text", "sample_answers", "is_optional":
+			default:
+				errs = append(errs, fmt.Errorf("line %d: unknown field %q", node.Content[i].Line, key))
+			}
+		}
+		if err := node.Decode(&entry); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", node.Line, err))
+			continue
+		}
+		entry.line = node.Line
+		entries = append(entries, entry)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return entries, nil
+}
@@ -23,6 +23,9 @@ import (
 
 const RandomPathLength int = 6
 
+// 署名付きURLで画像を返すパス、guestのパスの後ろに付ける
+const SignedImagePath string = "/images/"
+
 func redirectHandlerFunc(path string) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, path, http.StatusSeeOther)
@@ -39,9 +42,34 @@ func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	rt.router.ServeHTTP(w, r)
 }
 
+// 空のパスはランダムな文字列を含むものを生成する
+func ResolvePaths(adminPath string, guestPath string) (string, string, error) {
+	if adminPath != "" && guestPath != "" {
+		return adminPath, guestPath, nil
+	}
+	// adminとguest２つ分のランダム文字列をまとめて生成して後で分割
+	randStr, err := util.CreateRandStr(RandomPathLength * 2)
+	if err != nil {
+		return "", "", err
+	}
+	runes := []rune(randStr)
+	if adminPath == "" {
+		adminPath = fmt.Sprintf("/%s/admin", string(runes[0:len(runes)/2]))
+	}
+	if guestPath == "" {
+		guestPath = fmt.Sprintf("/%s/guest", string(runes[len(runes)/2:]))
+	}
+	return adminPath, guestPath, nil
+}
+
 func NewRouter(
+	adminPath string,
+	guestPath string,
 	fileHandler *filecontroller.StaticFileHandler,
+	caCertificateHandler *filecontroller.CACertificateHandler,
 	imageHndler *restcontroller.ImageHandler,
+	qrCodeHandler *restcontroller.QRCodeHandler,
+	exportHandler *restcontroller.ExportHandler,
 	entryServiceHandler *rpccontroller.EntryServiceHandler,
 	lobbyServiceHandler *rpccontroller.LobbyServiceHandler,
 	questServiceHandler *rpccontroller.QuestServiceHandler,
@@ -51,15 +79,6 @@ func NewRouter(
 	rateLimitMiddleware *middleware.RateLimitMiddleware,
 	corsMiddleware *middleware.CorsMiddleware,
 ) *Router {
-	// adminとguest２つ分のランダム文字列をまとめて生成して後で分割
-	randStr, err := util.CreateRandStr(RandomPathLength * 2)
-	if err != nil {
-		panic(err)
-	}
-	runes := []rune(randStr)
-	adminPath := fmt.Sprintf("/%s/admin", string(runes[0:len(runes)/2]))
-	guestPath := fmt.Sprintf("/%s/guest", string(runes[len(runes)/2:]))
-
 	router := routegroup.New(http.NewServeMux())
 	adminGroup := router.Mount(adminPath)
 	guestGroup := router.Mount(guestPath)
@@ -69,14 +88,28 @@ func NewRouter(
 	guestGroup.HandleFunc("/", redirectHandlerFunc("static"))
 	adminGroup.Handle("/static/", http.StripPrefix(adminPath+"/static", http.HandlerFunc(fileHandler.Handle)))
 	guestGroup.Handle("/static/", http.StripPrefix(guestPath+"/static", http.HandlerFunc(fileHandler.Handle)))
+	// 自己署名の証明書を使う場合のみ、端末に入れてもらうCA証明書を配る
+	// 証明書を信頼させる前に取得するものなので認証は掛けない
+	if caCertificateHandler != nil {
+		guestGroup.HandleFunc("GET /"+filecontroller.CACertificateFileName, caCertificateHandler.Handle)
+	}
+	// 署名で認証するので認証ヘッダは要らない
+	guestGroup.HandleFunc("GET "+SignedImagePath+"{"+restcontroller.ImageIDPathValue+"}", imageHndler.HandleSigned)
 	adminRestGroup := adminGroup.Mount("/rest")
 	guestRestGroup := guestGroup.Mount("/rest")
 	adminRestGroup.Use(authorizeMiddleware.Handle)
 	guestRestGroup.Use(authorizeMiddleware.Handle)
 	adminRestGroup.Handle("GET /images", http.StripPrefix(adminPath+"/rest/images", http.HandlerFunc(imageHndler.Handle)))
 	guestRestGroup.Handle("GET /images", http.StripPrefix(guestPath+"/rest/images", http.HandlerFunc(imageHndler.Handle)))
-	// imageをuploadする必要があるのはゲストだけ
+	// /images/{画像ID}で指定した画像、/images/で自分の画像
+	adminRestGroup.HandleFunc("GET /images/", http.StripPrefix(adminPath+"/rest/images", http.HandlerFunc(imageHndler.Handle)).ServeHTTP)
+	guestRestGroup.HandleFunc("GET /images/", http.StripPrefix(guestPath+"/rest/images", http.HandlerFunc(imageHndler.Handle)).ServeHTTP)
+	adminRestGroup.HandleFunc("GET /qrcode", qrCodeHandler.Handle)
+	// ZIPが大きくなるのでRPCではなく、画像と同じようにそのまま流す
+	adminRestGroup.With(adminCheckMiddleware.Handle).HandleFunc("GET /export", exportHandler.Handle)
+	// imageをupload、deleteする必要があるのはゲストだけ
 	guestRestGroup.Handle("POST /images", http.StripPrefix(guestPath+"/rest/images", http.HandlerFunc(imageHndler.Handle)))
+	guestRestGroup.Handle("DELETE /images", http.StripPrefix(guestPath+"/rest/images", http.HandlerFunc(imageHndler.Handle)))
 
 	guestRPCGroup := guestGroup.Mount("/rpc")
 	entryPath, entryHandler := entryv1connect.NewEntryServiceHandler(
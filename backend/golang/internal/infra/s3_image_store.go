@@ -0,0 +1,334 @@
+package infra
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+const (
+	s3Service         string = "s3"
+	s3SigningAlgo     string = "AWS4-HMAC-SHA256"
+	s3AmzDateFormat   string = "20060102T150405Z"
+	s3ScopeDateFormat string = "20060102"
+	s3RequestTimeout         = 30 * time.Second
+)
+
+// S3互換のオブジェクトストレージ（AWS S3、MinIO、Cloudflare R2など）に画像を置く
+// SDKは使わず、必要な操作だけ署名バージョン4で署名したリクエストを送る
+type S3ImageStore struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	pathStyle       bool
+	client          *http.Client
+	now             func() time.Time
+	// 今回の起動の間に置いた画像のキー、終了時に消す対象
+	written map[string]struct{}
+	mu      sync.Mutex
+}
+
+type s3ErrorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type s3ListResponse struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// RFC 3986の非予約文字以外を全てエンコードする、keepSlashの場合は/を残す
+func s3URIEncode(s string, keepSlash bool) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && keepSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// objectKeyが空の場合はバケットへのリクエストになる
+func (sis *S3ImageStore) newRequest(method string, objectKey string, query url.Values, body []byte) (*http.Request, error) {
+	host := sis.endpoint.Host
+	basePath := strings.TrimSuffix(sis.endpoint.Path, "/")
+	if sis.pathStyle {
+		basePath += "/" + sis.bucket
+	} else {
+		host = sis.bucket + "." + host
+	}
+	objectPath := basePath
+	if objectKey != "" {
+		objectPath += "/" + objectKey
+	}
+	if objectPath == "" {
+		objectPath = "/"
+	}
+	canonicalURI := s3URIEncode(objectPath, true)
+
+	// クエリはキーの順に並べて、署名と送信で同じ文字列を使う
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range query[k] {
+			pairs = append(pairs, s3URIEncode(k, false)+"="+s3URIEncode(v, false))
+		}
+	}
+	canonicalQuery := strings.Join(pairs, "&")
+
+	rawURL := sis.endpoint.Scheme + "://" + host + canonicalURI
+	if canonicalQuery != "" {
+		rawURL += "?" + canonicalQuery
+	}
+	req, err := http.NewRequest(method, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+
+	now := sis.now().UTC()
+	amzDate := now.Format(s3AmzDateFormat)
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalURI,
+		canonicalQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := now.Format(s3ScopeDateFormat) + "/" + sis.region + "/" + s3Service + "/aws4_request"
+	stringToSign := s3SigningAlgo + "\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+	signingKey := hmacSHA256([]byte("AWS4"+sis.secretAccessKey), now.Format(s3ScopeDateFormat))
+	signingKey = hmacSHA256(signingKey, sis.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgo, sis.accessKeyID, scope, signedHeaders, signature))
+	return req, nil
+}
+
+// 失敗した場合はレスポンスのXMLからエラーの内容を取り出す
+func s3ResponseError(method string, objectKey string, res *http.Response) error {
+	data, _ := io.ReadAll(io.LimitReader(res.Body, 64<<10))
+	var s3Err s3ErrorResponse
+	if xml.Unmarshal(data, &s3Err) == nil && s3Err.Code != "" {
+		return fmt.Errorf("S3 %s %q failed with %d %s: %s", method, objectKey, res.StatusCode, s3Err.Code, s3Err.Message)
+	}
+	return fmt.Errorf("S3 %s %q failed with %d", method, objectKey, res.StatusCode)
+}
+
+func (sis *S3ImageStore) do(method string, objectKey string, query url.Values, body []byte) (*http.Response, error) {
+	req, err := sis.newRequest(method, objectKey, query, body)
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodPut {
+		req.Header.Set("Content-Type", "image/jpeg")
+	}
+	return sis.client.Do(req)
+}
+
+func (sis *S3ImageStore) Put(key string, data []byte) error {
+	if err := validateImageKey(key); err != nil {
+		return err
+	}
+	res, err := sis.do(http.MethodPut, sis.prefix+key, nil, data)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return s3ResponseError(http.MethodPut, key, res)
+	}
+	sis.mu.Lock()
+	defer sis.mu.Unlock()
+	sis.written[key] = struct{}{}
+	return nil
+}
+
+// 本文は読み込まずに返すので、呼び出し側がそのままレスポンスに流せる
+func (sis *S3ImageStore) Get(key string) (*model.StoredImage, error) {
+	if err := validateImageKey(key); err != nil {
+		return nil, err
+	}
+	res, err := sis.do(http.MethodGet, sis.prefix+key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, &fs.PathError{Op: "get", Path: key, Err: fs.ErrNotExist}
+	default:
+		defer res.Body.Close()
+		return nil, s3ResponseError(http.MethodGet, key, res)
+	}
+	modTime, err := http.ParseTime(res.Header.Get("Last-Modified"))
+	if err != nil {
+		modTime = time.Time{}
+	}
+	return model.NewStoredImage(key, res.ContentLength, modTime, res.Body), nil
+}
+
+func (sis *S3ImageStore) Delete(key string) error {
+	if err := validateImageKey(key); err != nil {
+		return err
+	}
+	res, err := sis.do(http.MethodDelete, sis.prefix+key, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// S3は無いキーの削除も204を返すが、404を返す互換ストレージもある
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNotFound {
+		return s3ResponseError(http.MethodDelete, key, res)
+	}
+	sis.mu.Lock()
+	defer sis.mu.Unlock()
+	delete(sis.written, key)
+	return nil
+}
+
+// 今回の起動の間に置いた画像だけを消す、同じprefixを使う他のサーバや以前の画像には触れない
+func (sis *S3ImageStore) DeleteWritten() error {
+	sis.mu.Lock()
+	keys := slices.Sorted(maps.Keys(sis.written))
+	sis.mu.Unlock()
+	errs := make([]error, 0)
+	for _, key := range keys {
+		if err := sis.Delete(key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ListObjectsV2で、1000件を超える場合は続きを辿って全て返す
+func (sis *S3ImageStore) List(prefix string) ([]string, error) {
+	keys := make([]string, 0)
+	token := ""
+	for {
+		query := url.Values{
+			"list-type": {"2"},
+			"prefix":    {sis.prefix + prefix},
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		res, err := sis.do(http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			err = s3ResponseError(http.MethodGet, sis.prefix+prefix, res)
+			res.Body.Close()
+			return nil, err
+		}
+		var list s3ListResponse
+		err = xml.NewDecoder(res.Body).Decode(&list)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse S3 object list: %w", err)
+		}
+		for _, content := range list.Contents {
+			keys = append(keys, strings.TrimPrefix(content.Key, sis.prefix))
+		}
+		if !list.IsTruncated || list.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = list.NextContinuationToken
+	}
+}
+
+// 起動時にバケットに接続できるか確認して、設定の誤りにゲーム中に気付くことが無いようにする
+func (sis *S3ImageStore) CheckBucket() error {
+	res, err := sis.do(http.MethodHead, "", nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to connect to S3 bucket %q: %w", sis.bucket, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to access S3 bucket %q: %d %s", sis.bucket, res.StatusCode, http.StatusText(res.StatusCode))
+	}
+	return nil
+}
+
+func NewS3ImageStore(config S3Config) (*S3ImageStore, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("images.s3.endpoint: %w", err)
+	}
+	// 空のprefixではバケット全体が対象になってしまうので受け付けない
+	prefix := strings.Trim(config.Prefix, "/")
+	if prefix == "" {
+		return nil, errors.New("images.s3.prefix is required")
+	}
+	prefix += "/"
+	return &S3ImageStore{
+		endpoint:        endpoint,
+		region:          config.Region,
+		bucket:          config.Bucket,
+		prefix:          prefix,
+		accessKeyID:     config.AccessKeyID,
+		secretAccessKey: config.SecretAccessKey,
+		pathStyle:       config.PathStyle,
+		client:          &http.Client{Timeout: s3RequestTimeout},
+		now:             time.Now,
+		written:         make(map[string]struct{}),
+		mu:              sync.Mutex{},
+	}, nil
+}
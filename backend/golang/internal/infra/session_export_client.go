@@ -0,0 +1,119 @@
+package infra
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/middleware"
+	adminv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1/adminv1connect"
+	entryv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/entry/v1"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/entry/v1/entryv1connect"
+)
+
+// 起動中のサーバから、管理者としてセッションのZIPを受け取るクライアント
+type SessionExportClient struct {
+	entry entryv1connect.EntryServiceClient
+	admin adminv1connect.AdminServiceClient
+}
+
+// caFileを指定した場合は、自己署名の証明書のサーバに繋げるようにそのCAも信頼する
+func NewExportHTTPClient(caFile string) (*http.Client, error) {
+	if caFile == "" {
+		return http.DefaultClient, nil
+	}
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", caFile)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	return &http.Client{Transport: transport}, nil
+}
+
+// 書き込み中のファイルを最終的な名前で残さないように、受け取り終わってから名前を変える
+// destが既存のディレクトリの場合は、サーバが付けた名前でその中に書き出す
+func (sec *SessionExportClient) Download(ctx context.Context, reconnectKey string, dest string) (string, error) {
+	reconnected, err := sec.entry.Reconnect(ctx, connect.NewRequest(&entryv1.ReconnectRequest{ReconnectKey: reconnectKey}))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign in as administrator: %w", err)
+	}
+	req := connect.NewRequest(&emptypb.Empty{})
+	req.Header().Set(middleware.HeaderKey, "Bearer "+reconnected.Msg.GetAccessToken())
+	stream, err := sec.admin.ExportSession(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return "", err
+		}
+		return "", errors.New("The server sent no archive")
+	}
+	first := stream.Msg()
+	path := dest
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		fileName := filepath.Base(first.GetFileName())
+		if fileName == "." || fileName == string(filepath.Separator) || strings.HasPrefix(fileName, ".") {
+			return "", fmt.Errorf("invalid file name from the server: %q", first.GetFileName())
+		}
+		path = filepath.Join(dest, fileName)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".cursed-frame-export-*")
+	if err != nil {
+		return path, err
+	}
+	if err := writeChunks(f, first.GetChunk(), stream); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return path, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return path, err
+	}
+	return path, os.Rename(f.Name(), path)
+}
+
+// 途中でエラーになった場合は、受け取った分を捨ててもらうためにエラーを返す
+func writeChunks(w io.Writer, first []byte, stream *connect.ServerStreamForClient[adminv1.ExportSessionResponse]) error {
+	if _, err := w.Write(first); err != nil {
+		return err
+	}
+	for stream.Receive() {
+		if _, err := w.Write(stream.Msg().GetChunk()); err != nil {
+			return err
+		}
+	}
+	return stream.Err()
+}
+
+func NewSessionExportClient(client *http.Client, adminURL string) *SessionExportClient {
+	// 管理者用のパスの配下にもentryServiceがある
+	baseURL := strings.TrimSuffix(adminURL, "/") + "/rpc"
+	return &SessionExportClient{
+		entry: entryv1connect.NewEntryServiceClient(client, baseURL),
+		admin: adminv1connect.NewAdminServiceClient(client, baseURL),
+	}
+}
@@ -0,0 +1,138 @@
+package infra
+
+import (
+	"archive/zip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/patrickmn/go-cache"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	rpccontroller "github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/rpc"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	adminv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/admin/v1/adminv1connect"
+	entryv1 "github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/entry/v1"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/gen/entry/v1/entryv1connect"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
+)
+
+const (
+	testAdminPath    string = "/test/admin"
+	testReconnectKey string = "0123456789abcdef"
+	testAccessToken  string = "admin-token"
+)
+
+// 再接続キーが合えば決まったトークンを返す
+type fakeEntryService struct {
+	entryv1connect.UnimplementedEntryServiceHandler
+}
+
+func (fakeEntryService) Reconnect(ctx context.Context, r *connect.Request[entryv1.ReconnectRequest]) (*connect.Response[entryv1.ReconnectResponse], error) {
+	if r.Msg.GetReconnectKey() != testReconnectKey {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("Invalid reconnect key"))
+	}
+	return connect.NewResponse(&entryv1.ReconnectResponse{AccessToken: testAccessToken}), nil
+}
+
+// 途中まで送ってから失敗する
+type failingAdminService struct {
+	adminv1connect.UnimplementedAdminServiceHandler
+}
+
+func (failingAdminService) ExportSession(ctx context.Context, r *connect.Request[emptypb.Empty], stream *connect.ServerStream[adminv1.ExportSessionResponse]) error {
+	if err := stream.Send(&adminv1.ExportSessionResponse{FileName: "broken.zip", Chunk: []byte("PK")}); err != nil {
+		return err
+	}
+	return connect.NewError(connect.CodeInternal, errors.New("Failed to read an image"))
+}
+
+// ルータと同じく管理者用のパスの配下の/rpcでentryとadminのサービスを受ける
+func startExportServer(t *testing.T, admin adminv1connect.AdminServiceHandler) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	entryPath, entryHandler := entryv1connect.NewEntryServiceHandler(fakeEntryService{})
+	mux.Handle(testAdminPath+"/rpc"+entryPath, http.StripPrefix(testAdminPath+"/rpc", entryHandler))
+	adminPath, adminHandler := adminv1connect.NewAdminServiceHandler(admin)
+	mux.Handle(testAdminPath+"/rpc"+adminPath, http.StripPrefix(testAdminPath+"/rpc", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		adminHandler.ServeHTTP(w, r)
+	})))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server.URL + testAdminPath
+}
+
+func newTestExportSessionUsecase(t *testing.T) *usecase.ExportSessionUsecase {
+	t.Helper()
+	db, err := NewSQLiteDB(t.TempDir(), t.TempDir(), fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+	return usecase.NewExportSessionUsecase(
+		core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules()),
+		repository.NewUserRepository(cache.New(time.Minute, time.Minute), db),
+		repository.NewUserImageRepository(db),
+		repository.NewUserProfileRepository(db),
+		repository.NewProfileQuestionRepository(db),
+		NewMemoryImageStore(),
+	)
+}
+
+// ディレクトリを指定するとサーバが付けた名前で書き出し、中身はZIPとして読める
+func TestSessionExportClientDownload(t *testing.T) {
+	handler := rpccontroller.NewAdminServiceHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestExportSessionUsecase(t), nil, nil, nil)
+	client := NewSessionExportClient(http.DefaultClient, startExportServer(t, handler))
+	dir := t.TempDir()
+
+	path, err := client.Download(context.Background(), testReconnectKey, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filepath.Dir(path) != dir || !strings.HasPrefix(filepath.Base(path), usecase.SessionArchivePrefix) {
+		t.Errorf("Download() path = %s, want a session archive in %s", path, dir)
+	}
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		t.Fatalf("downloaded file is not a zip: %v", err)
+	}
+	defer zr.Close()
+	if _, err := zr.Open("session.json"); err != nil {
+		t.Errorf("session.json is missing: %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("%s has %d files, want only the archive", dir, len(entries))
+	}
+
+	if _, err := client.Download(context.Background(), "wrong-key-wrong-key", dir); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Download() with a wrong key error = %v, want unauthenticated", err)
+	}
+}
+
+// 途中で失敗した場合は、壊れたZIPを残さない
+func TestSessionExportClientDownloadFailure(t *testing.T) {
+	client := NewSessionExportClient(http.DefaultClient, startExportServer(t, failingAdminService{}))
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "session.zip")
+
+	if _, err := client.Download(context.Background(), testReconnectKey, dest); connect.CodeOf(err) != connect.CodeInternal {
+		t.Errorf("Download() error = %v, want the server's error", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("%s has %d files after a failure, want none", dir, len(entries))
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
+	"path"
 	"slices"
 	"strconv"
 	"strings"
@@ -21,16 +23,34 @@ import (
 const SQLitePlaceholder string = "?"
 
 const (
-	UserTable     string = "User"
-	ImageTable    string = "UserImage"
-	ProfileTable  string = "UserProfile"
-	QuestionTable string = "ProfileQuestion"
+	UserTable       string = "User"
+	ImageTable      string = "UserImage"
+	ProfileTable    string = "UserProfile"
+	QuestionTable   string = "ProfileQuestion"
+	AssignmentTable string = "QuestionAssignment"
+	UserPlayerTable string = "UserPlayer"
+	PlayerTable     string = "Player"
+	GameTable       string = "Game"
+	GameTeamTable   string = "GameTeam"
+	GamePlayerTable string = "GamePlayer"
+	GameQuizTable   string = "GameQuiz"
+	TeamAnswerTable string = "GameTeamAnswer"
+	AnswerTable     string = "GameAnswer"
 )
 
 type column struct {
 	Name       string
 	Type       string
 	Constraint string
+	// 後から追加したカラムは、既存の行がNULLにならないように既定値を指定する
+	Default string
+}
+
+func (c column) defaultClause() string {
+	if c.Default == "" {
+		return ""
+	}
+	return "NOT NULL DEFAULT " + c.Default
 }
 
 func (c column) Definition() string {
@@ -41,6 +61,9 @@ func (c column) Definition() string {
 	if c.Constraint != "" {
 		str = str + " " + c.Constraint
 	}
+	if d := c.defaultClause(); d != "" {
+		str = str + " " + d
+	}
 	return str
 }
 
@@ -87,20 +110,107 @@ var columnMap map[string]columns = map[string]columns{
 		{Name: "user_id", Type: "TEXT"},
 		{Name: "profile_id", Type: "INTEGER"},
 		{Name: "answer", Type: "TEXT"},
+		{Name: "is_flagged", Type: "BOOLEAN"},
+	}},
+	AssignmentTable: columns{Columns: []column{
+		{Name: "user_id", Type: "TEXT"},
+		{Name: "question_id", Type: "INTEGER"},
+		{Name: "sort_order", Type: "INTEGER"},
+		{Name: "is_skipped", Type: "BOOLEAN"},
 	}},
 	QuestionTable: columns{Columns: []column{
 		{Name: "question_id", Type: "INTEGER", Constraint: "PRIMARY KEY"},
 		{Name: "question_text", Type: "TEXT"},
 		{Name: "quiz_text", Type: "TEXT"},
 		{Name: "sample_answer", Type: "TEXT"},
+		{Name: "sort_order", Type: "INTEGER", Default: "0"},
+		{Name: "is_optional", Type: "BOOLEAN", Default: "FALSE"},
+	}},
+	UserPlayerTable: columns{Columns: []column{
+		{Name: "user_id", Type: "TEXT", Constraint: "PRIMARY KEY"},
+		{Name: "player_id", Type: "TEXT"},
+	}},
+	// 鍵はそのまま保存せずにハッシュ値で照合する
+	PlayerTable: columns{Columns: []column{
+		{Name: "player_id", Type: "TEXT", Constraint: "PRIMARY KEY"},
+		{Name: "key_hash", Type: "TEXT", Constraint: "UNIQUE"},
+		{Name: "name", Type: "TEXT"},
+		{Name: "last_seen_at", Type: "INTEGER"},
+	}},
+	// 時刻はUNIXミリ秒、時間はミリ秒で保存する
+	GameTable: columns{Columns: []column{
+		{Name: "game_id", Type: "TEXT", Constraint: "PRIMARY KEY"},
+		{Name: "started_at", Type: "INTEGER"},
+		{Name: "ended_at", Type: "INTEGER"},
+		{Name: "rules", Type: "TEXT"},
+		{Name: "quiz_count", Type: "INTEGER"},
+	}},
+	GameTeamTable: columns{Columns: []column{
+		{Name: "game_id", Type: "TEXT"},
+		{Name: "team_id", Type: "INTEGER"},
+		{Name: "correct_count", Type: "INTEGER"},
+		{Name: "points", Type: "INTEGER"},
+		{Name: "team_order", Type: "INTEGER"},
+	}},
+	GamePlayerTable: columns{Columns: []column{
+		{Name: "game_id", Type: "TEXT"},
+		{Name: "player_id", Type: "TEXT"},
+		{Name: "name", Type: "TEXT"},
+		{Name: "team_id", Type: "INTEGER"},
+		{Name: "answered_count", Type: "INTEGER"},
+		{Name: "correct_count", Type: "INTEGER"},
+		{Name: "total_response_time", Type: "INTEGER"},
+		{Name: "personal_order", Type: "INTEGER"},
+	}},
+	GameQuizTable: columns{Columns: []column{
+		{Name: "game_id", Type: "TEXT"},
+		{Name: "quiz_number", Type: "INTEGER"},
+		{Name: "target_player_id", Type: "TEXT"},
+		{Name: "target_team_id", Type: "INTEGER"},
+		{Name: "question_id", Type: "INTEGER"},
+		{Name: "question_text", Type: "TEXT"},
+		{Name: "correct_answer", Type: "TEXT"},
+		{Name: "hint", Type: "TEXT"},
+		{Name: "started_at", Type: "INTEGER"},
+		{Name: "countdown_started_at", Type: "INTEGER"},
+		{Name: "hint_taken_at", Type: "INTEGER"},
+		{Name: "checked_at", Type: "INTEGER"},
+	}},
+	TeamAnswerTable: columns{Columns: []column{
+		{Name: "game_id", Type: "TEXT"},
+		{Name: "quiz_number", Type: "INTEGER"},
+		{Name: "team_id", Type: "INTEGER"},
+		{Name: "answer", Type: "TEXT"},
+		{Name: "is_correct", Type: "BOOLEAN"},
+		{Name: "is_double_points", Type: "BOOLEAN"},
+		{Name: "lifelines", Type: "TEXT"},
+	}},
+	AnswerTable: columns{Columns: []column{
+		{Name: "game_id", Type: "TEXT"},
+		{Name: "quiz_number", Type: "INTEGER"},
+		{Name: "player_id", Type: "TEXT"},
+		{Name: "team_id", Type: "INTEGER"},
+		{Name: "answer", Type: "TEXT"},
+		{Name: "is_correct", Type: "BOOLEAN"},
+		{Name: "answered_at", Type: "INTEGER"},
+		{Name: "response_time", Type: "INTEGER"},
 	}},
 }
 
 var migrations map[string]string = map[string]string{
-	UserTable:     fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", UserTable, columnMap[UserTable].toDDL()),
-	ImageTable:    fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", ImageTable, columnMap[ImageTable].toDDL()),
-	ProfileTable:  fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(user_id, profile_id));", ProfileTable, columnMap[ProfileTable].toDDL()),
-	QuestionTable: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", QuestionTable, columnMap[QuestionTable].toDDL()),
+	UserTable:       fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", UserTable, columnMap[UserTable].toDDL()),
+	ImageTable:      fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", ImageTable, columnMap[ImageTable].toDDL()),
+	ProfileTable:    fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(user_id, profile_id));", ProfileTable, columnMap[ProfileTable].toDDL()),
+	AssignmentTable: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(user_id, question_id));", AssignmentTable, columnMap[AssignmentTable].toDDL()),
+	QuestionTable:   fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", QuestionTable, columnMap[QuestionTable].toDDL()),
+	UserPlayerTable: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", UserPlayerTable, columnMap[UserPlayerTable].toDDL()),
+	PlayerTable:     fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", PlayerTable, columnMap[PlayerTable].toDDL()),
+	GameTable:       fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s);", GameTable, columnMap[GameTable].toDDL()),
+	GameTeamTable:   fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(game_id, team_id));", GameTeamTable, columnMap[GameTeamTable].toDDL()),
+	GamePlayerTable: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(game_id, player_id));", GamePlayerTable, columnMap[GamePlayerTable].toDDL()),
+	GameQuizTable:   fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(game_id, quiz_number));", GameQuizTable, columnMap[GameQuizTable].toDDL()),
+	TeamAnswerTable: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(game_id, quiz_number, team_id));", TeamAnswerTable, columnMap[TeamAnswerTable].toDDL()),
+	AnswerTable:     fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(%s, PRIMARY KEY(game_id, quiz_number, player_id));", AnswerTable, columnMap[AnswerTable].toDDL()),
 }
 
 var databases map[string][]string = map[string][]string{
@@ -108,13 +218,29 @@ var databases map[string][]string = map[string][]string{
 	"UserAttribute": []string{
 		ImageTable,
 		ProfileTable,
+		AssignmentTable,
+		UserPlayerTable,
 	},
 	"Master": []string{QuestionTable},
+	"History": []string{
+		PlayerTable,
+		GameTable,
+		GameTeamTable,
+		GamePlayerTable,
+		GameQuizTable,
+		TeamAnswerTable,
+		AnswerTable,
+	},
 }
 
+// 再起動後もデータを残しておきたいDB
+var persistentDatabases []string = []string{"Master", "History"}
+
 var doBatchTables []string = []string{
 	UserTable,
 	ProfileTable,
+	TeamAnswerTable,
+	AnswerTable,
 }
 
 type Mode string
@@ -162,13 +288,10 @@ func (db *SQLiteDB) closeQueue(dbName string) {
 }
 
 func (db *SQLiteDB) Command(dbName string, req repository.WriteRequest) {
-	if dbName == "Master" {
-		req.ResultCh <- errors.New("Master database is read only")
-		return
-	}
 	q, ok := db.writeQueues[dbName]
 	if !ok {
 		req.ResultCh <- errors.New(dbName + " is not exist")
+		return
 	}
 	select {
 	case q <- req:
@@ -220,48 +343,88 @@ func (db *SQLiteDB) QueryIn(dbName string, sql string, params ...any) (*sqlx.Row
 	return db.connections[dbName][Read].Queryx(query, newParams...)
 }
 
-func readXSVFile(file fs.File) (header []string, body [][]string, err error) {
+// 拡張子から区切り文字を決める、対応していない拡張子の場合は0を返す
+func xsvDelimiter(fileName string) rune {
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".csv":
+		return ','
+	case ".tsv":
+		return '\t'
+	default:
+		return 0
+	}
+}
+
+// bodyの各行がファイルの何行目にあたるかをlinesに詰めて返す
+func readXSVFile(file io.Reader, delimiter rune) (header []string, body [][]string, lines []int, err error) {
 	reader := csv.NewReader(file)
-	rows, err := reader.ReadAll()
-	if err != nil {
-		return nil, nil, err
+	reader.Comma = delimiter
+	if delimiter == '\t' {
+		// TSVはダブルクォートでの囲みを前提としないので、値の途中の"をそのまま許容する
+		reader.LazyQuotes = true
+	}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if header == nil {
+			header = row
+			continue
+		}
+		body = append(body, row)
+		lines = append(lines, line)
 	}
-	header = rows[0]
-	body = rows[1:]
-	return header, body, nil
+	if header == nil {
+		return nil, nil, nil, errors.New("header row is missing")
+	}
+	return header, body, lines, nil
+}
+
+type lineError struct {
+	line int
+	err  error
 }
 
-func createValueMap(header []string, body [][]string, columns columns) []map[string]any {
-	values := make([]map[string]any, 0, len(body))
+func createValueMap(header []string, body [][]string, lines []int, columns columns) ([]map[string]any, error) {
+	values := make([]map[string]any, len(body))
 	colNames := columns.ColNames()
 	var wg sync.WaitGroup
 	var mu sync.Mutex
-	for _, row := range body {
+	lineErrs := make([]lineError, 0)
+	for rowIdx, row := range body {
 		// 過剰な最適化説もあるけどやっておく
 		wg.Go(func() {
 			valueMap := make(map[string]any, len(colNames))
+			rowErrs := make([]error, 0)
 			for i, col := range colNames {
 				idx := slices.Index(header, col)
 				switch columns.Columns[i].Type {
 				case "INTEGER":
-					if idx < 0 {
+					if idx < 0 || row[idx] == "" {
 						valueMap[col] = 0
 						continue
 					}
-					n, err := strconv.Atoi(row[idx])
+					n, err := strconv.Atoi(strings.TrimSpace(row[idx]))
 					if err != nil {
-						n = 0
+						rowErrs = append(rowErrs, fmt.Errorf("%s: invalid integer %q", col, row[idx]))
+						continue
 					}
 					valueMap[col] = n
 				case "BOOLEAN":
 					// BOOLEANってSQLiteには無いらしいけど動くし分かりやすいからこのままで
-					if idx < 0 {
+					if idx < 0 || row[idx] == "" {
 						valueMap[col] = false
 						continue
 					}
-					b, err := strconv.ParseBool(row[idx])
+					b, err := strconv.ParseBool(strings.TrimSpace(row[idx]))
 					if err != nil {
-						b = false
+						rowErrs = append(rowErrs, fmt.Errorf("%s: invalid boolean %q", col, row[idx]))
+						continue
 					}
 					valueMap[col] = b
 				case "TEXT":
@@ -276,14 +439,100 @@ func createValueMap(header []string, body [][]string, columns columns) []map[str
 			}
 			mu.Lock()
 			defer mu.Unlock()
-			values = append(values, valueMap)
+			if len(rowErrs) > 0 {
+				lineErrs = append(lineErrs, lineError{line: lines[rowIdx], err: errors.Join(rowErrs...)})
+				return
+			}
+			// 元のファイルの行の順番を保つ
+			values[rowIdx] = valueMap
 		})
 	}
 	wg.Wait()
-	return values
+	if len(lineErrs) > 0 {
+		slices.SortFunc(lineErrs, func(a, b lineError) int { return a.line - b.line })
+		errs := make([]error, 0, len(lineErrs))
+		for _, le := range lineErrs {
+			errs = append(errs, fmt.Errorf("line %d: %w", le.line, le.err))
+		}
+		return nil, errors.Join(errs...)
+	}
+	return values, nil
+}
+
+// WriteRequestを１件書き込む、Transactionは含められない
+func execWrite(ext Execerx, req repository.WriteRequest) error {
+	switch req.Method {
+	case repository.Insert, repository.Upsert:
+		stmt := fmt.Sprintf(
+			"%s INTO %s(%s) VALUES (%s);",
+			req.Method,
+			req.Table,
+			strings.Join(req.Targets, ", "),
+			":"+strings.Join(req.Targets, ", :"),
+		)
+		_, err := ext.NamedExec(stmt, req.Params)
+		return err
+	case repository.Update:
+		values := make([]string, 0, len(req.Targets))
+		for _, t := range req.Targets {
+			values = append(values, fmt.Sprintf("%s = :%s", t, t))
+		}
+		stmt := fmt.Sprintf(
+			"UPDATE %s SET %s WHERE %s;",
+			req.Table,
+			strings.Join(values, ", "),
+			req.Conds,
+		)
+		_, err := ext.NamedExec(stmt, req.Params)
+		return err
+	case repository.Delete:
+		stmt := fmt.Sprintf("DELETE FROM %s WHERE %s;", req.Table, req.Conds)
+		_, err := ext.NamedExec(stmt, req.Params)
+		return err
+	default:
+		return errors.ErrUnsupported
+	}
+}
+
+func execTransaction(conn *sqlx.DB, tables []string, requests []repository.WriteRequest) error {
+	for _, req := range requests {
+		if !slices.Contains(tables, req.Table) {
+			return errors.New("Table is not exist")
+		}
+	}
+	tx, err := conn.Beginx()
+	if err != nil {
+		return err
+	}
+	for _, req := range requests {
+		if err := execWrite(tx, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// 永続化されたDBを古いバージョンから引き継いだ場合に備えて、足りないカラムを追加する
+func addMissingColumns(conn *sqlx.DB, table string) error {
+	var existing []string
+	if err := conn.Select(&existing, fmt.Sprintf("SELECT name FROM pragma_table_info('%s');", table)); err != nil {
+		return err
+	}
+	for _, col := range columnMap[table].Columns {
+		if slices.Contains(existing, col.Name) {
+			continue
+		}
+		// ALTER TABLEではPRIMARY KEYやUNIQUEの制約は付けられないので型と既定値だけ指定する
+		if _, err := conn.Exec(strings.TrimSpace(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s %s", table, col.Name, col.Type, col.defaultClause())) + ";"); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
+// sessionDatabasesに指定した永続化DBは、保存されている内容に触れないように今回の起動の間だけ一時ディレクトリに作る
+func NewSQLiteDB(dbFileDir string, persistentDir string, dbSources fs.FS, sessionDatabases ...string) (*SQLiteDB, error) {
 	connections := make(map[string]map[Mode]*sqlx.DB, len(databases))
 	clearConnections := func() {
 		// 途中で失敗した場合に過去に生成済みのものをcloseする
@@ -293,23 +542,27 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 			}
 		}
 	}
-	readSourceFile := func(fileName string) ([]string, [][]string, error) {
+	readSourceFile := func(fileName string) ([]string, [][]string, []int, error) {
 		file, err := dbSources.Open(fileName)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		defer file.Close()
-		return readXSVFile(file)
+		return readXSVFile(file, xsvDelimiter(fileName))
 	}
 	for dbName := range databases {
 		connections[dbName] = make(map[Mode]*sqlx.DB, 2)
-		reader, err := sqlx.Open("sqlite", fmt.Sprintf("file:%s/%s.db?%s", dbFileDir, dbName, ReadOnlyDsnOption))
+		dir := dbFileDir
+		if slices.Contains(persistentDatabases, dbName) && !slices.Contains(sessionDatabases, dbName) {
+			dir = persistentDir
+		}
+		reader, err := sqlx.Open("sqlite", fmt.Sprintf("file:%s/%s.db?%s", dir, dbName, ReadOnlyDsnOption))
 		if err != nil {
 			clearConnections()
 			os.RemoveAll(dbFileDir)
 			return nil, err
 		}
-		writer, err := sqlx.Open("sqlite", fmt.Sprintf("file:%s/%s.db?%s", dbFileDir, dbName, ReadWriteDsnOption))
+		writer, err := sqlx.Open("sqlite", fmt.Sprintf("file:%s/%s.db?%s", dir, dbName, ReadWriteDsnOption))
 		if err != nil {
 			clearConnections()
 			reader.Close()
@@ -325,18 +578,39 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 			if _, err = connections[dbName][Write].Exec(migrations[table]); err != nil {
 				break
 			}
-			filePath := fmt.Sprintf("%s/%s.csv", dbName, table)
-			if match, err := fs.Glob(dbSources, filePath); err == nil && match != nil {
-				header, body, err := readSourceFile(filePath)
-				if err != nil {
-					break
-				}
-				colNames := columnMap[table].ColNames()
-				query := fmt.Sprintf("INSERT INTO %s(%s) VALUES (%s);", table, strings.Join(colNames, ", "), ":"+strings.Join(colNames, ", :"))
-				values := createValueMap(header, body, columnMap[table])
-				if _, err := connections[dbName][Write].NamedExec(query, values); err != nil {
-					break
-				}
+			if err = addMissingColumns(connections[dbName][Write], table); err != nil {
+				break
+			}
+			// 永続化されたDBに既にデータがある場合は初期データを投入しない
+			var rowNum int
+			if err = connections[dbName][Write].QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s;", table)).Scan(&rowNum); err != nil {
+				break
+			}
+			if rowNum > 0 {
+				continue
+			}
+			match, globErr := fs.Glob(dbSources, fmt.Sprintf("%s/%s.[ct]sv", dbName, table))
+			if globErr != nil || len(match) == 0 {
+				continue
+			}
+			filePath := match[0]
+			header, body, lines, readErr := readSourceFile(filePath)
+			if readErr != nil {
+				err = fmt.Errorf("%s: %w", filePath, readErr)
+				break
+			}
+			values, valueErr := createValueMap(header, body, lines, columnMap[table])
+			if valueErr != nil {
+				err = fmt.Errorf("%s: %w", filePath, valueErr)
+				break
+			}
+			if len(values) == 0 {
+				continue
+			}
+			colNames := columnMap[table].ColNames()
+			query := fmt.Sprintf("INSERT INTO %s(%s) VALUES (%s);", table, strings.Join(colNames, ", "), ":"+strings.Join(colNames, ", :"))
+			if _, err = connections[dbName][Write].NamedExec(query, values); err != nil {
+				break
 			}
 		}
 		if err != nil {
@@ -349,12 +623,8 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 	// 書き込みキューをデータベースにつき１つに限定したいのでOnceValueで作る
 	queues := sync.OnceValue(func() map[string]chan<- repository.WriteRequest {
 		batchSize := 10
-		queueMap := make(map[string]chan<- repository.WriteRequest, len(databases)-1)
+		queueMap := make(map[string]chan<- repository.WriteRequest, len(databases))
 		for db := range databases {
-			// Masterは起動時以外書き込む必要が無いのでスキップ
-			if db == "Master" {
-				continue
-			}
 			q := make(chan repository.WriteRequest, 100)
 
 			go func(db string) {
@@ -366,15 +636,9 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 						// ここでブロックされてしまうと全部止まってしまうので早めにタイムアウト
 					}
 				}
-				doInsert := func(ext *sqlx.DB, req repository.WriteRequest) {
-					stmt := fmt.Sprintf(
-						"INSERT INTO %s(%s) VALUES (%s);",
-						req.Table,
-						strings.Join(req.Targets, ", "),
-						":"+strings.Join(req.Targets, ", :"),
-					)
-					_, err := ext.NamedExec(stmt, req.Params)
-					sendErr(req.ResultCh, err)
+				// InsertとUpsertはどちらもここで処理する、WriteMethodの値がそのままSQLの動詞になっている
+				doInsert := func(ext Execerx, req repository.WriteRequest) {
+					sendErr(req.ResultCh, execWrite(ext, req))
 				}
 				flush := func(ext *sqlx.DB, requests []repository.WriteRequest) {
 					params := make([]any, 0, len(requests))
@@ -386,6 +650,7 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 					// ResultChだけはここで一回受けてから元のWriteRequestに同じものを渡したいので新規に作成
 					newRequest := repository.WriteRequest{
 						Table:    requests[0].Table,
+						Method:   requests[0].Method,
 						Targets:  requests[0].Targets,
 						Params:   params,
 						Conds:    requests[0].Conds,
@@ -397,6 +662,21 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 						sendErr(req.ResultCh, err)
 					}
 				}
+				// 一度のINSERT文にまとめられるのは、テーブルと書き込み方法と対象カラムが同じものだけ
+				canJoinBatch := func(req repository.WriteRequest) bool {
+					if len(batch) == 0 {
+						return true
+					}
+					return batch[0].Table == req.Table &&
+						batch[0].Method == req.Method &&
+						slices.Equal(slices.Sorted(slices.Values(batch[0].Targets)), slices.Sorted(slices.Values(req.Targets)))
+				}
+				flushPending := func() {
+					if len(batch) > 0 {
+						flush(connections[db][Write], batch)
+						batch = batch[:0]
+					}
+				}
 				timer := time.NewTimer(time.Second)
 				for {
 					select {
@@ -404,46 +684,34 @@ func NewSQLiteDB(dbFileDir string, dbSources fs.FS) (*SQLiteDB, error) {
 						if !ok {
 							return
 						}
-						if !slices.Contains(databases[db], req.Table) {
+						if req.Method != repository.Transaction && !slices.Contains(databases[db], req.Table) {
 							sendErr(req.ResultCh, errors.New("Table is not exist"))
 							continue
 						}
+						isBatchable := (req.Method == repository.Insert || req.Method == repository.Upsert) && slices.Contains(doBatchTables, req.Table)
+						// 書き込みの順番が入れ替わらないように、まとめられないものが来たら溜まっている分を先に書き込む
+						if !isBatchable || !canJoinBatch(req) {
+							flushPending()
+						}
 						switch req.Method {
-						case repository.Insert:
-							if slices.Contains(doBatchTables, req.Table) {
+						case repository.Insert, repository.Upsert:
+							if isBatchable {
 								batch = append(batch, req)
 								if len(batch) >= batchSize {
-									flush(connections[db][Write], batch)
-									batch = batch[:0]
+									flushPending()
 								}
 							} else {
 								doInsert(connections[db][Write], req)
 							}
-						case repository.Update:
-							values := make([]string, 0, len(req.Targets))
-							for _, t := range req.Targets {
-								values = append(values, fmt.Sprintf("%s = :%s", t, t))
-							}
-							stmt := fmt.Sprintf(
-								"UPDATE %s SET %s WHERE %s;",
-								req.Table,
-								strings.Join(values, ", "),
-								req.Conds,
-							)
-							_, err := connections[db][Write].NamedExec(stmt, req.Params)
-							sendErr(req.ResultCh, err)
-						case repository.Delete:
-							stmt := fmt.Sprintf("DELETE FROM %s WHERE %s;", req.Table, req.Conds)
-							_, err := connections[db][Write].NamedExec(stmt, req.Params)
-							sendErr(req.ResultCh, err)
+						case repository.Update, repository.Delete:
+							sendErr(req.ResultCh, execWrite(connections[db][Write], req))
+						case repository.Transaction:
+							sendErr(req.ResultCh, execTransaction(connections[db][Write], databases[db], req.Requests))
 						default:
 							req.ResultCh <- errors.ErrUnsupported
 						}
 					case <-timer.C:
-						if len(batch) > 0 {
-							flush(connections[db][Write], batch)
-							batch = batch[:0]
-						}
+						flushPending()
 						timer.Reset(time.Second)
 					}
 				}
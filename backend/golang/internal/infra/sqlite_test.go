@@ -0,0 +1,126 @@
+package infra
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+	"github.com/jmoiron/sqlx"
+)
+
+func fetchQuestionTexts(t *testing.T, db *SQLiteDB) []string {
+	t.Helper()
+	questions, err := repository.NewProfileQuestionRepository(db).FetchAllQuestions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := make([]string, 0, len(questions))
+	for _, q := range questions {
+		texts = append(texts, q.GetQuestionText())
+	}
+	return texts
+}
+
+// 今回の起動の間だけ使うDBに書き込んでも、保存されている質問は変わらないこと
+func TestSessionDatabaseKeepsStoredQuestions(t *testing.T) {
+	persistentDir := t.TempDir()
+	stored, err := NewSQLiteDB(t.TempDir(), persistentDir, fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := model.NewProfileQuestion(0, "Stored", "Stored?", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repository.NewProfileQuestionRepository(stored).Create(q); err != nil {
+		t.Fatal(err)
+	}
+	stored.Close()
+
+	session, err := NewSQLiteDB(t.TempDir(), persistentDir, fstest.MapFS{}, "Master")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pack, err := model.NewProfileQuestion(1, "Pack", "Pack?", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repository.NewProfileQuestionRepository(session).ReplaceAll([]model.ProfileQuestion{*pack}); err != nil {
+		t.Fatal(err)
+	}
+	if got := fetchQuestionTexts(t, session); len(got) != 1 || got[0] != "Pack" {
+		t.Errorf("questions in the session = %v, want [Pack]", got)
+	}
+	session.Close()
+
+	reopened, err := NewSQLiteDB(t.TempDir(), persistentDir, fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if got := fetchQuestionTexts(t, reopened); len(got) != 1 || got[0] != "Stored" {
+		t.Errorf("stored questions = %v, want [Stored]", got)
+	}
+}
+
+// 置き換えに失敗した場合は元の質問が残ること
+func TestReplaceAllIsAtomic(t *testing.T) {
+	db, err := NewSQLiteDB(t.TempDir(), t.TempDir(), fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	pqr := repository.NewProfileQuestionRepository(db)
+	q, err := model.NewProfileQuestion(0, "Stored", "Stored?", nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pqr.Create(q); err != nil {
+		t.Fatal(err)
+	}
+	// 同じIDが２つあると２件目の挿入で失敗する
+	a, _ := model.NewProfileQuestion(5, "A", "A?", nil, false)
+	b, _ := model.NewProfileQuestion(5, "B", "B?", nil, false)
+	if err := pqr.ReplaceAll([]model.ProfileQuestion{*a, *b}); err == nil {
+		t.Fatal("ReplaceAll with duplicate IDs succeeded, want error")
+	}
+	if got := fetchQuestionTexts(t, db); len(got) != 1 || got[0] != "Stored" {
+		t.Errorf("questions = %v, want [Stored]", got)
+	}
+}
+
+// 古いバージョンで作られた永続化DBを引き継いでも、追加したカラムを読めること
+func TestAddMissingColumnsKeepsOldRowsReadable(t *testing.T) {
+	persistentDir := t.TempDir()
+	old, err := sqlx.Open("sqlite", fmt.Sprintf("file:%s/Master.db", persistentDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := old.Exec("CREATE TABLE ProfileQuestion(question_id INTEGER PRIMARY KEY, question_text TEXT, quiz_text TEXT, sample_answer TEXT);"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := old.Exec("INSERT INTO ProfileQuestion VALUES (1, 'Q1', 'Quiz1', 'a|b'), (2, 'Q2', 'Quiz2', '');"); err != nil {
+		t.Fatal(err)
+	}
+	old.Close()
+
+	db, err := NewSQLiteDB(t.TempDir(), persistentDir, fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	questions, err := repository.NewProfileQuestionRepository(db).FetchAllQuestions()
+	if err != nil {
+		t.Fatalf("FetchAllQuestions() error = %v", err)
+	}
+	if len(questions) != 2 {
+		t.Fatalf("len(questions) = %d, want 2", len(questions))
+	}
+	for _, q := range questions {
+		if q.IsOptional() {
+			t.Errorf("question %d is optional, want required", q.GetQuestionID())
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 履歴に残す１ゲーム分の記録
+// 参加者はゲーム毎のUserではなくPlayerのIDで記録する
+type GameRecord struct {
+	GameID    uuid.UUID
+	StartedAt time.Time
+	EndedAt   time.Time
+	// ゲームのルールをJSONにしたもの
+	Rules       string
+	QuizCount   int
+	Teams       []GameTeamRecord
+	Players     []GamePlayerRecord
+	Quizzes     []GameQuizRecord
+	TeamAnswers []GameTeamAnswerRecord
+	Answers     []GameAnswerRecord
+}
+
+type GameTeamRecord struct {
+	TeamID       uint32
+	CorrectCount int
+	Points       int
+	Order        int
+}
+
+type GamePlayerRecord struct {
+	PlayerID uuid.UUID
+	// このゲームで登録した名前
+	Name          string
+	TeamID        uint32
+	AnsweredCount int
+	CorrectCount  int
+	// 回答までにかかった時間の合計
+	TotalResponseTime time.Duration
+	Order             int
+}
+
+func (pr GamePlayerRecord) AverageResponseTime() time.Duration {
+	if pr.AnsweredCount == 0 {
+		return 0
+	}
+	return pr.TotalResponseTime / time.Duration(pr.AnsweredCount)
+}
+
+type GameQuizRecord struct {
+	Number int
+	// 出題対象のプレイヤー、途中で辞退した参加者の場合はuuid.Nil
+	TargetPlayerID     uuid.UUID
+	TargetTeamID       uint32
+	QuestionID         uint
+	QuestionText       string
+	CorrectAnswer      string
+	Hint               string
+	StartedAt          time.Time
+	CountdownStartedAt time.Time
+	HintTakenAt        time.Time
+	CheckedAt          time.Time
+}
+
+type GameTeamAnswerRecord struct {
+	QuizNumber     int
+	TeamID         uint32
+	Answer         string
+	IsCorrect      bool
+	IsDoublePoints bool
+	Lifelines      []string
+}
+
+type GameAnswerRecord struct {
+	QuizNumber   int
+	PlayerID     uuid.UUID
+	TeamID       uint32
+	Answer       string
+	IsCorrect    bool
+	AnsweredAt   time.Time
+	ResponseTime time.Duration
+}
+
+// 一人のプレイヤーの１ゲーム分の成績
+type PlayerGameRecord struct {
+	GameID      uuid.UUID
+	StartedAt   time.Time
+	QuizCount   int
+	PlayerCount int
+	TeamNum     int
+	TeamOrder   int
+	GamePlayerRecord
+}
+
+// あるプレイヤーが、これまでのゲームで出題対象のプレイヤーについてのクイズに答えた成績
+type PlayerPairRecord struct {
+	TargetPlayerID uuid.UUID
+	AnsweredCount  int
+	CorrectCount   int
+}
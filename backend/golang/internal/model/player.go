@@ -0,0 +1,63 @@
+package model
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
+)
+
+const PlayerKeyLength int = 16
+
+// ゲームを跨いで同じ人を識別するためのプレイヤー
+// Userはゲーム毎に作り直されるので、履歴はプレイヤーに紐付けて残す
+type Player struct {
+	playerID uuid.UUID
+	// 端末に保存してもらい、次のゲームの参加登録時に送ってもらう鍵
+	playerKey string
+	name      string
+}
+
+func (p *Player) GetPlayerID() uuid.UUID {
+	return p.playerID
+}
+
+func (p *Player) GetPlayerKey() string {
+	return p.playerKey
+}
+
+func (p *Player) GetName() string {
+	return p.name
+}
+
+// 最後に参加した時の名前で表示する
+func (p *Player) SetName(name string) {
+	p.name = name
+}
+
+func NewPlayer(name string) (*Player, error) {
+	playerID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	key, err := util.CreateRandStr(PlayerKeyLength)
+	if err != nil {
+		return nil, err
+	}
+	return &Player{
+		playerID:  playerID,
+		playerKey: key,
+		name:      name,
+	}, nil
+}
+
+func ReconstructPlayer(playerID string, playerKey string, name string) (*Player, error) {
+	pid, err := uuid.Parse(playerID)
+	if err != nil {
+		return nil, err
+	}
+	return &Player{
+		playerID:  pid,
+		playerKey: playerKey,
+		name:      name,
+	}, nil
+}
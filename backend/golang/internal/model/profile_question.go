@@ -1,10 +1,17 @@
 package model
 
+import (
+	"errors"
+	"strings"
+)
+
 type ProfileQuestion struct {
-	questionID   uint
-	questionText string
-	quizText     string
-	sampleAnswer string
+	questionID    uint
+	questionText  string
+	quizText      string
+	sampleAnswers []string
+	// trueの場合、参加者は回答せずにスキップできる
+	isOptional bool
 }
 
 func (pq *ProfileQuestion) GetQuestionID() uint {
@@ -19,15 +26,26 @@ func (pq *ProfileQuestion) GetQuizText() string {
 	return pq.quizText
 }
 
-func (pq *ProfileQuestion) GetSampleAnswer() string {
-	return pq.sampleAnswer
+func (pq *ProfileQuestion) GetSampleAnswers() []string {
+	return pq.sampleAnswers
+}
+
+func (pq *ProfileQuestion) IsOptional() bool {
+	return pq.isOptional
 }
 
-func NewProfileQuestion(questionID uint, questionText string, quizText string, sampleAnswer string) (*ProfileQuestion, error) {
+func NewProfileQuestion(questionID uint, questionText string, quizText string, sampleAnswers []string, isOptional bool) (*ProfileQuestion, error) {
+	if strings.TrimSpace(questionText) == "" {
+		return nil, errors.New("Question text is required")
+	}
+	if strings.TrimSpace(quizText) == "" {
+		return nil, errors.New("Quiz text is required")
+	}
 	return &ProfileQuestion{
-		questionID:   questionID,
-		questionText: questionText,
-		quizText:     quizText,
-		sampleAnswer: sampleAnswer,
+		questionID:    questionID,
+		questionText:  questionText,
+		quizText:      quizText,
+		sampleAnswers: sampleAnswers,
+		isOptional:    isOptional,
 	}, nil
 }
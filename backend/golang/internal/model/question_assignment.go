@@ -0,0 +1,30 @@
+package model
+
+import "github.com/google/uuid"
+
+// 参加者毎に割り当てられたプロフィール質問
+type QuestionAssignment struct {
+	userID     uuid.UUID
+	questionID uint
+	isSkipped  bool
+}
+
+func (qa *QuestionAssignment) GetUserID() uuid.UUID {
+	return qa.userID
+}
+
+func (qa *QuestionAssignment) GetQuestionID() uint {
+	return qa.questionID
+}
+
+func (qa *QuestionAssignment) IsSkipped() bool {
+	return qa.isSkipped
+}
+
+func NewQuestionAssignment(userID uuid.UUID, questionID uint, isSkipped bool) *QuestionAssignment {
+	return &QuestionAssignment{
+		userID:     userID,
+		questionID: questionID,
+		isSkipped:  isSkipped,
+	}
+}
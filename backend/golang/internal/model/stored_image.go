@@ -0,0 +1,44 @@
+package model
+
+import (
+	"io"
+	"time"
+)
+
+// 画像の保存先から取り出した画像、読み終わったらCloseする
+type StoredImage struct {
+	key     string
+	size    int64
+	modTime time.Time
+	body    io.ReadCloser
+}
+
+func (si *StoredImage) GetKey() string {
+	return si.key
+}
+
+// 分からない場合は-1
+func (si *StoredImage) GetSize() int64 {
+	return si.size
+}
+
+func (si *StoredImage) GetModTime() time.Time {
+	return si.modTime
+}
+
+func (si *StoredImage) Read(p []byte) (int, error) {
+	return si.body.Read(p)
+}
+
+func (si *StoredImage) Close() error {
+	return si.body.Close()
+}
+
+func NewStoredImage(key string, size int64, modTime time.Time, body io.ReadCloser) *StoredImage {
+	return &StoredImage{
+		key:     key,
+		size:    size,
+		modTime: modTime,
+		body:    body,
+	}
+}
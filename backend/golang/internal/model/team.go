@@ -1,6 +1,8 @@
 // nolint unused
 package model
 
+import "image/color"
+
 type TeamColor uint32
 
 const (
@@ -19,7 +21,6 @@ const (
 
 const MaxTeamNum int = int(teamNum) - 1
 const MinTeamNum int = 2
-const MinTeamUser int = 3
 
 func (tc TeamColor) Raw() uint32 {
 	return uint32(tc)
@@ -54,6 +55,32 @@ func (tc TeamColor) String() string {
 	}
 }
 
+// 画像の生成などに使う色、UNDEFINEDはチーム分け前なので灰色にする
+func (tc TeamColor) RGBA() color.RGBA {
+	switch tc {
+	case RED:
+		return color.RGBA{R: 0xE5, G: 0x39, B: 0x35, A: 0xFF}
+	case BLUE:
+		return color.RGBA{R: 0x1E, G: 0x88, B: 0xE5, A: 0xFF}
+	case GREEN:
+		return color.RGBA{R: 0x43, G: 0xA0, B: 0x47, A: 0xFF}
+	case YELLOW:
+		return color.RGBA{R: 0xFD, G: 0xD8, B: 0x35, A: 0xFF}
+	case CYAN:
+		return color.RGBA{R: 0x00, G: 0xAC, B: 0xC1, A: 0xFF}
+	case MAGENTA:
+		return color.RGBA{R: 0xD8, G: 0x1B, B: 0x60, A: 0xFF}
+	case WHITE:
+		return color.RGBA{R: 0xFA, G: 0xFA, B: 0xFA, A: 0xFF}
+	case GLAY:
+		return color.RGBA{R: 0x75, G: 0x75, B: 0x75, A: 0xFF}
+	case BLACK:
+		return color.RGBA{R: 0x21, G: 0x21, B: 0x21, A: 0xFF}
+	default:
+		return color.RGBA{R: 0x9E, G: 0x9E, B: 0x9E, A: 0xFF}
+	}
+}
+
 type Team struct {
 	teamID    uint32
 	teamColor TeamColor
@@ -12,6 +12,8 @@ type UserProfile struct {
 	userID    uuid.UUID
 	profileID uint
 	answer    string
+	// 禁止語を含むため、管理者が確認するまでクイズに使わない回答
+	isFlagged bool
 }
 
 func (up *UserProfile) GetUserID() uuid.UUID {
@@ -26,6 +28,14 @@ func (up *UserProfile) GetAnswer() string {
 	return up.answer
 }
 
+func (up *UserProfile) IsFlagged() bool {
+	return up.isFlagged
+}
+
+func (up *UserProfile) Flag() {
+	up.isFlagged = true
+}
+
 func NewUserProfile(userID uuid.UUID, profileID uint, answer string) (*UserProfile, error) {
 	if len(answer) > MaxAnswerLength {
 		return nil, errors.New("Answer is too long")
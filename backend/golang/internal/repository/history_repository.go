@@ -0,0 +1,417 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 複数のライフラインはこの区切り文字で連結して保存する
+const LifelineSeparator string = ","
+
+// ゼロ値の時刻は0として保存する
+func toUnixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func fromUnixMilli(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// 削除された参加者などプレイヤーが分からない場合は空文字で保存する
+func playerIDString(pid uuid.UUID) string {
+	if pid == uuid.Nil {
+		return ""
+	}
+	return pid.String()
+}
+
+type DBGameRow struct {
+	GameID    string `db:"game_id"`
+	StartedAt int64  `db:"started_at"`
+	EndedAt   int64  `db:"ended_at"`
+	Rules     string `db:"rules"`
+	QuizCount int    `db:"quiz_count"`
+}
+
+type DBGameTeamRow struct {
+	GameID       string `db:"game_id"`
+	TeamID       int    `db:"team_id"`
+	CorrectCount int    `db:"correct_count"`
+	Points       int    `db:"points"`
+	TeamOrder    int    `db:"team_order"`
+}
+
+type DBGamePlayerRow struct {
+	GameID            string `db:"game_id"`
+	PlayerID          string `db:"player_id"`
+	Name              string `db:"name"`
+	TeamID            int    `db:"team_id"`
+	AnsweredCount     int    `db:"answered_count"`
+	CorrectCount      int    `db:"correct_count"`
+	TotalResponseTime int64  `db:"total_response_time"`
+	PersonalOrder     int    `db:"personal_order"`
+}
+
+func (row DBGamePlayerRow) toRecord() (model.GamePlayerRecord, error) {
+	pid, err := uuid.Parse(row.PlayerID)
+	if err != nil {
+		return model.GamePlayerRecord{}, err
+	}
+	return model.GamePlayerRecord{
+		PlayerID:          pid,
+		Name:              row.Name,
+		TeamID:            uint32(row.TeamID),
+		AnsweredCount:     row.AnsweredCount,
+		CorrectCount:      row.CorrectCount,
+		TotalResponseTime: time.Duration(row.TotalResponseTime) * time.Millisecond,
+		Order:             row.PersonalOrder,
+	}, nil
+}
+
+type DBGameQuizRow struct {
+	GameID             string `db:"game_id"`
+	QuizNumber         int    `db:"quiz_number"`
+	TargetPlayerID     string `db:"target_player_id"`
+	TargetTeamID       int    `db:"target_team_id"`
+	QuestionID         int    `db:"question_id"`
+	QuestionText       string `db:"question_text"`
+	CorrectAnswer      string `db:"correct_answer"`
+	Hint               string `db:"hint"`
+	StartedAt          int64  `db:"started_at"`
+	CountdownStartedAt int64  `db:"countdown_started_at"`
+	HintTakenAt        int64  `db:"hint_taken_at"`
+	CheckedAt          int64  `db:"checked_at"`
+}
+
+type DBGameTeamAnswerRow struct {
+	GameID         string `db:"game_id"`
+	QuizNumber     int    `db:"quiz_number"`
+	TeamID         int    `db:"team_id"`
+	Answer         string `db:"answer"`
+	IsCorrect      bool   `db:"is_correct"`
+	IsDoublePoints bool   `db:"is_double_points"`
+	Lifelines      string `db:"lifelines"`
+}
+
+type DBGameAnswerRow struct {
+	GameID       string `db:"game_id"`
+	QuizNumber   int    `db:"quiz_number"`
+	PlayerID     string `db:"player_id"`
+	TeamID       int    `db:"team_id"`
+	Answer       string `db:"answer"`
+	IsCorrect    bool   `db:"is_correct"`
+	AnsweredAt   int64  `db:"answered_at"`
+	ResponseTime int64  `db:"response_time"`
+}
+
+type DBPlayerPairRow struct {
+	TargetPlayerID string `db:"target_player_id"`
+	AnsweredCount  int    `db:"answered_count"`
+	CorrectCount   int    `db:"correct_count"`
+}
+
+type DBPlayerGameRow struct {
+	DBGamePlayerRow
+	StartedAt   int64 `db:"started_at"`
+	QuizCount   int   `db:"quiz_count"`
+	PlayerCount int   `db:"player_count"`
+	TeamNum     int   `db:"team_num"`
+	TeamOrder   int   `db:"team_order"`
+}
+
+type HistoryRepository struct {
+	db IDatabase
+}
+
+// 途中で失敗した場合に中途半端な記録が残らないよう、1つのトランザクションで書き込む
+func (hr *HistoryRepository) SaveGame(game *model.GameRecord) error {
+	gameID := game.GameID.String()
+	requests := make([]WriteRequest, 0, 1+len(game.Teams)+len(game.Players)+len(game.Quizzes)+len(game.TeamAnswers)+len(game.Answers))
+	requests = append(requests, WriteRequest{
+		Table:   "Game",
+		Method:  Insert,
+		Targets: []string{"game_id", "started_at", "ended_at", "rules", "quiz_count"},
+		Params: DBGameRow{
+			GameID:    gameID,
+			StartedAt: toUnixMilli(game.StartedAt),
+			EndedAt:   toUnixMilli(game.EndedAt),
+			Rules:     game.Rules,
+			QuizCount: game.QuizCount,
+		},
+	})
+	for _, t := range game.Teams {
+		requests = append(requests, WriteRequest{
+			Table:   "GameTeam",
+			Method:  Insert,
+			Targets: []string{"game_id", "team_id", "correct_count", "points", "team_order"},
+			Params: DBGameTeamRow{
+				GameID:       gameID,
+				TeamID:       int(t.TeamID),
+				CorrectCount: t.CorrectCount,
+				Points:       t.Points,
+				TeamOrder:    t.Order,
+			},
+		})
+	}
+	for _, p := range game.Players {
+		requests = append(requests, WriteRequest{
+			Table:   "GamePlayer",
+			Method:  Insert,
+			Targets: []string{"game_id", "player_id", "name", "team_id", "answered_count", "correct_count", "total_response_time", "personal_order"},
+			Params: DBGamePlayerRow{
+				GameID:            gameID,
+				PlayerID:          p.PlayerID.String(),
+				Name:              p.Name,
+				TeamID:            int(p.TeamID),
+				AnsweredCount:     p.AnsweredCount,
+				CorrectCount:      p.CorrectCount,
+				TotalResponseTime: p.TotalResponseTime.Milliseconds(),
+				PersonalOrder:     p.Order,
+			},
+		})
+	}
+	for _, q := range game.Quizzes {
+		requests = append(requests, WriteRequest{
+			Table:  "GameQuiz",
+			Method: Insert,
+			Targets: []string{
+				"game_id", "quiz_number", "target_player_id", "target_team_id", "question_id", "question_text",
+				"correct_answer", "hint", "started_at", "countdown_started_at", "hint_taken_at", "checked_at",
+			},
+			Params: DBGameQuizRow{
+				GameID:             gameID,
+				QuizNumber:         q.Number,
+				TargetPlayerID:     playerIDString(q.TargetPlayerID),
+				TargetTeamID:       int(q.TargetTeamID),
+				QuestionID:         int(q.QuestionID),
+				QuestionText:       q.QuestionText,
+				CorrectAnswer:      q.CorrectAnswer,
+				Hint:               q.Hint,
+				StartedAt:          toUnixMilli(q.StartedAt),
+				CountdownStartedAt: toUnixMilli(q.CountdownStartedAt),
+				HintTakenAt:        toUnixMilli(q.HintTakenAt),
+				CheckedAt:          toUnixMilli(q.CheckedAt),
+			},
+		})
+	}
+	for _, ta := range game.TeamAnswers {
+		requests = append(requests, WriteRequest{
+			Table:   "GameTeamAnswer",
+			Method:  Insert,
+			Targets: []string{"game_id", "quiz_number", "team_id", "answer", "is_correct", "is_double_points", "lifelines"},
+			Params: DBGameTeamAnswerRow{
+				GameID:         gameID,
+				QuizNumber:     ta.QuizNumber,
+				TeamID:         int(ta.TeamID),
+				Answer:         ta.Answer,
+				IsCorrect:      ta.IsCorrect,
+				IsDoublePoints: ta.IsDoublePoints,
+				Lifelines:      strings.Join(ta.Lifelines, LifelineSeparator),
+			},
+		})
+	}
+	for _, a := range game.Answers {
+		requests = append(requests, WriteRequest{
+			Table:   "GameAnswer",
+			Method:  Insert,
+			Targets: []string{"game_id", "quiz_number", "player_id", "team_id", "answer", "is_correct", "answered_at", "response_time"},
+			Params: DBGameAnswerRow{
+				GameID:       gameID,
+				QuizNumber:   a.QuizNumber,
+				PlayerID:     a.PlayerID.String(),
+				TeamID:       int(a.TeamID),
+				Answer:       a.Answer,
+				IsCorrect:    a.IsCorrect,
+				AnsweredAt:   toUnixMilli(a.AnsweredAt),
+				ResponseTime: a.ResponseTime.Milliseconds(),
+			},
+		})
+	}
+	resultCh := make(chan error, 1)
+	hr.db.Command("History", WriteRequest{
+		Method:   Transaction,
+		Requests: requests,
+		ResultCh: resultCh,
+	})
+	return <-resultCh
+}
+
+func (hr *HistoryRepository) fetchTeams(gameID string) ([]model.GameTeamRecord, error) {
+	rows, err := hr.db.Query("History", "SELECT * FROM GameTeam WHERE game_id = ? ORDER BY team_id", gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	teams := make([]model.GameTeamRecord, 0)
+	for rows.Next() {
+		dbTeam := DBGameTeamRow{}
+		if err := rows.StructScan(&dbTeam); err != nil {
+			return nil, err
+		}
+		teams = append(teams, model.GameTeamRecord{
+			TeamID:       uint32(dbTeam.TeamID),
+			CorrectCount: dbTeam.CorrectCount,
+			Points:       dbTeam.Points,
+			Order:        dbTeam.TeamOrder,
+		})
+	}
+	return teams, nil
+}
+
+func (hr *HistoryRepository) fetchPlayers(gameID string) ([]model.GamePlayerRecord, error) {
+	rows, err := hr.db.Query("History", "SELECT * FROM GamePlayer WHERE game_id = ? ORDER BY team_id, personal_order, name", gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	players := make([]model.GamePlayerRecord, 0)
+	for rows.Next() {
+		dbPlayer := DBGamePlayerRow{}
+		if err := rows.StructScan(&dbPlayer); err != nil {
+			return nil, err
+		}
+		player, err := dbPlayer.toRecord()
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, player)
+	}
+	return players, nil
+}
+
+// 新しいゲームから順に、チームとプレイヤーの成績を付けて返す
+// 出題と回答は件数が多いので含めない
+func (hr *HistoryRepository) FetchGames(limit int, offset int) ([]model.GameRecord, error) {
+	rows, err := hr.db.Query("History", "SELECT * FROM Game ORDER BY started_at DESC LIMIT ? OFFSET ?", limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	dbGames := make([]DBGameRow, 0, limit)
+	for rows.Next() {
+		dbGame := DBGameRow{}
+		if err := rows.StructScan(&dbGame); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		dbGames = append(dbGames, dbGame)
+	}
+	rows.Close()
+
+	games := make([]model.GameRecord, 0, len(dbGames))
+	for _, dbGame := range dbGames {
+		gameID, err := uuid.Parse(dbGame.GameID)
+		if err != nil {
+			return nil, err
+		}
+		teams, err := hr.fetchTeams(dbGame.GameID)
+		if err != nil {
+			return nil, err
+		}
+		players, err := hr.fetchPlayers(dbGame.GameID)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, model.GameRecord{
+			GameID:    gameID,
+			StartedAt: fromUnixMilli(dbGame.StartedAt),
+			EndedAt:   fromUnixMilli(dbGame.EndedAt),
+			Rules:     dbGame.Rules,
+			QuizCount: dbGame.QuizCount,
+			Teams:     teams,
+			Players:   players,
+		})
+	}
+	return games, nil
+}
+
+// 古いゲームから順に返す、記録が途中で止まったゲームは含めない
+func (hr *HistoryRepository) FetchPlayerGames(pid uuid.UUID) ([]model.PlayerGameRecord, error) {
+	rows, err := hr.db.Query("History", `SELECT gp.*, g.started_at, g.quiz_count,
+		(SELECT COUNT(*) FROM GamePlayer WHERE game_id = gp.game_id) AS player_count,
+		(SELECT COUNT(*) FROM GameTeam WHERE game_id = gp.game_id) AS team_num,
+		COALESCE((SELECT team_order FROM GameTeam WHERE game_id = gp.game_id AND team_id = gp.team_id), 0) AS team_order
+		FROM GamePlayer gp INNER JOIN Game g ON g.game_id = gp.game_id
+		WHERE gp.player_id = ? ORDER BY g.started_at`, pid.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	games := make([]model.PlayerGameRecord, 0)
+	for rows.Next() {
+		dbGame := DBPlayerGameRow{}
+		if err := rows.StructScan(&dbGame); err != nil {
+			return nil, err
+		}
+		gameID, err := uuid.Parse(dbGame.GameID)
+		if err != nil {
+			return nil, err
+		}
+		player, err := dbGame.toRecord()
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, model.PlayerGameRecord{
+			GameID:           gameID,
+			StartedAt:        fromUnixMilli(dbGame.StartedAt),
+			QuizCount:        dbGame.QuizCount,
+			PlayerCount:      dbGame.PlayerCount,
+			TeamNum:          dbGame.TeamNum,
+			TeamOrder:        dbGame.TeamOrder,
+			GamePlayerRecord: player,
+		})
+	}
+	return games, nil
+}
+
+// 全てのゲームを通して、answererがtargetsの各プレイヤーについてのクイズに答えた数と正解した数を返す
+// 一度も答えていない相手は含めない
+func (hr *HistoryRepository) FetchPairRecords(answerer uuid.UUID, targets []uuid.UUID) ([]model.PlayerPairRecord, error) {
+	records := make([]model.PlayerPairRecord, 0, len(targets))
+	if len(targets) == 0 {
+		return records, nil
+	}
+	strTargets := make([]string, 0, len(targets))
+	for _, pid := range targets {
+		strTargets = append(strTargets, pid.String())
+	}
+	rows, err := hr.db.QueryIn("History", `SELECT q.target_player_id, COUNT(*) AS answered_count, SUM(a.is_correct) AS correct_count
+		FROM GameAnswer a INNER JOIN GameQuiz q ON q.game_id = a.game_id AND q.quiz_number = a.quiz_number
+		WHERE a.player_id = ? AND q.target_player_id IN (?)
+		GROUP BY q.target_player_id ORDER BY q.target_player_id`, answerer.String(), strTargets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		dbPair := DBPlayerPairRow{}
+		if err := rows.StructScan(&dbPair); err != nil {
+			return nil, err
+		}
+		pid, err := uuid.Parse(dbPair.TargetPlayerID)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, model.PlayerPairRecord{
+			TargetPlayerID: pid,
+			AnsweredCount:  dbPair.AnsweredCount,
+			CorrectCount:   dbPair.CorrectCount,
+		})
+	}
+	return records, nil
+}
+
+func NewHistoryRepository(db IDatabase) *HistoryRepository {
+	return &HistoryRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,194 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+)
+
+// 2チームで1問だけ出題したゲーム
+func twoTeamGame(startedAt time.Time, alice uuid.UUID, bob uuid.UUID) *model.GameRecord {
+	return &model.GameRecord{
+		GameID:    uuid.New(),
+		StartedAt: startedAt,
+		EndedAt:   startedAt.Add(20 * time.Minute),
+		Rules:     `{"countdown_seconds":30}`,
+		QuizCount: 1,
+		Teams: []model.GameTeamRecord{
+			{TeamID: 1, CorrectCount: 1, Points: 2, Order: 1},
+			{TeamID: 2, CorrectCount: 0, Points: 0, Order: 2},
+		},
+		Players: []model.GamePlayerRecord{
+			{PlayerID: alice, Name: "Alice", TeamID: 1, AnsweredCount: 1, CorrectCount: 1, TotalResponseTime: 3 * time.Second, Order: 1},
+			{PlayerID: bob, Name: "Bob", TeamID: 2, AnsweredCount: 1, CorrectCount: 0, TotalResponseTime: 5 * time.Second, Order: 2},
+		},
+		Quizzes: []model.GameQuizRecord{{
+			Number: 1, TargetPlayerID: alice, TargetTeamID: 1, QuestionID: 1,
+			QuestionText: "Favorite food?", CorrectAnswer: "Sushi", StartedAt: startedAt, CheckedAt: startedAt.Add(time.Minute),
+		}},
+		TeamAnswers: []model.GameTeamAnswerRecord{
+			{QuizNumber: 1, TeamID: 1, Answer: "Sushi", IsCorrect: true, IsDoublePoints: true, Lifelines: []string{"FIFTY_FIFTY"}},
+			{QuizNumber: 1, TeamID: 2, Answer: "Ramen"},
+		},
+		Answers: []model.GameAnswerRecord{
+			{QuizNumber: 1, PlayerID: alice, TeamID: 1, Answer: "Sushi", IsCorrect: true, AnsweredAt: startedAt.Add(3 * time.Second), ResponseTime: 3 * time.Second},
+			{QuizNumber: 1, PlayerID: bob, TeamID: 2, Answer: "Ramen", AnsweredAt: startedAt.Add(5 * time.Second), ResponseTime: 5 * time.Second},
+		},
+	}
+}
+
+func newGameRecord(players ...model.GamePlayerRecord) *model.GameRecord {
+	startedAt := time.Now().Add(-time.Hour)
+	return &model.GameRecord{
+		GameID:    uuid.New(),
+		StartedAt: startedAt,
+		EndedAt:   startedAt.Add(30 * time.Minute),
+		Rules:     "{}",
+		QuizCount: 1,
+		Teams:     []model.GameTeamRecord{{TeamID: 1, CorrectCount: 1, Points: 1, Order: 1}},
+		Players:   players,
+		Quizzes:   []model.GameQuizRecord{{Number: 1, TargetTeamID: 1, QuestionID: 1, QuestionText: "Q", CorrectAnswer: "A", StartedAt: startedAt}},
+	}
+}
+
+func TestHistoryRepositorySaveGame(t *testing.T) {
+	hr := repository.NewHistoryRepository(newTestDB(t))
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	alice, bob := uuid.New(), uuid.New()
+	game := twoTeamGame(startedAt, alice, bob)
+	if err := hr.SaveGame(game); err != nil {
+		t.Fatal(err)
+	}
+
+	games, err := hr.FetchGames(10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 1 {
+		t.Fatalf("FetchGames() returned %d games, want 1", len(games))
+	}
+	got := games[0]
+	if got.GameID != game.GameID || !got.StartedAt.Equal(startedAt) || got.Rules != game.Rules || got.QuizCount != 1 {
+		t.Errorf("FetchGames() = %+v, want the saved game", got)
+	}
+	if len(got.Teams) != 2 || got.Teams[0] != game.Teams[0] || got.Teams[1] != game.Teams[1] {
+		t.Errorf("teams = %+v, want %+v", got.Teams, game.Teams)
+	}
+	if len(got.Players) != 2 || got.Players[0] != game.Players[0] || got.Players[1] != game.Players[1] {
+		t.Errorf("players = %+v, want %+v", got.Players, game.Players)
+	}
+}
+
+// 同じプレイヤーの成績はゲームを跨いで古い順に並ぶ
+func TestHistoryRepositoryFetchPlayerGames(t *testing.T) {
+	hr := repository.NewHistoryRepository(newTestDB(t))
+	alice, bob := uuid.New(), uuid.New()
+	first := time.Now().Add(-48 * time.Hour).Truncate(time.Millisecond)
+	second := time.Now().Add(-24 * time.Hour).Truncate(time.Millisecond)
+	// 新しいゲームから保存しても並び順は変わらない
+	if err := hr.SaveGame(twoTeamGame(second, bob, alice)); err != nil {
+		t.Fatal(err)
+	}
+	if err := hr.SaveGame(twoTeamGame(first, alice, bob)); err != nil {
+		t.Fatal(err)
+	}
+
+	games, err := hr.FetchPlayerGames(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 2 {
+		t.Fatalf("FetchPlayerGames() returned %d games, want 2", len(games))
+	}
+	if !games[0].StartedAt.Equal(first) || !games[1].StartedAt.Equal(second) {
+		t.Errorf("games started at %s and %s, want oldest first", games[0].StartedAt, games[1].StartedAt)
+	}
+	if games[0].TeamOrder != 1 || games[1].TeamOrder != 2 {
+		t.Errorf("team orders = %d, %d, want 1, 2", games[0].TeamOrder, games[1].TeamOrder)
+	}
+	if games[0].PlayerCount != 2 || games[0].TeamNum != 2 || games[0].AverageResponseTime() != 3*time.Second {
+		t.Errorf("first game = %+v, want 2 players in 2 teams answering in 3s", games[0])
+	}
+
+	if none, err := hr.FetchPlayerGames(uuid.New()); err != nil || len(none) != 0 {
+		t.Errorf("FetchPlayerGames() of an unknown player = (%v, %v), want none", none, err)
+	}
+}
+
+// 出題対象ごとに、ゲームを跨いで回答数と正解数をまとめる
+func TestHistoryRepositoryFetchPairRecords(t *testing.T) {
+	hr := repository.NewHistoryRepository(newTestDB(t))
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	start := time.Now().Add(-72 * time.Hour)
+	// どのゲームもクイズはAliceについてで、Bobは１回目に外して２回目に当てた
+	wrong := twoTeamGame(start, alice, bob)
+	right := twoTeamGame(start.Add(24*time.Hour), alice, bob)
+	right.Answers[1].IsCorrect = true
+	// Bobについてのクイズへの回答は、BobがAliceを知っているかには数えない
+	aboutBob := twoTeamGame(start.Add(48*time.Hour), bob, alice)
+	for _, game := range []*model.GameRecord{wrong, right, aboutBob} {
+		if err := hr.SaveGame(game); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := hr.FetchPairRecords(bob, []uuid.UUID{alice, carol})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (model.PlayerPairRecord{TargetPlayerID: alice, AnsweredCount: 2, CorrectCount: 1}); len(records) != 1 || records[0] != want {
+		t.Errorf("FetchPairRecords() = %+v, want only %+v", records, want)
+	}
+	if none, err := hr.FetchPairRecords(bob, nil); err != nil || len(none) != 0 {
+		t.Errorf("FetchPairRecords() without targets = (%v, %v), want none", none, err)
+	}
+}
+
+func TestHistoryRepositoryFetchGamesPages(t *testing.T) {
+	hr := repository.NewHistoryRepository(newTestDB(t))
+	base := time.Now().Add(-time.Hour)
+	for i := range 3 {
+		if err := hr.SaveGame(twoTeamGame(base.Add(time.Duration(i)*time.Minute), uuid.New(), uuid.New())); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page, err := hr.FetchGames(2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || !page[0].StartedAt.After(page[1].StartedAt) {
+		t.Errorf("FetchGames(2, 1) = %d games, want the 2nd and 3rd newest", len(page))
+	}
+}
+
+// 途中の行で失敗した場合は、ゲームの行も含めて何も残さないこと
+func TestHistoryRepositorySaveGameIsAtomic(t *testing.T) {
+	db := newTestDB(t)
+	hr := repository.NewHistoryRepository(db)
+	pid := uuid.New()
+	game := newGameRecord(
+		model.GamePlayerRecord{PlayerID: pid, Name: "P1", TeamID: 1, Order: 1},
+		model.GamePlayerRecord{PlayerID: pid, Name: "P2", TeamID: 1, Order: 1},
+	)
+	if err := hr.SaveGame(game); err == nil {
+		t.Fatal("SaveGame() with a duplicate player succeeded, want error")
+	}
+	games, err := hr.FetchGames(10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 0 {
+		t.Errorf("len(games) = %d after a failed SaveGame, want 0", len(games))
+	}
+	var teams int
+	if err := db.QueryRow("History", "SELECT COUNT(*) FROM GameTeam").Scan(&teams); err != nil {
+		t.Fatal(err)
+	}
+	if teams != 0 {
+		t.Errorf("%d team rows are left after a failed SaveGame, want 0", teams)
+	}
+}
@@ -8,6 +8,10 @@ const (
 	Insert WriteMethod = "INSERT"
 	Update WriteMethod = "UPDATE"
 	Delete WriteMethod = "DELETE"
+	// 主キーが重複する行があれば置き換える
+	Upsert WriteMethod = "INSERT OR REPLACE"
+	// Requestsを１つのトランザクションで順に書き込み、どれかが失敗すれば全て取り消す
+	Transaction WriteMethod = "TRANSACTION"
 )
 
 type WriteRequest struct {
@@ -17,6 +21,8 @@ type WriteRequest struct {
 	Params   any
 	Conds    string
 	ResultCh chan<- error
+	// Transactionの場合だけ使う、個々のResultChは使わない
+	Requests []WriteRequest
 }
 
 type IDatabase interface {
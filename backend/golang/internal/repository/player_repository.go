@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type DBPlayerRow struct {
+	PlayerID   string `db:"player_id"`
+	KeyHash    string `db:"key_hash"`
+	Name       string `db:"name"`
+	LastSeenAt int64  `db:"last_seen_at"`
+}
+
+type DBUserPlayerRow struct {
+	UserID   string `db:"user_id"`
+	PlayerID string `db:"player_id"`
+}
+
+// 履歴のDBが漏れても鍵を使い回せないように、ハッシュ値だけを保存する
+func hashPlayerKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+type PlayerRepository struct {
+	db IDatabase
+}
+
+// 参加する度に名前と最終参加日時を更新する
+func (pr *PlayerRepository) Save(player *model.Player) error {
+	resultCh := make(chan error, 1)
+	pr.db.Command("History", WriteRequest{
+		Table:   "Player",
+		Method:  Upsert,
+		Targets: []string{"player_id", "key_hash", "name", "last_seen_at"},
+		Params: DBPlayerRow{
+			PlayerID:   player.GetPlayerID().String(),
+			KeyHash:    hashPlayerKey(player.GetPlayerKey()),
+			Name:       player.GetName(),
+			LastSeenAt: time.Now().UnixMilli(),
+		},
+		Conds:    "",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (pr *PlayerRepository) FetchByPlayerKey(key string) (*model.Player, error) {
+	dbPlayer := DBPlayerRow{}
+	if err := pr.db.QueryRow("History", "SELECT * FROM Player WHERE key_hash = ?", hashPlayerKey(key)).StructScan(&dbPlayer); err != nil {
+		return nil, err
+	}
+	return model.ReconstructPlayer(dbPlayer.PlayerID, key, dbPlayer.Name)
+}
+
+// 鍵は復元できないので空になる
+func (pr *PlayerRepository) FetchByPlayerID(pid uuid.UUID) (*model.Player, error) {
+	dbPlayer := DBPlayerRow{}
+	if err := pr.db.QueryRow("History", "SELECT * FROM Player WHERE player_id = ?", pid.String()).StructScan(&dbPlayer); err != nil {
+		return nil, err
+	}
+	return model.ReconstructPlayer(dbPlayer.PlayerID, "", dbPlayer.Name)
+}
+
+// このゲームの参加者がどのプレイヤーかを記録する
+func (pr *PlayerRepository) Link(uid uuid.UUID, pid uuid.UUID) error {
+	resultCh := make(chan error, 1)
+	pr.db.Command("UserAttribute", WriteRequest{
+		Table:   "UserPlayer",
+		Method:  Upsert,
+		Targets: []string{"user_id", "player_id"},
+		Params: DBUserPlayerRow{
+			UserID:   uid.String(),
+			PlayerID: pid.String(),
+		},
+		Conds:    "",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
+// このゲームの参加者に既に紐付いているか
+func (pr *PlayerRepository) IsLinked(pid uuid.UUID) (bool, error) {
+	var count int
+	if err := pr.db.QueryRow("UserAttribute", "SELECT COUNT(*) FROM UserPlayer WHERE player_id = ?", pid.String()).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// 参加者のIDからプレイヤーのIDへの対応、紐付いていない参加者は含まない
+func (pr *PlayerRepository) FetchPlayerIDs(uids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	playerIDs := make(map[uuid.UUID]uuid.UUID, len(uids))
+	if len(uids) == 0 {
+		return playerIDs, nil
+	}
+	strUIDs := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		strUIDs = append(strUIDs, uid.String())
+	}
+	rows, err := pr.db.QueryIn("UserAttribute", "SELECT * FROM UserPlayer WHERE user_id IN (?)", strUIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		dbUserPlayer := DBUserPlayerRow{}
+		if err := rows.StructScan(&dbUserPlayer); err != nil {
+			return nil, err
+		}
+		uid, err := uuid.Parse(dbUserPlayer.UserID)
+		if err != nil {
+			return nil, err
+		}
+		pid, err := uuid.Parse(dbUserPlayer.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		playerIDs[uid] = pid
+	}
+	return playerIDs, nil
+}
+
+func NewPlayerRepository(db IDatabase) *PlayerRepository {
+	return &PlayerRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,104 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+)
+
+// 次のゲームで端末に残した鍵を送ってもらえば、同じプレイヤーとして名前が引き継がれる
+func TestPlayerRepositoryFetchByPlayerKey(t *testing.T) {
+	pr := repository.NewPlayerRepository(newTestDB(t))
+	player, err := model.NewPlayer("Alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pr.Save(player); err != nil {
+		t.Fatal(err)
+	}
+	player.SetName("Alice B.")
+	if err := pr.Save(player); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := pr.FetchByPlayerKey(player.GetPlayerKey())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.GetPlayerID() != player.GetPlayerID() || found.GetName() != "Alice B." {
+		t.Errorf("FetchByPlayerKey() = (%s, %q), want (%s, %q)", found.GetPlayerID(), found.GetName(), player.GetPlayerID(), "Alice B.")
+	}
+	if _, err := pr.FetchByPlayerKey("unknown-key"); err == nil {
+		t.Error("FetchByPlayerKey() with an unknown key error = nil, want error")
+	}
+}
+
+// 鍵はハッシュ値だけを保存するので、IDから引いたプレイヤーの鍵は空になる
+func TestPlayerRepositoryDoesNotStoreKey(t *testing.T) {
+	db := newTestDB(t)
+	pr := repository.NewPlayerRepository(db)
+	player, err := model.NewPlayer("Bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pr.Save(player); err != nil {
+		t.Fatal(err)
+	}
+	var stored int
+	if err := db.QueryRow("History", "SELECT COUNT(*) FROM Player WHERE key_hash = ?", player.GetPlayerKey()).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored != 0 {
+		t.Error("player key is stored as it is")
+	}
+	found, err := pr.FetchByPlayerID(player.GetPlayerID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.GetPlayerKey() != "" || found.GetName() != "Bob" {
+		t.Errorf("FetchByPlayerID() = (%q, %q), want an empty key and Bob", found.GetPlayerKey(), found.GetName())
+	}
+}
+
+func TestPlayerRepositoryFetchPlayerIDs(t *testing.T) {
+	pr := repository.NewPlayerRepository(newTestDB(t))
+	linked, guest := uuid.New(), uuid.New()
+	pid := uuid.New()
+	if err := pr.Link(linked, pid); err != nil {
+		t.Fatal(err)
+	}
+
+	playerIDs, err := pr.FetchPlayerIDs([]uuid.UUID{linked, guest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(playerIDs) != 1 || playerIDs[linked] != pid {
+		t.Errorf("FetchPlayerIDs() = %v, want only %s -> %s", playerIDs, linked, pid)
+	}
+	if empty, err := pr.FetchPlayerIDs(nil); err != nil || len(empty) != 0 {
+		t.Errorf("FetchPlayerIDs(nil) = (%v, %v), want an empty map", empty, err)
+	}
+}
+
+func TestPlayerRepositoryIsLinked(t *testing.T) {
+	pr := repository.NewPlayerRepository(newTestDB(t))
+	player, err := model.NewPlayer("P")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pr.Save(player); err != nil {
+		t.Fatal(err)
+	}
+	if linked, err := pr.IsLinked(player.GetPlayerID()); err != nil || linked {
+		t.Fatalf("IsLinked() before Link = %v, %v, want false", linked, err)
+	}
+	if err := pr.Link(uuid.New(), player.GetPlayerID()); err != nil {
+		t.Fatal(err)
+	}
+	if linked, err := pr.IsLinked(player.GetPlayerID()); err != nil || !linked {
+		t.Errorf("IsLinked() after Link = %v, %v, want true", linked, err)
+	}
+}
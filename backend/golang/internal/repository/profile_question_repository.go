@@ -1,16 +1,50 @@
 package repository
 
-import "github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// sample_answerカラムには複数のサンプル回答をこの区切り文字で連結して保存する
+const SampleAnswerSeparator string = "|"
+
+func splitSampleAnswers(sampleAnswer string) []string {
+	answers := make([]string, 0, strings.Count(sampleAnswer, SampleAnswerSeparator)+1)
+	for answer := range strings.SplitSeq(sampleAnswer, SampleAnswerSeparator) {
+		if answer = strings.TrimSpace(answer); answer != "" {
+			answers = append(answers, answer)
+		}
+	}
+	return answers
+}
+
+// 区切り文字を含む回答は読み出す時に分かれてしまうので保存しない
+func joinSampleAnswers(answers []string) (string, error) {
+	for _, answer := range answers {
+		if strings.Contains(answer, SampleAnswerSeparator) {
+			return "", errors.New("Sample answers must not contain \"" + SampleAnswerSeparator + "\"")
+		}
+	}
+	return strings.Join(answers, SampleAnswerSeparator), nil
+}
 
 type DBQuestionRow struct {
 	QuestionID   int    `db:"question_id"`
 	QuestionText string `db:"question_text"`
 	QuizText     string `db:"quiz_text"`
 	SampleAnswer string `db:"sample_answer"`
+	SortOrder    int    `db:"sort_order"`
+	IsOptional   bool   `db:"is_optional"`
 }
 
 type ProfileQuestionRepository struct {
 	db IDatabase
+	// IDや並び順を読んでから書き込むまでの間に、他の書き込みが割り込まないようにする
+	// MasterのDBはこのプロセスが排他的に開いているので、ここで順番に処理すれば重ならない
+	mu sync.Mutex
 }
 
 func (pqr *ProfileQuestionRepository) FetchByQuestionID(questionID uint) (*model.ProfileQuestion, error) {
@@ -24,7 +58,8 @@ func (pqr *ProfileQuestionRepository) FetchByQuestionID(questionID uint) (*model
 		uint(dbQuestion.QuestionID),
 		dbQuestion.QuestionText,
 		dbQuestion.QuizText,
-		dbQuestion.SampleAnswer,
+		splitSampleAnswers(dbQuestion.SampleAnswer),
+		dbQuestion.IsOptional,
 	)
 }
 
@@ -34,7 +69,7 @@ func (pqr *ProfileQuestionRepository) FetchAllQuestions() ([]model.ProfileQuesti
 		return nil, err
 	}
 	questions := make([]model.ProfileQuestion, 0, n)
-	rows, err := pqr.db.Query("Master", "SELECT * FROM ProfileQuestion")
+	rows, err := pqr.db.Query("Master", "SELECT * FROM ProfileQuestion ORDER BY sort_order, question_id")
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +82,8 @@ func (pqr *ProfileQuestionRepository) FetchAllQuestions() ([]model.ProfileQuesti
 			uint(dbQuestion.QuestionID),
 			dbQuestion.QuestionText,
 			dbQuestion.QuizText,
-			dbQuestion.SampleAnswer,
+			splitSampleAnswers(dbQuestion.SampleAnswer),
+			dbQuestion.IsOptional,
 		)
 		if err != nil {
 			return nil, err
@@ -58,6 +94,150 @@ func (pqr *ProfileQuestionRepository) FetchAllQuestions() ([]model.ProfileQuesti
 	return questions, nil
 }
 
+func (pqr *ProfileQuestionRepository) Create(question *model.ProfileQuestion) (uint, error) {
+	sampleAnswer, err := joinSampleAnswers(question.GetSampleAnswers())
+	if err != nil {
+		return 0, err
+	}
+	pqr.mu.Lock()
+	defer pqr.mu.Unlock()
+	var maxID, maxOrder int
+	if err := pqr.db.QueryRow("Master", "SELECT COALESCE(MAX(question_id), 0), COALESCE(MAX(sort_order), 0) FROM ProfileQuestion").Scan(&maxID, &maxOrder); err != nil {
+		return 0, err
+	}
+	resultCh := make(chan error, 1)
+	pqr.db.Command("Master", WriteRequest{
+		Table:   "ProfileQuestion",
+		Method:  Insert,
+		Targets: []string{"question_id", "question_text", "quiz_text", "sample_answer", "sort_order", "is_optional"},
+		Params: DBQuestionRow{
+			QuestionID:   maxID + 1,
+			QuestionText: question.GetQuestionText(),
+			QuizText:     question.GetQuizText(),
+			SampleAnswer: sampleAnswer,
+			SortOrder:    maxOrder + 1,
+			IsOptional:   question.IsOptional(),
+		},
+		Conds:    "",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return 0, err
+	}
+	return uint(maxID + 1), nil
+}
+
+func (pqr *ProfileQuestionRepository) Update(question *model.ProfileQuestion) error {
+	sampleAnswer, err := joinSampleAnswers(question.GetSampleAnswers())
+	if err != nil {
+		return err
+	}
+	resultCh := make(chan error, 1)
+	pqr.db.Command("Master", WriteRequest{
+		Table:   "ProfileQuestion",
+		Method:  Update,
+		Targets: []string{"question_text", "quiz_text", "sample_answer", "is_optional"},
+		Params: DBQuestionRow{
+			QuestionID:   int(question.GetQuestionID()),
+			QuestionText: question.GetQuestionText(),
+			QuizText:     question.GetQuizText(),
+			SampleAnswer: sampleAnswer,
+			IsOptional:   question.IsOptional(),
+		},
+		Conds:    "question_id = :question_id",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (pqr *ProfileQuestionRepository) Delete(questionID uint) error {
+	resultCh := make(chan error, 1)
+	pqr.db.Command("Master", WriteRequest{
+		Table:   "ProfileQuestion",
+		Method:  Delete,
+		Targets: []string{"question_id"},
+		Params: DBQuestionRow{
+			QuestionID: int(questionID),
+		},
+		Conds:    "question_id = :question_id",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
+// questionIDsの並び順をそのまま出題順として保存する、途中で失敗した場合は元の並び順のまま
+func (pqr *ProfileQuestionRepository) Reorder(questionIDs []uint) error {
+	pqr.mu.Lock()
+	defer pqr.mu.Unlock()
+	requests := make([]WriteRequest, 0, len(questionIDs))
+	for i, qid := range questionIDs {
+		requests = append(requests, WriteRequest{
+			Table:   "ProfileQuestion",
+			Method:  Update,
+			Targets: []string{"sort_order"},
+			Params: DBQuestionRow{
+				QuestionID: int(qid),
+				SortOrder:  i + 1,
+			},
+			Conds: "question_id = :question_id",
+		})
+	}
+	resultCh := make(chan error, 1)
+	pqr.db.Command("Master", WriteRequest{
+		Method:   Transaction,
+		Requests: requests,
+		ResultCh: resultCh,
+	})
+	return <-resultCh
+}
+
+// 質問を全て削除してquestionsに置き換える、questionsの並び順をそのまま出題順とする
+// 途中で失敗した場合は元の質問のまま残す
+func (pqr *ProfileQuestionRepository) ReplaceAll(questions []model.ProfileQuestion) error {
+	pqr.mu.Lock()
+	defer pqr.mu.Unlock()
+	requests := make([]WriteRequest, 0, len(questions)+1)
+	requests = append(requests, WriteRequest{
+		Table:   "ProfileQuestion",
+		Method:  Delete,
+		Targets: []string{},
+		Params:  DBQuestionRow{},
+		Conds:   "1 = 1",
+	})
+	for i, question := range questions {
+		sampleAnswer, err := joinSampleAnswers(question.GetSampleAnswers())
+		if err != nil {
+			return err
+		}
+		requests = append(requests, WriteRequest{
+			Table:   "ProfileQuestion",
+			Method:  Insert,
+			Targets: []string{"question_id", "question_text", "quiz_text", "sample_answer", "sort_order", "is_optional"},
+			Params: DBQuestionRow{
+				QuestionID:   int(question.GetQuestionID()),
+				QuestionText: question.GetQuestionText(),
+				QuizText:     question.GetQuizText(),
+				SampleAnswer: sampleAnswer,
+				SortOrder:    i + 1,
+				IsOptional:   question.IsOptional(),
+			},
+		})
+	}
+	resultCh := make(chan error, 1)
+	pqr.db.Command("Master", WriteRequest{
+		Method:   Transaction,
+		Requests: requests,
+		ResultCh: resultCh,
+	})
+	return <-resultCh
+}
+
 func NewProfileQuestionRepository(db IDatabase) *ProfileQuestionRepository {
 	return &ProfileQuestionRepository{
 		db: db,
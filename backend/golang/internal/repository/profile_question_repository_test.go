@@ -0,0 +1,114 @@
+package repository_test
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/infra"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+)
+
+func newTestDB(t *testing.T) *infra.SQLiteDB {
+	t.Helper()
+	db, err := infra.NewSQLiteDB(t.TempDir(), t.TempDir(), fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+	return db
+}
+
+func newQuestion(t *testing.T, text string, sampleAnswers ...string) *model.ProfileQuestion {
+	t.Helper()
+	q, err := model.NewProfileQuestion(0, text, text+"?", sampleAnswers, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+func TestProfileQuestionRepositoryCreateConcurrently(t *testing.T) {
+	pqr := repository.NewProfileQuestionRepository(newTestDB(t))
+	const n = 20
+	ids := make([]uint, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Go(func() {
+			ids[i], errs[i] = pqr.Create(newQuestion(t, fmt.Sprintf("Q%d", i)))
+		})
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("Create(Q%d) error = %v", i, err)
+		}
+	}
+	slices.Sort(ids)
+	if len(slices.Compact(ids)) != n {
+		t.Errorf("Create returned duplicate IDs: %v", ids)
+	}
+	questions, err := pqr.FetchAllQuestions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(questions) != n {
+		t.Errorf("len(questions) = %d, want %d", len(questions), n)
+	}
+}
+
+func TestProfileQuestionRepositoryReorder(t *testing.T) {
+	pqr := repository.NewProfileQuestionRepository(newTestDB(t))
+	ids := make([]uint, 0, 3)
+	for _, text := range []string{"A", "B", "C"} {
+		id, err := pqr.Create(newQuestion(t, text))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	order := []uint{ids[2], ids[0], ids[1]}
+	if err := pqr.Reorder(order); err != nil {
+		t.Fatal(err)
+	}
+	questions, err := pqr.FetchAllQuestions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]uint, 0, len(questions))
+	for _, q := range questions {
+		got = append(got, q.GetQuestionID())
+	}
+	if !slices.Equal(got, order) {
+		t.Errorf("order = %v, want %v", got, order)
+	}
+}
+
+func TestProfileQuestionRepositoryRejectsSeparator(t *testing.T) {
+	pqr := repository.NewProfileQuestionRepository(newTestDB(t))
+	if _, err := pqr.Create(newQuestion(t, "Q", "a|b")); err == nil {
+		t.Error("Create with a separator in a sample answer succeeded, want error")
+	}
+	id, err := pqr.Create(newQuestion(t, "Q", "a", "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := model.NewProfileQuestion(id, "Q", "Q?", []string{"c|d"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pqr.Update(q); err == nil {
+		t.Error("Update with a separator in a sample answer succeeded, want error")
+	}
+	stored, err := pqr.FetchByQuestionID(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(stored.GetSampleAnswers(), []string{"a", "b"}) {
+		t.Errorf("sample answers = %v, want [a b]", stored.GetSampleAnswers())
+	}
+}
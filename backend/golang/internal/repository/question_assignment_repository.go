@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type DBAssignmentRow struct {
+	UserID     string `db:"user_id"`
+	QuestionID int    `db:"question_id"`
+	SortOrder  int    `db:"sort_order"`
+	IsSkipped  bool   `db:"is_skipped"`
+}
+
+type QuestionAssignmentRepository struct {
+	db IDatabase
+}
+
+// questionIDsの並び順をそのまま回答順として保存する
+// 1つのトランザクションで保存するので、同時に呼ばれても後から来た方は主キーの重複で丸ごと失敗する
+func (qar *QuestionAssignmentRepository) SaveAll(uid uuid.UUID, questionIDs []uint) error {
+	requests := make([]WriteRequest, 0, len(questionIDs))
+	for i, qid := range questionIDs {
+		requests = append(requests, WriteRequest{
+			Table:   "QuestionAssignment",
+			Method:  Insert,
+			Targets: []string{"user_id", "question_id", "sort_order", "is_skipped"},
+			Params: DBAssignmentRow{
+				UserID:     uid.String(),
+				QuestionID: int(qid),
+				SortOrder:  i + 1,
+				IsSkipped:  false,
+			},
+			Conds: "",
+		})
+	}
+	resultCh := make(chan error, 1)
+	qar.db.Command("UserAttribute", WriteRequest{
+		Method:   Transaction,
+		Requests: requests,
+		ResultCh: resultCh,
+	})
+	return <-resultCh
+}
+
+// 回答順に並べて返す
+func (qar *QuestionAssignmentRepository) FetchByUserID(uid uuid.UUID) ([]model.QuestionAssignment, error) {
+	rows, err := qar.db.Query("UserAttribute", "SELECT * FROM QuestionAssignment WHERE user_id = ? ORDER BY sort_order", uid.String())
+	if err != nil {
+		return nil, err
+	}
+	assignments := make([]model.QuestionAssignment, 0)
+	for rows.Next() {
+		dbAssignment := DBAssignmentRow{}
+		if err := rows.StructScan(&dbAssignment); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, *model.NewQuestionAssignment(uid, uint(dbAssignment.QuestionID), dbAssignment.IsSkipped))
+	}
+
+	return assignments, nil
+}
+
+func (qar *QuestionAssignmentRepository) SetSkipped(uid uuid.UUID, questionID uint, isSkipped bool) error {
+	resultCh := make(chan error, 1)
+	qar.db.Command("UserAttribute", WriteRequest{
+		Table:   "QuestionAssignment",
+		Method:  Update,
+		Targets: []string{"is_skipped"},
+		Params: DBAssignmentRow{
+			UserID:     uid.String(),
+			QuestionID: int(questionID),
+			IsSkipped:  isSkipped,
+		},
+		Conds:    "user_id = :user_id AND question_id = :question_id",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewQuestionAssignmentRepository(db IDatabase) *QuestionAssignmentRepository {
+	return &QuestionAssignmentRepository{
+		db: db,
+	}
+}
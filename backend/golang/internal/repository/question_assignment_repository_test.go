@@ -0,0 +1,42 @@
+package repository_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
+)
+
+// 同時に割り当てても、どちらか一方の割り当てだけがそのまま残ること
+func TestQuestionAssignmentRepositorySaveAllConcurrently(t *testing.T) {
+	qar := repository.NewQuestionAssignmentRepository(newTestDB(t))
+	uid := uuid.New()
+	orders := [][]uint{{1, 2, 3, 4, 5}, {5, 4, 3, 2, 1}, {3, 1, 4, 5, 2}}
+	errs := make([]error, len(orders))
+	var wg sync.WaitGroup
+	for i, order := range orders {
+		wg.Go(func() {
+			errs[i] = qar.SaveAll(uid, order)
+		})
+	}
+	wg.Wait()
+	succeeded := slices.IndexFunc(errs, func(err error) bool { return err == nil })
+	if succeeded < 0 {
+		t.Fatalf("SaveAll failed for every call: %v", errs)
+	}
+
+	assignments, err := qar.FetchByUserID(uid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]uint, 0, len(assignments))
+	for _, a := range assignments {
+		got = append(got, a.GetQuestionID())
+	}
+	if !slices.ContainsFunc(orders, func(order []uint) bool { return slices.Equal(order, got) }) {
+		t.Errorf("assignments = %v, want one of %v", got, orders)
+	}
+}
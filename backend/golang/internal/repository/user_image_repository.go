@@ -6,11 +6,12 @@ type UserImageRepository struct {
 	db IDatabase
 }
 
+// 撮り直した場合は画像IDを置き換える
 func (uir *UserImageRepository) Save(uid uuid.UUID, imageID string) error {
 	resultCh := make(chan error, 1)
 	uir.db.Command("UserAttribute", WriteRequest{
 		Table:   "UserImage",
-		Method:  Insert,
+		Method:  Upsert,
 		Targets: []string{"user_id", "image_id"},
 		Params: map[string]any{
 			"user_id":  uid.String(),
@@ -25,6 +26,24 @@ func (uir *UserImageRepository) Save(uid uuid.UUID, imageID string) error {
 	return nil
 }
 
+func (uir *UserImageRepository) Delete(uid uuid.UUID) error {
+	resultCh := make(chan error, 1)
+	uir.db.Command("UserAttribute", WriteRequest{
+		Table:   "UserImage",
+		Method:  Delete,
+		Targets: []string{"user_id"},
+		Params: map[string]any{
+			"user_id": uid.String(),
+		},
+		Conds:    "user_id = :user_id",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
 func (uir *UserImageRepository) FetchByUserID(uid uuid.UUID) (string, error) {
 	var imageID string
 	if err := uir.db.QueryRow("UserAttribute", "SELECT image_id FROM UserImage WHERE user_id = ?", uid.String()).Scan(&imageID); err != nil {
@@ -33,6 +52,14 @@ func (uir *UserImageRepository) FetchByUserID(uid uuid.UUID) (string, error) {
 	return imageID, nil
 }
 
+func (uir *UserImageRepository) FetchUserIDByImageID(imageID string) (uuid.UUID, error) {
+	var uid string
+	if err := uir.db.QueryRow("UserAttribute", "SELECT user_id FROM UserImage WHERE image_id = ?", imageID).Scan(&uid); err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(uid)
+}
+
 func NewUserImageRepository(db IDatabase) *UserImageRepository {
 	return &UserImageRepository{
 		db: db,
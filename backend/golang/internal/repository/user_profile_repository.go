@@ -12,6 +12,7 @@ type DBProfileRow struct {
 	UserID    string `db:"user_id"`
 	ProfileID int    `db:"profile_id"`
 	Answer    string `db:"answer"`
+	IsFlagged bool   `db:"is_flagged"`
 }
 
 var profileDBColumns map[string]string = getDBColums(DBProfileRow{})
@@ -20,16 +21,18 @@ type UserProfileRepository struct {
 	db IDatabase
 }
 
+// 同じ質問への回答が既にあれば上書きする
 func (upr *UserProfileRepository) Save(profile *model.UserProfile) error {
 	resultCh := make(chan error, 1)
 	upr.db.Command("UserAttribute", WriteRequest{
 		Table:   "UserProfile",
-		Method:  Insert,
+		Method:  Upsert,
 		Targets: slices.Collect(maps.Values(profileDBColumns)),
 		Params: DBProfileRow{
 			UserID:    profile.GetUserID().String(),
 			ProfileID: int(profile.GetProfileID()),
 			Answer:    profile.GetAnswer(),
+			IsFlagged: profile.IsFlagged(),
 		},
 		Conds:    "",
 		ResultCh: resultCh,
@@ -57,7 +60,39 @@ func (upr *UserProfileRepository) FetchByProfileIDWithUserGroup(pid uint, users
 		}
 		uid, err := uuid.Parse(dbProfile.UserID)
 		if err != nil {
-			return  nil, err
+			return nil, err
+		}
+		profile, err := model.NewUserProfile(
+			uid,
+			uint(dbProfile.ProfileID),
+			dbProfile.Answer,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if dbProfile.IsFlagged {
+			profile.Flag()
+		}
+		profiles = append(profiles, *profile)
+	}
+
+	return profiles, nil
+}
+
+func (upr *UserProfileRepository) FetchByProfileID(pid uint) ([]model.UserProfile, error) {
+	rows, err := upr.db.Query("UserAttribute", "SELECT * FROM UserProfile WHERE profile_id = ?", int(pid))
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]model.UserProfile, 0)
+	for rows.Next() {
+		dbProfile := DBProfileRow{}
+		if err := rows.StructScan(&dbProfile); err != nil {
+			return nil, err
+		}
+		uid, err := uuid.Parse(dbProfile.UserID)
+		if err != nil {
+			return nil, err
 		}
 		profile, err := model.NewUserProfile(
 			uid,
@@ -67,12 +102,92 @@ func (upr *UserProfileRepository) FetchByProfileIDWithUserGroup(pid uint, users
 		if err != nil {
 			return nil, err
 		}
+		if dbProfile.IsFlagged {
+			profile.Flag()
+		}
 		profiles = append(profiles, *profile)
 	}
 
 	return profiles, nil
 }
 
+func (upr *UserProfileRepository) FetchByUserID(uid uuid.UUID) ([]model.UserProfile, error) {
+	rows, err := upr.db.Query("UserAttribute", "SELECT * FROM UserProfile WHERE user_id = ? ORDER BY profile_id", uid.String())
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]model.UserProfile, 0)
+	for rows.Next() {
+		dbProfile := DBProfileRow{}
+		if err := rows.StructScan(&dbProfile); err != nil {
+			return nil, err
+		}
+		profile, err := model.NewUserProfile(
+			uid,
+			uint(dbProfile.ProfileID),
+			dbProfile.Answer,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if dbProfile.IsFlagged {
+			profile.Flag()
+		}
+		profiles = append(profiles, *profile)
+	}
+
+	return profiles, nil
+}
+
+func (upr *UserProfileRepository) FetchFlagged() ([]model.UserProfile, error) {
+	rows, err := upr.db.Query("UserAttribute", "SELECT * FROM UserProfile WHERE is_flagged = ? ORDER BY user_id, profile_id", true)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]model.UserProfile, 0)
+	for rows.Next() {
+		dbProfile := DBProfileRow{}
+		if err := rows.StructScan(&dbProfile); err != nil {
+			return nil, err
+		}
+		uid, err := uuid.Parse(dbProfile.UserID)
+		if err != nil {
+			return nil, err
+		}
+		profile, err := model.NewUserProfile(
+			uid,
+			uint(dbProfile.ProfileID),
+			dbProfile.Answer,
+		)
+		if err != nil {
+			return nil, err
+		}
+		profile.Flag()
+		profiles = append(profiles, *profile)
+	}
+
+	return profiles, nil
+}
+
+func (upr *UserProfileRepository) Delete(uid uuid.UUID, pid uint) error {
+	resultCh := make(chan error, 1)
+	upr.db.Command("UserAttribute", WriteRequest{
+		Table:   "UserProfile",
+		Method:  Delete,
+		Targets: []string{"user_id", "profile_id"},
+		Params: DBProfileRow{
+			UserID:    uid.String(),
+			ProfileID: int(pid),
+		},
+		Conds:    "user_id = :user_id AND profile_id = :profile_id",
+		ResultCh: resultCh,
+	})
+	if err := <-resultCh; err != nil {
+		return err
+	}
+	return nil
+}
+
 func NewUserProfileRepository(db IDatabase) *UserProfileRepository {
 	return &UserProfileRepository{
 		db: db,
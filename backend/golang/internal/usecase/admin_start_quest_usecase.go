@@ -4,21 +4,21 @@ import (
 	"context"
 	"maps"
 	"slices"
+	"strings"
 	"time"
 
-	"github.com/google/uuid"
-
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
 )
 
 type AdminStartQuestUsecase struct {
-	gm  *core.GameManager
-	ur  IUserRepository
-	uir IUserImageRepository
-	upr IUserProfileRepository
-	pqr IProfileQuestionRepository
+	gm     *core.GameManager
+	ur     IUserRepository
+	uir    IUserImageRepository
+	upr    IUserProfileRepository
+	pqr    IProfileQuestionRepository
+	signer *ImageURLSigner
 }
 
 func (asqu *AdminStartQuestUsecase) Execute(
@@ -34,6 +34,8 @@ func (asqu *AdminStartQuestUsecase) Execute(
 	if err != nil {
 		return failedCallback(err)
 	}
+	rules := asqu.gm.GetRules()
+	cg := core.NewChoiceGenerator(rules.MaxChoiceNum)
 	teams := asqu.gm.GetTeams()
 	teamIDs := slices.Collect(maps.Keys(teams))
 	shuffledTIDs := util.ShuffleSlice(teamIDs)
@@ -54,69 +56,70 @@ checkConnectionLoop:
 	// quizLoopに入るまで止めておく
 	ticker.Stop()
 	defer ticker.Stop()
+	questionMap := make(map[uint]model.ProfileQuestion, len(questions))
+	for _, question := range questions {
+		questionMap[question.GetQuestionID()] = question
+	}
+	// 同じ質問ばかり出題されないように、使った回数の少ない質問を優先する
+	usedCount := make(map[uint]int, len(questions))
 	for _, tid := range shuffledTIDs {
 		teamUsers := teams[tid]
 		shuffledUsers := util.ShuffleSlice(teamUsers)
-		shuffledQuestions := util.ShuffleSlice(questions)
-		if len(shuffledUsers) > len(shuffledQuestions) {
-			for {
-				shuffledQuestions = append(shuffledQuestions, util.ShuffleSlice(questions)...)
-				if len(shuffledQuestions) >= len(shuffledUsers) {
-					break
-				}
-			}
-		}
-		shuffledQuestions = shuffledQuestions[:len(shuffledUsers)]
-		for i, uid := range shuffledUsers {
-			imageID, err := asqu.uir.FetchByUserID(uid)
-			if err != nil {
-				imageID = "NotFoundImage"
-			}
-			question := shuffledQuestions[i]
-			correctProfile, err := asqu.upr.FetchByProfileIDWithUserGroup(question.GetQuestionID(), []uuid.UUID{uid})
+		for _, uid := range shuffledUsers {
+			// 写真が無い参加者も出題できるように、代わりの画像を使う
+			imageID := userImageID(asqu.uir, uid, uint32(tid))
+			imageURL := asqu.signer.SignedURL(imageID, core.ImageLarge)
+			// 参加者毎に割り当てられた質問が違ったりスキップされたりするので、本人が回答した質問から選ぶ
+			userProfiles, err := asqu.upr.FetchByUserID(uid)
 			if err != nil {
-				// 正答が取得できないとクイズにならないので仕方なくスキップ
 				continue
 			}
-			profileCandidates, err := asqu.upr.FetchByProfileIDWithUserGroup(question.GetQuestionID(), shuffledUsers)
-			if err != nil {
-				// とりあえず正答に適当な選択肢を足して２択に
-				profileCandidates = correctProfile
-				profile, _ := model.NewUserProfile(uid, (&correctProfile[0]).GetProfileID(), question.GetSampleAnswer())
-				profileCandidates = append(profileCandidates, *profile)
+			candidates := make([]model.UserProfile, 0, len(userProfiles))
+			for _, profile := range util.ShuffleSlice(userProfiles) {
+				if _, ok := questionMap[profile.GetProfileID()]; !ok || strings.TrimSpace(profile.GetAnswer()) == "" || profile.IsFlagged() {
+					continue
+				}
+				candidates = append(candidates, profile)
 			}
-			choiceCandidates := make([]string, 0, len(profileCandidates))
-			for _, profile := range profileCandidates {
-				choiceCandidates = append(choiceCandidates, profile.GetAnswer())
+			if len(candidates) == 0 {
+				// 正答が取得できないとクイズにならないので仕方なくスキップ
+				continue
 			}
-			// Sort -> Compactで重複を削除
-			slices.Sort(choiceCandidates)
-			choiceCandidates = util.ShuffleSlice(slices.Compact(choiceCandidates))
-			if len(choiceCandidates) > core.MaxChoiceNum {
-				choiceCandidates = choiceCandidates[:core.MaxChoiceNum]
-				if !slices.Contains(choiceCandidates, correctProfile[0].GetAnswer()) {
-					choiceCandidates[0] = correctProfile[0].GetAnswer()
-					choiceCandidates = util.ShuffleSlice(choiceCandidates)
+			correctProfile := slices.MinFunc(candidates, func(a, b model.UserProfile) int {
+				return usedCount[a.GetProfileID()] - usedCount[b.GetProfileID()]
+			})
+			question := questionMap[correctProfile.GetProfileID()]
+			usedCount[question.GetQuestionID()]++
+			// 取得できなくても残りはサンプル回答で埋まるので、エラーは無視する
+			allProfiles, _ := asqu.upr.FetchByProfileID(question.GetQuestionID())
+			teammateAnswers := make([]string, 0, len(teamUsers))
+			otherTeamAnswers := make([]string, 0, len(allProfiles))
+			for _, profile := range allProfiles {
+				switch {
+				case profile.GetUserID() == uid, profile.IsFlagged():
+					continue
+				case slices.Contains(teamUsers, profile.GetUserID()):
+					teammateAnswers = append(teammateAnswers, profile.GetAnswer())
+				default:
+					otherTeamAnswers = append(otherTeamAnswers, profile.GetAnswer())
 				}
 			}
+			// チームメイトの回答 -> 他チームの回答 -> サンプル回答の優先度で選択肢を埋める
+			choices, correctChoice := cg.Generate(
+				correctProfile.GetAnswer(),
+				teammateAnswers,
+				otherTeamAnswers,
+				question.GetSampleAnswers(),
+			)
 			quiz := core.Quiz{
 				ImageID:      imageID,
+				ImageURL:     imageURL,
 				TeamID:       core.TeamID(tid),
 				QuestionID:   question.GetQuestionID(),
 				QuestionText: question.GetQuizText(),
-				Choices:      make([]core.Choice, len(choiceCandidates)),
-			}
-			var correctChoiceID uint
-			for i, choice := range choiceCandidates {
-				quiz.Choices[i] = core.Choice{
-					ChoiceID:   uint(i + 1),
-					ChoiceText: choice,
-				}
-				if choice == correctProfile[0].GetAnswer() {
-					correctChoiceID = uint(i + 1)
-				}
+				Choices:      choices,
 			}
-			var remaindTime int = core.InitialRemaindTime
+			var remaindTime int = rules.CountdownSeconds
 			var onTickFailedCount int = 0
 			var hint string = ""
 			var canCountdown bool = false
@@ -130,16 +133,13 @@ checkConnectionLoop:
 					return failedCallback(networkCtx.Err())
 				case hint = <-asqu.gm.CheckHint():
 					if remaindTime > 0 {
-						remaindTime += core.IncreaseTimeHintTaken
+						remaindTime += rules.HintBonusSeconds
 					}
 				case <-startCount:
 					canCountdown = true
 				case <-ticker.C:
 					quiz.RemainedTime = remaindTime
-					_ = asqu.gm.Broadcast(uid, quiz, core.Choice{
-						ChoiceID:   correctChoiceID,
-						ChoiceText: correctProfile[0].GetAnswer(),
-					})
+					_ = asqu.gm.Broadcast(uid, quiz, correctChoice)
 					if err := onTick(quiz, hint); err != nil {
 						onTickFailedCount++
 						if onTickFailedCount > MaxFailedCount {
@@ -166,12 +166,14 @@ func NewAdminStartQuestUsecase(
 	uir IUserImageRepository,
 	upr IUserProfileRepository,
 	pqr IProfileQuestionRepository,
+	signer *ImageURLSigner,
 ) *AdminStartQuestUsecase {
 	return &AdminStartQuestUsecase{
-		gm:  gm,
-		ur:  ur,
-		uir: uir,
-		upr: upr,
-		pqr: pqr,
+		gm:     gm,
+		ur:     ur,
+		uir:    uir,
+		upr:    upr,
+		pqr:    pqr,
+		signer: signer,
 	}
 }
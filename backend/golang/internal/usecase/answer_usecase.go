@@ -14,18 +14,18 @@ type AnswerUsecase struct {
 	gm *core.GameManager
 }
 
-func (au *AnswerUsecase) Execute(user *model.User, answer AnswerDTO) (core.Result, map[uint]int, error) {
+func (au *AnswerUsecase) Execute(user *model.User, answer AnswerDTO) (core.Result, core.AnswerWithMap, error) {
 	teamAnswer, isCorrect, err := au.gm.Answer(user.GetUserID(), core.TeamID(user.GetTeamID()), core.Choice{
 		ChoiceID:   answer.ChoiceID,
 		ChoiceText: answer.ChoiceText,
 	})
 	if err != nil {
-		return core.Result{}, nil, err
+		return core.Result{}, core.AnswerWithMap{}, err
 	}
 	return core.Result{
 		Answer:    teamAnswer.TeamAnswer,
 		IsCorrect: isCorrect,
-	}, teamAnswer.AnswerMap, nil
+	}, teamAnswer, nil
 }
 
 func NewAnswerUsecase(gm *core.GameManager) *AnswerUsecase {
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"errors"
+	"io/fs"
+	"sync"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 写真が無い参加者の代わりの画像を、最初に要求された時に作ってアップロードされた画像と同じ保存先に置く
+// 置いておけば、アップロードされた画像と同じくETagやサイズの指定がそのまま使える
+type AvatarStore struct {
+	is IImageStore
+	ip *core.ImageProcessor
+	mu sync.Mutex
+}
+
+// 画像を保存先から取り出す、代わりの画像がまだ無ければ作ってから取り出す
+func (as *AvatarStore) Open(imageID string, variant core.ImageVariant) (*model.StoredImage, error) {
+	key := imageFileName(imageID, variant)
+	img, err := as.is.Get(key)
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return img, err
+	}
+	// チーム分け前の0か実在するチームの色だけ作る
+	uid, teamID, ok := core.ParseAvatarImageID(imageID)
+	if !ok || teamID > uint32(model.MaxTeamNum) {
+		return nil, err
+	}
+
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	// 待っている間に他のリクエストが作っている場合がある
+	if img, err = as.is.Get(key); err == nil || !errors.Is(err, fs.ErrNotExist) {
+		return img, err
+	}
+	avatar := core.GenerateAvatar(uid, model.TeamColor(teamID).RGBA(), as.ip.OutputSize())
+	variants, err := as.ip.EncodeVariants(avatar)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range core.ImageVariants {
+		if err := as.is.Put(imageFileName(imageID, v), variants[v]); err != nil {
+			return nil, err
+		}
+	}
+	return as.is.Get(key)
+}
+
+func NewAvatarStore(is IImageStore, ip *core.ImageProcessor) *AvatarStore {
+	return &AvatarStore{
+		is: is,
+		ip: ip,
+		mu: sync.Mutex{},
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 最初に要求された時に全サイズを作って保存し、次からは保存したものを返す
+func TestAvatarStoreOpenGeneratesOnce(t *testing.T) {
+	store := storedFiles{}
+	as := NewAvatarStore(store, core.NewImageProcessor(core.DefaultMaxUploadBytes, 64))
+	imageID := core.AvatarImageID(uuid.New(), 2)
+
+	img, err := as.Open(imageID, core.ImageThumbnail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img.Close()
+	if len(store) != len(core.ImageVariants) {
+		t.Fatalf("stored %d files, want one per variant", len(store))
+	}
+	store[imageFileName(imageID, core.ImageLarge)] = []byte("cached")
+	img, err = as.Open(imageID, core.ImageLarge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer img.Close()
+	if img.GetSize() != int64(len("cached")) {
+		t.Error("Open() generated the avatar again instead of using the stored one")
+	}
+}
+
+// 存在しないチームの色や書き方を変えたIDでは、保存先に何も作らない
+func TestAvatarStoreOpenRejectsUnknownAvatars(t *testing.T) {
+	store := storedFiles{}
+	as := NewAvatarStore(store, core.NewImageProcessor(core.DefaultMaxUploadBytes, 64))
+	uid := uuid.New()
+	for _, imageID := range []string{
+		core.AvatarImageID(uid, uint32(model.MaxTeamNum)+1),
+		core.AvatarImageID(uid, 4294967295),
+		"avatar_002_" + uid.String(),
+		"missing",
+	} {
+		if img, err := as.Open(imageID, core.ImageLarge); err == nil {
+			img.Close()
+			t.Errorf("Open(%q) error = nil, want error", imageID)
+		}
+	}
+	if len(store) != 0 {
+		t.Errorf("Open() stored %d files for rejected IDs, want none", len(store))
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+type AwardDTO struct {
+	Kind core.AwardKind
+	// チームの賞の場合はuuid.Nilで、名前は空
+	UserID       uuid.UUID
+	UserName     string
+	TeamID       core.TeamID
+	Count        int
+	CorrectRate  float32
+	ResponseTime time.Duration
+}
+
+// 本人が受賞したか、チームの賞を本人のチームが受賞したか
+func (a AwardDTO) IsFor(uid uuid.UUID, tid core.TeamID) bool {
+	if a.UserID == uuid.Nil {
+		return a.TeamID == tid
+	}
+	return a.UserID == uid
+}
+
+// ゲームの記録から賞を決めて受賞者の名前を付ける
+// 途中で参加を取り消された人は名前が分からないので除く
+func evaluateAwards(gm *core.GameManager, ur IUserRepository) ([]AwardDTO, error) {
+	teams := gm.GetTeams()
+	uids := make([]uuid.UUID, 0)
+	for _, tid := range slices.Sorted(maps.Keys(teams)) {
+		uids = append(uids, teams[tid]...)
+	}
+	names := make(map[uuid.UUID]string, len(uids))
+	if len(uids) > 0 {
+		users, err := ur.FetchByUserIDs(uids)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			names[u.GetUserID()] = u.GetName()
+		}
+	}
+
+	awards := core.EvaluateAwards(gm.GetQuestLog(), gm.GetRules().Awards)
+	dtos := make([]AwardDTO, 0, len(awards))
+	for _, a := range awards {
+		name, ok := names[a.UserID]
+		if a.UserID != uuid.Nil && !ok {
+			continue
+		}
+		dtos = append(dtos, AwardDTO{
+			Kind:         a.Kind,
+			UserID:       a.UserID,
+			UserName:     name,
+			TeamID:       a.TeamID,
+			Count:        a.Count,
+			CorrectRate:  a.CorrectRate,
+			ResponseTime: a.ResponseTime,
+		})
+	}
+	return dtos, nil
+}
@@ -2,13 +2,16 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 )
 
 type ChangeTeamUsecase struct {
+	gm *core.GameManager
 	ur IUserRepository
 }
 
@@ -30,8 +33,8 @@ func (ctu *ChangeTeamUsecase) Execute(userIDStr string, newTeamID uint32) error
 	if err != nil {
 		return err
 	}
-	if len(teammates)-1 < model.MinTeamUser {
-		return errors.New("Cannot change team because a team must have at least 3 users")
+	if minTeamUser := ctu.gm.GetRules().MinTeamUser; len(teammates)-1 < minTeamUser {
+		return fmt.Errorf("Cannot change team because a team must have at least %d users", minTeamUser)
 	}
 
 	user.SetTeamID(newTeamID)
@@ -42,8 +45,9 @@ func (ctu *ChangeTeamUsecase) Execute(userIDStr string, newTeamID uint32) error
 	return nil
 }
 
-func NewChangeTeamUsecase(ur IUserRepository) *ChangeTeamUsecase {
+func NewChangeTeamUsecase(gm *core.GameManager, ur IUserRepository) *ChangeTeamUsecase {
 	return &ChangeTeamUsecase{
+		gm: gm,
 		ur: ur,
 	}
 }
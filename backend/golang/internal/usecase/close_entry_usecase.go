@@ -2,9 +2,9 @@ package usecase
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
-	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 )
 
 type CloseEntryUsecase struct {
@@ -32,9 +32,10 @@ func (ceu *CloseEntryUsecase) Execute() error {
 
 	userTeam := ceu.gm.SplitTeams(userIDs, ceu.teamNum)
 	teams := ceu.gm.GetTeams()
+	minTeamUser := ceu.gm.GetRules().MinTeamUser
 	for _, member := range teams {
-		if len(member) < model.MinTeamUser {
-			return errors.New("a team must have at least 3 users")
+		if len(member) < minTeamUser {
+			return fmt.Errorf("a team must have at least %d users", minTeamUser)
 		}
 	}
 	for i, usr := range users {
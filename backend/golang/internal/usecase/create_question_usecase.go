@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type QuestionDTO struct {
+	QuestionID    uint
+	QuestionText  string
+	QuizText      string
+	SampleAnswers []string
+	IsOptional    bool
+}
+
+func (question QuestionDTO) ToProfileQuestionModel() (*model.ProfileQuestion, error) {
+	return model.NewProfileQuestion(question.QuestionID, question.QuestionText, question.QuizText, question.SampleAnswers, question.IsOptional)
+}
+
+// エントリー開始後に質問が変わると回答済みの内容と噛み合わなくなるので、編集はエントリー開始前に限る
+// 確認してから編集し終わるまでの間にエントリーが始まらないよう、editはGameManagerのロックの中で実行する
+func editQuestions(gm *core.GameManager, edit func() error) error {
+	err := gm.RunBeforeEntry(edit)
+	if errors.Is(err, core.ErrEntryOpened) {
+		return errors.New("Questions cannot be edited after entry has been opened")
+	}
+	return err
+}
+
+type CreateQuestionUsecase struct {
+	gm  *core.GameManager
+	pqr IProfileQuestionRepositoryForAdmin
+}
+
+func (cqu *CreateQuestionUsecase) Execute(question QuestionDTO) (*model.ProfileQuestion, error) {
+	pq, err := question.ToProfileQuestionModel()
+	if err != nil {
+		return nil, err
+	}
+	var qid uint
+	if err = editQuestions(cqu.gm, func() error {
+		var err error
+		qid, err = cqu.pqr.Create(pq)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return cqu.pqr.FetchByQuestionID(qid)
+}
+
+func NewCreateQuestionUsecase(gm *core.GameManager, pqr IProfileQuestionRepositoryForAdmin) *CreateQuestionUsecase {
+	return &CreateQuestionUsecase{
+		gm:  gm,
+		pqr: pqr,
+	}
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+)
+
+// 参加者が自分の画像を消す
+type DeleteImageUsecase struct {
+	is  IImageStore
+	uir IUserImageRepository
+}
+
+func (diu *DeleteImageUsecase) Execute(uid uuid.UUID) error {
+	imageID, err := diu.uir.FetchByUserID(uid)
+	if err != nil {
+		return err
+	}
+	// 先に画像IDとの紐付けを消して、これ以上配信されないようにする
+	if err := diu.uir.Delete(uid); err != nil {
+		return err
+	}
+	return removeImageFiles(diu.is, imageID)
+}
+
+func NewDeleteImageUsecase(is IImageStore, uir IUserImageRepository) *DeleteImageUsecase {
+	return &DeleteImageUsecase{
+		is:  is,
+		uir: uir,
+	}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+type DeleteQuestionUsecase struct {
+	gm  *core.GameManager
+	pqr IProfileQuestionRepositoryForAdmin
+}
+
+func (dqu *DeleteQuestionUsecase) Execute(questionID uint) error {
+	return editQuestions(dqu.gm, func() error {
+		if _, err := dqu.pqr.FetchByQuestionID(questionID); err != nil {
+			return err
+		}
+		questions, err := dqu.pqr.FetchAllQuestions()
+		if err != nil {
+			return err
+		}
+		// 質問が１つも無いとゲームが成り立たないので、最後の１つは消させない
+		if len(questions) <= 1 {
+			return errors.New("At least one question is required")
+		}
+		return dqu.pqr.Delete(questionID)
+	})
+}
+
+func NewDeleteQuestionUsecase(gm *core.GameManager, pqr IProfileQuestionRepositoryForAdmin) *DeleteQuestionUsecase {
+	return &DeleteQuestionUsecase{
+		gm:  gm,
+		pqr: pqr,
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+// クイズの出題が始まると回答を元に選択肢が作られてしまうので、管理者による修正はそれまでに限る
+func checkAnswersModeratable(gm *core.GameManager) error {
+	if gm.GetState() >= core.INGAME {
+		return errors.New("Answers cannot be moderated after the quest has started")
+	}
+	return nil
+}
+
+type EditUserAnswerUsecase struct {
+	gm  *core.GameManager
+	upr IUserProfileRepositoryForAdmin
+}
+
+// 管理者が確認した回答として、禁止語の有無に関わらずフラグを外して保存する
+func (euau *EditUserAnswerUsecase) Execute(userIDStr string, questionID uint, answer string) error {
+	if err := checkAnswersModeratable(euau.gm); err != nil {
+		return err
+	}
+	uid, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(answer) == "" {
+		return errors.New("Answer is required")
+	}
+	profiles, err := euau.upr.FetchByProfileIDWithUserGroup(questionID, []uuid.UUID{uid})
+	if err != nil {
+		return err
+	}
+	if len(profiles) == 0 {
+		return errors.New("The user has not answered this question")
+	}
+	up, err := UserProfileDTO{UserID: uid, ProfileID: questionID, Answer: answer}.ToUserProfileModel()
+	if err != nil {
+		return err
+	}
+	return euau.upr.Save(up)
+}
+
+func NewEditUserAnswerUsecase(gm *core.GameManager, upr IUserProfileRepositoryForAdmin) *EditUserAnswerUsecase {
+	return &EditUserAnswerUsecase{
+		gm:  gm,
+		upr: upr,
+	}
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"log"
+
 	"github.com/google/uuid"
 
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
@@ -23,17 +25,18 @@ type TeamStatsDTO struct {
 type EndQuestUsecase struct {
 	gm                *core.GameManager
 	ur                IUserRepository
-	resultStateMapper func(float32) int32
+	resultStateMapper func(float32, core.ResultThresholds) int32
+	gr                *GameRecorder
 }
 
-func (equ *EndQuestUsecase) Execute() (int32, map[core.TeamID]TeamStatsDTO, error) {
+func (equ *EndQuestUsecase) Execute() (int32, map[core.TeamID]TeamStatsDTO, []AwardDTO, error) {
 	if err := equ.gm.EndQuest(); err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
 	totalRate, usersStats, teamsStats, err := equ.gm.GetAllStats()
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 	teamStats := make(map[core.TeamID]TeamStatsDTO, len(teamsStats))
 	for tid, teamStat := range teamsStats {
@@ -58,13 +61,24 @@ func (equ *EndQuestUsecase) Execute() (int32, map[core.TeamID]TeamStatsDTO, erro
 		}
 	}
 
-	return equ.resultStateMapper(totalRate), teamStats, nil
+	awards, err := evaluateAwards(equ.gm, equ.ur)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	// 履歴に残せなくてもゲームの結果は返したいので、ログに出すだけにする
+	if err := equ.gr.Record(); err != nil {
+		log.Printf("failed to record game history: %v", err)
+	}
+
+	return equ.resultStateMapper(totalRate, equ.gm.GetRules().ResultThresholds), teamStats, awards, nil
 }
 
-func NewEndQuestUsecase(gm *core.GameManager, ur IUserRepository, mapper func(float32) int32) *EndQuestUsecase {
+func NewEndQuestUsecase(gm *core.GameManager, ur IUserRepository, mapper func(float32, core.ResultThresholds) int32, gr *GameRecorder) *EndQuestUsecase {
 	return &EndQuestUsecase{
 		gm:                gm,
 		ur:                ur,
 		resultStateMapper: mapper,
+		gr:                gr,
 	}
 }
@@ -1,6 +1,10 @@
 package usecase
 
 import (
+	"errors"
+	"sync"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
 )
@@ -8,34 +12,103 @@ import (
 type EntryDTO struct {
 	AccessToken  string
 	ReconnectKey string
+	// 次のゲームでも同じプレイヤーとして扱うために、端末に保存してもらう鍵
+	PlayerKey string
 }
 
 type EntryUsecase struct {
-	ur IUserRepository
+	gm     *core.GameManager
+	ur     IUserRepository
 	secret []byte
+	mod    *core.Moderator
+	pr     IPlayerRepository
+	// 同じ鍵で同時に登録されても、1人のプレイヤーを複数の参加者に紐付けないようにする
+	mu sync.Mutex
+}
+
+// 鍵が無いか見つからない場合は新しいプレイヤーとして登録する
+// 同じ鍵で2回登録された場合などに、このゲームの別の参加者に紐付いているプレイヤーも新しくする
+// 履歴では1人のプレイヤーが1つのゲームに1回しか出てこないようにするため
+func (ueu *EntryUsecase) findOrCreatePlayer(name string, playerKey string) (*model.Player, error) {
+	if playerKey != "" {
+		if player, err := ueu.pr.FetchByPlayerKey(playerKey); err == nil {
+			linked, err := ueu.pr.IsLinked(player.GetPlayerID())
+			if err != nil {
+				return nil, err
+			}
+			if !linked {
+				player.SetName(name)
+				return player, nil
+			}
+		}
+	}
+	return model.NewPlayer(name)
 }
 
-func (ueu *EntryUsecase) Execute(name string) (EntryDTO, error) {
+func (ueu *EntryUsecase) Execute(name string, playerKey string) (EntryDTO, error) {
+	// 名前はプロジェクタにそのまま映るので、禁止語を含む場合は登録させない
+	if _, found := ueu.mod.Check(name); found {
+		return EntryDTO{}, errors.New("User name contains a prohibited word")
+	}
 	user, err := model.NewUser(name)
 	if err != nil {
 		return EntryDTO{}, err
 	}
+	// 上限に達した後に登録できても参加できないので、ここで枠を確保できなければ断る
+	if err = ueu.gm.ReserveEntry(user.GetUserID()); err != nil {
+		return EntryDTO{}, err
+	}
+	dto, err := ueu.regist(user, playerKey)
+	if err != nil {
+		ueu.gm.ReleaseEntry(user.GetUserID())
+		return EntryDTO{}, err
+	}
+	return dto, nil
+}
+
+// プレイヤーを探すか作り、紐付けるまでをまとめて行う
+func (ueu *EntryUsecase) linkPlayer(user *model.User, playerKey string) (*model.Player, error) {
+	ueu.mu.Lock()
+	defer ueu.mu.Unlock()
+	player, err := ueu.findOrCreatePlayer(user.GetName(), playerKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = ueu.pr.Save(player); err != nil {
+		return nil, err
+	}
+	if err = ueu.pr.Link(user.GetUserID(), player.GetPlayerID()); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+func (ueu *EntryUsecase) regist(user *model.User, playerKey string) (EntryDTO, error) {
 	key, err := util.Encrypt(user.GetUserID().String(), ueu.secret)
 	if err != nil {
 		return EntryDTO{}, err
 	}
+	player, err := ueu.linkPlayer(user, playerKey)
+	if err != nil {
+		return EntryDTO{}, err
+	}
 	if err = ueu.ur.Save(user); err != nil {
 		return EntryDTO{}, err
 	}
 	return EntryDTO{
-		AccessToken: user.GetAccessToken(),
+		AccessToken:  user.GetAccessToken(),
 		ReconnectKey: key,
+		PlayerKey:    player.GetPlayerKey(),
 	}, nil
 }
 
-func NewEntryUsecase(ur IUserRepository, secret []byte) *EntryUsecase {
+func NewEntryUsecase(gm *core.GameManager, ur IUserRepository, secret []byte, mod *core.Moderator, pr IPlayerRepository) *EntryUsecase {
 	return &EntryUsecase{
-		ur: ur,
+		gm:     gm,
+		ur:     ur,
 		secret: secret,
+		mod:    mod,
+		pr:     pr,
+		mu:     sync.Mutex{},
 	}
 }
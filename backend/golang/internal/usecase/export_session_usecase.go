@@ -0,0 +1,358 @@
+package usecase
+
+import (
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+const SessionArchivePrefix string = "cursed-frame-session-"
+
+type SessionChoiceDTO struct {
+	ChoiceID   uint   `json:"choice_id"`
+	ChoiceText string `json:"choice_text"`
+}
+
+type SessionUserDTO struct {
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	TeamID    uint32 `json:"team_id"`
+	TeamColor string `json:"team_color"`
+	IsReady   bool   `json:"is_ready"`
+	// アーカイブ内の画像のパス、写真が無い場合は空
+	ImageFile string `json:"image_file,omitempty"`
+}
+
+type SessionTeamDTO struct {
+	TeamID       uint32   `json:"team_id"`
+	TeamColor    string   `json:"team_color"`
+	MemberIDs    []string `json:"member_ids"`
+	CorrectCount int      `json:"correct_count"`
+	Points       int      `json:"points"`
+	CorrectRate  float32  `json:"correct_rate"`
+	Order        int      `json:"order"`
+}
+
+type SessionProfileAnswerDTO struct {
+	UserID       string `json:"user_id"`
+	UserName     string `json:"user_name"`
+	QuestionID   uint   `json:"question_id"`
+	QuestionText string `json:"question_text"`
+	Answer       string `json:"answer"`
+	IsFlagged    bool   `json:"is_flagged"`
+}
+
+type SessionAnswerDTO struct {
+	UserID         string           `json:"user_id"`
+	UserName       string           `json:"user_name"`
+	TeamID         uint32           `json:"team_id"`
+	Answer         SessionChoiceDTO `json:"answer"`
+	IsCorrect      bool             `json:"is_correct"`
+	ResponseTimeMs int64            `json:"response_time_ms"`
+	AnsweredAt     time.Time        `json:"answered_at"`
+}
+
+type SessionTeamAnswerDTO struct {
+	TeamID         uint32           `json:"team_id"`
+	TeamColor      string           `json:"team_color"`
+	Answer         SessionChoiceDTO `json:"answer"`
+	IsCorrect      bool             `json:"is_correct"`
+	IsDoublePoints bool             `json:"is_double_points"`
+	Lifelines      []string         `json:"lifelines"`
+	// 選択肢のID毎のチーム内の投票数
+	Votes map[uint]int `json:"votes"`
+}
+
+type SessionQuizDTO struct {
+	Number         int                    `json:"number"`
+	TargetUserID   string                 `json:"target_user_id"`
+	TargetUserName string                 `json:"target_user_name"`
+	TargetTeamID   uint32                 `json:"target_team_id"`
+	QuestionID     uint                   `json:"question_id"`
+	QuestionText   string                 `json:"question_text"`
+	Choices        []SessionChoiceDTO     `json:"choices"`
+	CorrectChoice  SessionChoiceDTO       `json:"correct_choice"`
+	Hint           string                 `json:"hint"`
+	StartedAt      time.Time              `json:"started_at"`
+	IsChecked      bool                   `json:"is_checked"`
+	TeamAnswers    []SessionTeamAnswerDTO `json:"team_answers"`
+	Answers        []SessionAnswerDTO     `json:"answers"`
+}
+
+type SessionUserStatsDTO struct {
+	UserID                string  `json:"user_id"`
+	UserName              string  `json:"user_name"`
+	TeamID                uint32  `json:"team_id"`
+	AnsweredCount         int     `json:"answered_count"`
+	CorrectCount          int     `json:"correct_count"`
+	CorrectRate           float32 `json:"correct_rate"`
+	AverageResponseTimeMs int64   `json:"average_response_time_ms"`
+	Order                 int     `json:"order"`
+}
+
+type SessionExportDTO struct {
+	ExportedAt     time.Time                 `json:"exported_at"`
+	QuizCount      int                       `json:"quiz_count"`
+	Users          []SessionUserDTO          `json:"users"`
+	Teams          []SessionTeamDTO          `json:"teams"`
+	ProfileAnswers []SessionProfileAnswerDTO `json:"profile_answers"`
+	Quizzes        []SessionQuizDTO          `json:"quizzes"`
+	UserStats      []SessionUserStatsDTO     `json:"user_stats"`
+	Analytics      *AnalyticsDTO             `json:"analytics"`
+}
+
+// 主催者が記録を残したり振り返りを共有したりできるように、ゲームのデータをまとめて書き出す
+// 一時ディレクトリは終了時に消えるので、終了前に書き出す必要がある
+type ExportSessionUsecase struct {
+	gm  *core.GameManager
+	ur  IUserRepository
+	uir IUserImageRepository
+	upr IUserProfileRepository
+	pqr IProfileQuestionRepository
+	is  IImageStore
+}
+
+func toSessionChoice(c core.Choice) SessionChoiceDTO {
+	return SessionChoiceDTO{
+		ChoiceID:   c.ChoiceID,
+		ChoiceText: c.ChoiceText,
+	}
+}
+
+// 値の大きい順に並べた時の順位、同じ値は同じ順位にする
+func rankDesc(values []int, value int) int {
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b int) int { return b - a })
+	return slices.Index(sorted, value) + 1
+}
+
+func rate(count int, total int) float32 {
+	if total == 0 {
+		return 0
+	}
+	return float32(count) / float32(total)
+}
+
+// ロビーにいる参加者とチーム分け後の参加者を重複無く集める
+func (esu *ExportSessionUsecase) sessionUsers() ([]model.User, error) {
+	uids := esu.gm.GetLobbyUsers()
+	for _, members := range esu.gm.GetTeams() {
+		for _, uid := range members {
+			if !slices.Contains(uids, uid) {
+				uids = append(uids, uid)
+			}
+		}
+	}
+	if len(uids) == 0 {
+		return []model.User{}, nil
+	}
+	users, err := esu.ur.FetchByUserIDs(uids)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(users, func(a, b model.User) int {
+		if a.GetTeamID() != b.GetTeamID() {
+			return int(a.GetTeamID()) - int(b.GetTeamID())
+		}
+		return slices.Index(uids, a.GetUserID()) - slices.Index(uids, b.GetUserID())
+	})
+	return users, nil
+}
+
+func (esu *ExportSessionUsecase) Collect() (*SessionExportDTO, map[string]string, error) {
+	users, err := esu.sessionUsers()
+	if err != nil {
+		return nil, nil, err
+	}
+	questions, err := esu.pqr.FetchAllQuestions()
+	if err != nil {
+		return nil, nil, err
+	}
+	questionTexts := make(map[uint]string, len(questions))
+	for _, q := range questions {
+		questionTexts[q.GetQuestionID()] = q.GetQuestionText()
+	}
+	names := make(map[uuid.UUID]string, len(users))
+	for _, u := range users {
+		names[u.GetUserID()] = u.GetName()
+	}
+
+	// アーカイブ内のパスから保存先のキーへの対応
+	images := make(map[string]string, len(users))
+	export := &SessionExportDTO{
+		ExportedAt:     time.Now(),
+		Users:          make([]SessionUserDTO, 0, len(users)),
+		ProfileAnswers: make([]SessionProfileAnswerDTO, 0),
+	}
+	for _, u := range users {
+		dto := SessionUserDTO{
+			UserID:    u.GetUserID().String(),
+			UserName:  u.GetName(),
+			TeamID:    u.GetTeamID(),
+			TeamColor: model.TeamColor(u.GetTeamID()).String(),
+			IsReady:   u.GetIsReady(),
+		}
+		if imageID, err := esu.uir.FetchByUserID(u.GetUserID()); err == nil {
+			dto.ImageFile = "images/" + dto.UserID + ImageFileExtension
+			images[dto.ImageFile] = imageFileName(imageID, core.ImageLarge)
+		}
+		export.Users = append(export.Users, dto)
+
+		profiles, err := esu.upr.FetchByUserID(u.GetUserID())
+		if err != nil {
+			continue
+		}
+		for _, p := range profiles {
+			export.ProfileAnswers = append(export.ProfileAnswers, SessionProfileAnswerDTO{
+				UserID:       dto.UserID,
+				UserName:     dto.UserName,
+				QuestionID:   p.GetProfileID(),
+				QuestionText: questionTexts[p.GetProfileID()],
+				Answer:       p.GetAnswer(),
+				IsFlagged:    p.IsFlagged(),
+			})
+		}
+	}
+
+	log := esu.gm.GetQuestLog()
+	quizCount := core.CheckedQuizCount(log)
+	export.QuizCount = quizCount
+	export.Quizzes = make([]SessionQuizDTO, 0, len(log))
+	for _, record := range log {
+		quiz := SessionQuizDTO{
+			Number:         record.Number,
+			TargetUserID:   record.TargetUserID.String(),
+			TargetUserName: names[record.TargetUserID],
+			TargetTeamID:   uint32(record.TargetTeamID),
+			QuestionID:     record.QuestionID,
+			QuestionText:   record.QuestionText,
+			Choices:        make([]SessionChoiceDTO, 0, len(record.Choices)),
+			CorrectChoice:  toSessionChoice(record.CorrectAnswer),
+			Hint:           record.Hint,
+			StartedAt:      record.StartedAt,
+			IsChecked:      record.IsChecked(),
+			TeamAnswers:    make([]SessionTeamAnswerDTO, 0, len(record.TeamAnswers)),
+			Answers:        make([]SessionAnswerDTO, 0, len(record.Answers)),
+		}
+		for _, c := range record.Choices {
+			quiz.Choices = append(quiz.Choices, toSessionChoice(c))
+		}
+		for _, ta := range record.TeamAnswers {
+			lifelines := make([]string, 0, len(ta.Lifelines))
+			for _, l := range ta.Lifelines {
+				lifelines = append(lifelines, l.String())
+			}
+			quiz.TeamAnswers = append(quiz.TeamAnswers, SessionTeamAnswerDTO{
+				TeamID:         uint32(ta.TeamID),
+				TeamColor:      model.TeamColor(ta.TeamID).String(),
+				Answer:         toSessionChoice(ta.Answer),
+				IsCorrect:      ta.IsCorrect,
+				IsDoublePoints: ta.IsDoublePoints,
+				Lifelines:      lifelines,
+				Votes:          ta.AnswerMap,
+			})
+		}
+		for _, a := range record.Answers {
+			quiz.Answers = append(quiz.Answers, SessionAnswerDTO{
+				UserID:         a.UserID.String(),
+				UserName:       names[a.UserID],
+				TeamID:         uint32(a.TeamID),
+				Answer:         toSessionChoice(a.Answer),
+				IsCorrect:      a.IsCorrect,
+				ResponseTimeMs: a.ResponseTime.Milliseconds(),
+				AnsweredAt:     a.AnsweredAt,
+			})
+		}
+		export.Quizzes = append(export.Quizzes, quiz)
+	}
+
+	export.Analytics = buildAnalytics(log, users)
+
+	players, teamSummaries := core.SummarizeQuestLog(log)
+	correctCounts := make([]int, 0, len(users))
+	for _, u := range users {
+		correctCounts = append(correctCounts, players[u.GetUserID()].CorrectCount)
+	}
+	export.UserStats = make([]SessionUserStatsDTO, 0, len(users))
+	for _, u := range users {
+		ps := players[u.GetUserID()]
+		export.UserStats = append(export.UserStats, SessionUserStatsDTO{
+			UserID:                u.GetUserID().String(),
+			UserName:              u.GetName(),
+			TeamID:                u.GetTeamID(),
+			AnsweredCount:         ps.AnsweredCount,
+			CorrectCount:          ps.CorrectCount,
+			CorrectRate:           rate(ps.CorrectCount, quizCount),
+			AverageResponseTimeMs: ps.AverageResponseTime().Milliseconds(),
+			Order:                 rankDesc(correctCounts, ps.CorrectCount),
+		})
+	}
+
+	teams := esu.gm.GetTeams()
+	teamIDs := slices.Sorted(maps.Keys(teams))
+	points := make([]int, 0, len(teams))
+	for _, tid := range teamIDs {
+		points = append(points, teamSummaries[tid].Points)
+	}
+	export.Teams = make([]SessionTeamDTO, 0, len(teams))
+	for _, tid := range teamIDs {
+		memberIDs := make([]string, 0, len(teams[tid]))
+		for _, uid := range teams[tid] {
+			memberIDs = append(memberIDs, uid.String())
+		}
+		ts := teamSummaries[tid]
+		export.Teams = append(export.Teams, SessionTeamDTO{
+			TeamID:       uint32(tid),
+			TeamColor:    model.TeamColor(tid).String(),
+			MemberIDs:    memberIDs,
+			CorrectCount: ts.CorrectCount,
+			Points:       ts.Points,
+			CorrectRate:  rate(ts.CorrectCount, quizCount),
+			Order:        rankDesc(points, ts.Points),
+		})
+	}
+	return export, images, nil
+}
+
+// JSONとCSVと画像をまとめたZIPを書き出す
+func (esu *ExportSessionUsecase) Execute(w io.Writer) error {
+	export, images, err := esu.Collect()
+	if err != nil {
+		return err
+	}
+	return writeSessionArchive(w, export, func(path string) (io.ReadCloser, error) {
+		img, err := esu.is.Get(images[path])
+		if err != nil {
+			return nil, err
+		}
+		return img, nil
+	})
+}
+
+func (esu *ExportSessionUsecase) FileName(t time.Time) string {
+	return SessionArchivePrefix + t.Format("20060102-150405") + ".zip"
+}
+
+func NewExportSessionUsecase(
+	gm *core.GameManager,
+	ur IUserRepository,
+	uir IUserImageRepository,
+	upr IUserProfileRepository,
+	pqr IProfileQuestionRepository,
+	is IImageStore,
+) *ExportSessionUsecase {
+	return &ExportSessionUsecase{
+		gm:  gm,
+		ur:  ur,
+		uir: uir,
+		upr: upr,
+		pqr: pqr,
+		is:  is,
+	}
+}
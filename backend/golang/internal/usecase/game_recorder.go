@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 終わったゲームを履歴に残す
+// 一時ディレクトリのDBは終了時に消えるので、プレイヤーのIDに置き換えて永続化されたDBに書き込む
+type GameRecorder struct {
+	gm *core.GameManager
+	ur IUserRepository
+	pr IPlayerRepository
+	hr IHistoryRepository
+}
+
+// ゲームに参加したプレイヤーの記録を作る、プレイヤーに紐付かない参加者は含めない
+func (gr *GameRecorder) build(endedAt time.Time) (*model.GameRecord, error) {
+	log := gr.gm.GetQuestLog()
+	if len(log) == 0 {
+		return nil, nil
+	}
+	teams := gr.gm.GetTeams()
+	uids := make([]uuid.UUID, 0)
+	for _, members := range teams {
+		uids = append(uids, members...)
+	}
+	users, err := gr.ur.FetchByUserIDs(uids)
+	if err != nil {
+		return nil, err
+	}
+	playerIDs, err := gr.pr.FetchPlayerIDs(uids)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := json.Marshal(gr.gm.GetRules())
+	if err != nil {
+		return nil, err
+	}
+	gameID, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	game := &model.GameRecord{
+		GameID:    gameID,
+		StartedAt: log[0].StartedAt,
+		EndedAt:   endedAt,
+		Rules:     string(rules),
+		QuizCount: core.CheckedQuizCount(log),
+	}
+
+	playerSummaries, teamSummaries := core.SummarizeQuestLog(log)
+	teamIDs := slices.Sorted(maps.Keys(teams))
+	points := make([]int, 0, len(teamIDs))
+	for _, tid := range teamIDs {
+		points = append(points, teamSummaries[tid].Points)
+	}
+	game.Teams = make([]model.GameTeamRecord, 0, len(teamIDs))
+	for _, tid := range teamIDs {
+		ts := teamSummaries[tid]
+		game.Teams = append(game.Teams, model.GameTeamRecord{
+			TeamID:       uint32(tid),
+			CorrectCount: ts.CorrectCount,
+			Points:       ts.Points,
+			Order:        rankDesc(points, ts.Points),
+		})
+	}
+
+	correctCounts := make([]int, 0, len(users))
+	for _, u := range users {
+		correctCounts = append(correctCounts, playerSummaries[u.GetUserID()].CorrectCount)
+	}
+	game.Players = make([]model.GamePlayerRecord, 0, len(users))
+	for _, u := range users {
+		pid, ok := playerIDs[u.GetUserID()]
+		if !ok {
+			continue
+		}
+		ps := playerSummaries[u.GetUserID()]
+		game.Players = append(game.Players, model.GamePlayerRecord{
+			PlayerID:          pid,
+			Name:              u.GetName(),
+			TeamID:            u.GetTeamID(),
+			AnsweredCount:     ps.AnsweredCount,
+			CorrectCount:      ps.CorrectCount,
+			TotalResponseTime: ps.TotalResponseTime,
+			Order:             rankDesc(correctCounts, ps.CorrectCount),
+		})
+	}
+
+	game.Quizzes = make([]model.GameQuizRecord, 0, len(log))
+	for _, record := range log {
+		game.Quizzes = append(game.Quizzes, model.GameQuizRecord{
+			Number:             record.Number,
+			TargetPlayerID:     playerIDs[record.TargetUserID],
+			TargetTeamID:       uint32(record.TargetTeamID),
+			QuestionID:         record.QuestionID,
+			QuestionText:       record.QuestionText,
+			CorrectAnswer:      record.CorrectAnswer.ChoiceText,
+			Hint:               record.Hint,
+			StartedAt:          record.StartedAt,
+			CountdownStartedAt: record.CountdownStartedAt,
+			HintTakenAt:        record.HintTakenAt,
+			CheckedAt:          record.CheckedAt,
+		})
+		for _, ta := range record.TeamAnswers {
+			lifelines := make([]string, 0, len(ta.Lifelines))
+			for _, l := range ta.Lifelines {
+				lifelines = append(lifelines, l.String())
+			}
+			game.TeamAnswers = append(game.TeamAnswers, model.GameTeamAnswerRecord{
+				QuizNumber:     record.Number,
+				TeamID:         uint32(ta.TeamID),
+				Answer:         ta.Answer.ChoiceText,
+				IsCorrect:      ta.IsCorrect,
+				IsDoublePoints: ta.IsDoublePoints,
+				Lifelines:      lifelines,
+			})
+		}
+		for _, a := range record.Answers {
+			pid, ok := playerIDs[a.UserID]
+			if !ok {
+				continue
+			}
+			game.Answers = append(game.Answers, model.GameAnswerRecord{
+				QuizNumber:   record.Number,
+				PlayerID:     pid,
+				TeamID:       uint32(a.TeamID),
+				Answer:       a.Answer.ChoiceText,
+				IsCorrect:    a.IsCorrect,
+				AnsweredAt:   a.AnsweredAt,
+				ResponseTime: a.ResponseTime,
+			})
+		}
+	}
+	return game, nil
+}
+
+// 一問も出題していない場合は何も残さない
+func (gr *GameRecorder) Record() error {
+	game, err := gr.build(time.Now())
+	if err != nil || game == nil {
+		return err
+	}
+	return gr.hr.SaveGame(game)
+}
+
+func NewGameRecorder(gm *core.GameManager, ur IUserRepository, pr IPlayerRepository, hr IHistoryRepository) *GameRecorder {
+	return &GameRecorder{
+		gm: gm,
+		ur: ur,
+		pr: pr,
+		hr: hr,
+	}
+}
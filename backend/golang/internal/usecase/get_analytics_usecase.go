@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// よく知られている人や難しかった質問を何件まで挙げるか
+const AnalyticsRankingNum int = 5
+
+type MemberKnowledgeDTO struct {
+	UserID    string `json:"user_id"`
+	UserName  string `json:"user_name"`
+	TeamID    uint32 `json:"team_id"`
+	TeamColor string `json:"team_color"`
+	// 出題対象になった回数
+	QuizCount     int     `json:"quiz_count"`
+	AnsweredCount int     `json:"answered_count"`
+	CorrectCount  int     `json:"correct_count"`
+	CorrectRate   float32 `json:"correct_rate"`
+}
+
+type QuestionDifficultyDTO struct {
+	QuestionID    uint    `json:"question_id"`
+	QuestionText  string  `json:"question_text"`
+	QuizCount     int     `json:"quiz_count"`
+	AnsweredCount int     `json:"answered_count"`
+	CorrectCount  int     `json:"correct_count"`
+	CorrectRate   float32 `json:"correct_rate"`
+}
+
+type RelationshipNodeDTO struct {
+	UserID    string `json:"id"`
+	UserName  string `json:"name"`
+	TeamID    uint32 `json:"team_id"`
+	TeamColor string `json:"team_color"`
+}
+
+// 回答した人から出題対象の人への辺
+type RelationshipEdgeDTO struct {
+	AnswererID    string  `json:"source"`
+	TargetID      string  `json:"target"`
+	AnsweredCount int     `json:"answered_count"`
+	CorrectCount  int     `json:"correct_count"`
+	CorrectRate   float32 `json:"correct_rate"`
+}
+
+type RelationshipGraphDTO struct {
+	Nodes []RelationshipNodeDTO `json:"nodes"`
+	Edges []RelationshipEdgeDTO `json:"edges"`
+}
+
+type AnalyticsDTO struct {
+	MostKnown        []MemberKnowledgeDTO    `json:"most_known"`
+	LeastKnown       []MemberKnowledgeDTO    `json:"least_known"`
+	HardestQuestions []QuestionDifficultyDTO `json:"hardest_questions"`
+	Graph            RelationshipGraphDTO    `json:"graph"`
+}
+
+func topN[T any](items []T, n int) []T {
+	if len(items) > n {
+		return items[:n]
+	}
+	return items
+}
+
+// 誰が誰をどれだけ知っていたかを、ランキングとグラフにまとめる
+// 出題対象になったが途中で参加を取り消された人は名前が分からないので、ランキングとグラフから除く
+func buildAnalytics(log []core.QuizRecord, users []model.User) *AnalyticsDTO {
+	relationships := core.AnalyzeRelationships(log)
+	usersByID := make(map[uuid.UUID]model.User, len(users))
+	for _, u := range users {
+		usersByID[u.GetUserID()] = u
+	}
+	toMembers := func(targets []core.TargetStats) []MemberKnowledgeDTO {
+		members := make([]MemberKnowledgeDTO, 0, len(targets))
+		for _, ts := range targets {
+			u, ok := usersByID[ts.TargetID]
+			if !ok {
+				continue
+			}
+			members = append(members, MemberKnowledgeDTO{
+				UserID:        u.GetUserID().String(),
+				UserName:      u.GetName(),
+				TeamID:        u.GetTeamID(),
+				TeamColor:     model.TeamColor(u.GetTeamID()).String(),
+				QuizCount:     ts.QuizCount,
+				AnsweredCount: ts.AnsweredCount,
+				CorrectCount:  ts.CorrectCount,
+				CorrectRate:   ts.CorrectRate(),
+			})
+		}
+		return topN(members, AnalyticsRankingNum)
+	}
+
+	analytics := &AnalyticsDTO{
+		MostKnown:        toMembers(relationships.MostKnown()),
+		LeastKnown:       toMembers(relationships.LeastKnown()),
+		HardestQuestions: make([]QuestionDifficultyDTO, 0, AnalyticsRankingNum),
+		Graph: RelationshipGraphDTO{
+			Nodes: make([]RelationshipNodeDTO, 0, len(users)),
+			Edges: make([]RelationshipEdgeDTO, 0, len(relationships.Pairs)),
+		},
+	}
+	for _, qs := range topN(relationships.HardestQuestions(), AnalyticsRankingNum) {
+		analytics.HardestQuestions = append(analytics.HardestQuestions, QuestionDifficultyDTO{
+			QuestionID:    qs.QuestionID,
+			QuestionText:  qs.QuestionText,
+			QuizCount:     qs.QuizCount,
+			AnsweredCount: qs.AnsweredCount,
+			CorrectCount:  qs.CorrectCount,
+			CorrectRate:   qs.CorrectRate(),
+		})
+	}
+	for _, u := range users {
+		analytics.Graph.Nodes = append(analytics.Graph.Nodes, RelationshipNodeDTO{
+			UserID:    u.GetUserID().String(),
+			UserName:  u.GetName(),
+			TeamID:    u.GetTeamID(),
+			TeamColor: model.TeamColor(u.GetTeamID()).String(),
+		})
+	}
+	for _, ps := range relationships.Pairs {
+		_, answererOK := usersByID[ps.AnswererID]
+		_, targetOK := usersByID[ps.TargetID]
+		if !answererOK || !targetOK {
+			continue
+		}
+		analytics.Graph.Edges = append(analytics.Graph.Edges, RelationshipEdgeDTO{
+			AnswererID:    ps.AnswererID.String(),
+			TargetID:      ps.TargetID.String(),
+			AnsweredCount: ps.AnsweredCount,
+			CorrectCount:  ps.CorrectCount,
+			CorrectRate:   ps.CorrectRate(),
+		})
+	}
+	return analytics
+}
+
+// 事後のレポート用に、お互いをどれだけ知っていたかを集計する
+type GetAnalyticsUsecase struct {
+	gm *core.GameManager
+	ur IUserRepository
+}
+
+func (gau *GetAnalyticsUsecase) Execute() (*AnalyticsDTO, error) {
+	teams := gau.gm.GetTeams()
+	uids := make([]uuid.UUID, 0)
+	for _, tid := range slices.Sorted(maps.Keys(teams)) {
+		uids = append(uids, teams[tid]...)
+	}
+	users := make([]model.User, 0, len(uids))
+	if len(uids) > 0 {
+		fetched, err := gau.ur.FetchByUserIDs(uids)
+		if err != nil {
+			return nil, err
+		}
+		// グラフの並びがチーム毎にまとまるように、チーム分けの順に並べる
+		slices.SortFunc(fetched, func(a, b model.User) int {
+			return slices.Index(uids, a.GetUserID()) - slices.Index(uids, b.GetUserID())
+		})
+		users = fetched
+	}
+	return buildAnalytics(gau.gm.GetQuestLog(), users), nil
+}
+
+func NewGetAnalyticsUsecase(gm *core.GameManager, ur IUserRepository) *GetAnalyticsUsecase {
+	return &GetAnalyticsUsecase{
+		gm: gm,
+		ur: ur,
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 件数を指定しなかった場合に返す件数
+const DefaultHistoryLimit int = 20
+
+type GameHistoryDTO struct {
+	GameID    uuid.UUID
+	StartedAt time.Time
+	EndedAt   time.Time
+	Rules     core.GameRules
+	QuizCount int
+	Teams     []model.GameTeamRecord
+	Players   []model.GamePlayerRecord
+}
+
+type GetHistoryUsecase struct {
+	hr IHistoryRepository
+}
+
+// 新しいゲームから順に返す
+func (ghu *GetHistoryUsecase) Execute(limit int, offset int) ([]GameHistoryDTO, error) {
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	games, err := ghu.hr.FetchGames(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]GameHistoryDTO, 0, len(games))
+	for _, game := range games {
+		// 後から増えたルールの項目は既定値で埋める
+		rules := core.DefaultGameRules()
+		if err := json.Unmarshal([]byte(game.Rules), &rules); err != nil {
+			return nil, err
+		}
+		history = append(history, GameHistoryDTO{
+			GameID:    game.GameID,
+			StartedAt: game.StartedAt,
+			EndedAt:   game.EndedAt,
+			Rules:     rules,
+			QuizCount: game.QuizCount,
+			Teams:     game.Teams,
+			Players:   game.Players,
+		})
+	}
+	return history, nil
+}
+
+func NewGetHistoryUsecase(hr IHistoryRepository) *GetHistoryUsecase {
+	return &GetHistoryUsecase{
+		hr: hr,
+	}
+}
@@ -0,0 +1,22 @@
+package usecase
+
+type GetInvitationQRCodeUsecase struct {
+	invitationURL string
+	encoder       func(string, int) ([]byte, error)
+}
+
+// 参加者向けURLのQRコードをPNGで返す
+func (giqu *GetInvitationQRCodeUsecase) Execute(size int) ([]byte, error) {
+	return giqu.encoder(giqu.invitationURL, size)
+}
+
+func (giqu *GetInvitationQRCodeUsecase) GetInvitationURL() string {
+	return giqu.invitationURL
+}
+
+func NewGetInvitationQRCodeUsecase(invitationURL string, encoder func(string, int) ([]byte, error)) *GetInvitationQRCodeUsecase {
+	return &GetInvitationQRCodeUsecase{
+		invitationURL: invitationURL,
+		encoder:       encoder,
+	}
+}
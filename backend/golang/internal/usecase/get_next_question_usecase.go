@@ -0,0 +1,18 @@
+package usecase
+
+import "github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+
+type GetNextQuestionUsecase struct {
+	qn *Questionnaire
+}
+
+// 再読み込みなどで途中から回答を再開する時に使う
+func (gnqu *GetNextQuestionUsecase) Execute(user *model.User) (ProfileQuestionDTO, error) {
+	return gnqu.qn.next(user.GetUserID())
+}
+
+func NewGetNextQuestionUsecase(qn *Questionnaire) *GetNextQuestionUsecase {
+	return &GetNextQuestionUsecase{
+		qn: qn,
+	}
+}
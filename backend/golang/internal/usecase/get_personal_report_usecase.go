@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 自分についてのクイズに他の参加者が出した回答
+type OthersAnswerDTO struct {
+	UserName  string
+	TeamID    uint32
+	Answer    core.Choice
+	IsCorrect bool
+}
+
+type QuizAboutMeDTO struct {
+	Number        int
+	Question      string
+	CorrectChoice core.Choice
+	Hint          string
+	AnsweredCount int
+	CorrectCount  int
+	Answers       []OthersAnswerDTO
+}
+
+type MyAnswerDTO struct {
+	Number         int
+	TargetUserName string
+	Question       string
+	Answer         core.Choice
+	CorrectChoice  core.Choice
+	IsCorrect      bool
+	ResponseTime   time.Duration
+}
+
+type KnownPersonDTO struct {
+	UserName      string
+	TeamID        uint32
+	AnsweredCount int
+	CorrectCount  int
+	CorrectRate   float32
+}
+
+type PersonalReportDTO struct {
+	QuizzesAboutMe []QuizAboutMeDTO
+	MyAnswers      []MyAnswerDTO
+	// 今のチームのメンバーを、これまでのゲームでよく知っていた人から順に並べる
+	// このゲームでは自分のチームのメンバーについてのクイズには答えないので、別のチームだった時の回答から数える
+	KnownPeople         []KnownPersonDTO
+	AnsweredCount       int
+	CorrectCount        int
+	AverageResponseTime time.Duration
+}
+
+// ゲームの後で参加者一人一人に振り返りを見せる
+// 出題中に見せると他の参加者のクイズの答えが分かってしまうので、結果発表の後だけ返す
+type GetPersonalReportUsecase struct {
+	gm *core.GameManager
+	ur IUserRepository
+	pr IPlayerRepository
+	hr IHistoryRepository
+}
+
+func (gpru *GetPersonalReportUsecase) Execute(uid uuid.UUID) (*PersonalReportDTO, error) {
+	if gpru.gm.GetState() != core.RESULT {
+		return nil, errors.New("Game has not been ended")
+	}
+	teams := gpru.gm.GetTeams()
+	uids := make([]uuid.UUID, 0)
+	for _, tid := range slices.Sorted(maps.Keys(teams)) {
+		uids = append(uids, teams[tid]...)
+	}
+	users, err := gpru.ur.FetchByUserIDs(uids)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[uuid.UUID]model.User, len(users))
+	for _, u := range users {
+		usersByID[u.GetUserID()] = u
+	}
+
+	log := gpru.gm.GetQuestLog()
+	report := &PersonalReportDTO{
+		QuizzesAboutMe: make([]QuizAboutMeDTO, 0),
+		MyAnswers:      make([]MyAnswerDTO, 0, len(log)),
+		KnownPeople:    make([]KnownPersonDTO, 0),
+	}
+	for _, record := range log {
+		if record.TargetUserID == uid {
+			quiz := QuizAboutMeDTO{
+				Number:        record.Number,
+				Question:      record.QuestionText,
+				CorrectChoice: record.CorrectAnswer,
+				Hint:          record.Hint,
+				Answers:       make([]OthersAnswerDTO, 0, len(record.Answers)),
+			}
+			for _, a := range record.Answers {
+				if a.UserID == uid {
+					continue
+				}
+				quiz.AnsweredCount++
+				if a.IsCorrect {
+					quiz.CorrectCount++
+				}
+				quiz.Answers = append(quiz.Answers, OthersAnswerDTO{
+					UserName:  usersByID[a.UserID].GetName(),
+					TeamID:    uint32(a.TeamID),
+					Answer:    a.Answer,
+					IsCorrect: a.IsCorrect,
+				})
+			}
+			report.QuizzesAboutMe = append(report.QuizzesAboutMe, quiz)
+			continue
+		}
+		idx := slices.IndexFunc(record.Answers, func(a core.AnswerRecord) bool { return a.UserID == uid })
+		if idx < 0 {
+			continue
+		}
+		a := record.Answers[idx]
+		report.MyAnswers = append(report.MyAnswers, MyAnswerDTO{
+			Number:         record.Number,
+			TargetUserName: usersByID[record.TargetUserID].GetName(),
+			Question:       record.QuestionText,
+			Answer:         a.Answer,
+			CorrectChoice:  record.CorrectAnswer,
+			IsCorrect:      a.IsCorrect,
+			ResponseTime:   a.ResponseTime,
+		})
+	}
+
+	players, _ := core.SummarizeQuestLog(log)
+	report.AnsweredCount = players[uid].AnsweredCount
+	report.CorrectCount = players[uid].CorrectCount
+	report.AverageResponseTime = players[uid].AverageResponseTime()
+
+	teammates := make([]uuid.UUID, 0)
+	for _, members := range teams {
+		if slices.Contains(members, uid) {
+			teammates = slices.DeleteFunc(slices.Clone(members), func(member uuid.UUID) bool { return member == uid })
+			break
+		}
+	}
+	knownPairs, err := gpru.fetchKnownPairs(uid, teammates)
+	if err != nil {
+		return nil, err
+	}
+	for _, ps := range core.RankKnownPairs(knownPairs) {
+		person, ok := usersByID[ps.TargetID]
+		if !ok {
+			continue
+		}
+		report.KnownPeople = append(report.KnownPeople, KnownPersonDTO{
+			UserName:      person.GetName(),
+			TeamID:        person.GetTeamID(),
+			AnsweredCount: ps.AnsweredCount,
+			CorrectCount:  ps.CorrectCount,
+			CorrectRate:   ps.CorrectRate(),
+		})
+	}
+	return report, nil
+}
+
+// 履歴はプレイヤー毎に残っているので、プレイヤーのIDで引いてから参加者のIDに戻す
+// 以前のゲームで一緒になったことが無い場合は空になる
+func (gpru *GetPersonalReportUsecase) fetchKnownPairs(uid uuid.UUID, teammates []uuid.UUID) ([]core.PairStats, error) {
+	pairs := make([]core.PairStats, 0, len(teammates))
+	if len(teammates) == 0 {
+		return pairs, nil
+	}
+	playerIDs, err := gpru.pr.FetchPlayerIDs(append([]uuid.UUID{uid}, teammates...))
+	if err != nil {
+		return nil, err
+	}
+	myPlayerID, ok := playerIDs[uid]
+	if !ok {
+		return pairs, nil
+	}
+	targets := make([]uuid.UUID, 0, len(teammates))
+	userIDs := make(map[uuid.UUID]uuid.UUID, len(teammates))
+	for _, mate := range teammates {
+		if pid, ok := playerIDs[mate]; ok {
+			targets = append(targets, pid)
+			userIDs[pid] = mate
+		}
+	}
+	records, err := gpru.hr.FetchPairRecords(myPlayerID, targets)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		pairs = append(pairs, core.PairStats{
+			AnswererID:    uid,
+			TargetID:      userIDs[record.TargetPlayerID],
+			AnsweredCount: record.AnsweredCount,
+			CorrectCount:  record.CorrectCount,
+		})
+	}
+	return pairs, nil
+}
+
+func NewGetPersonalReportUsecase(gm *core.GameManager, ur IUserRepository, pr IPlayerRepository, hr IHistoryRepository) *GetPersonalReportUsecase {
+	return &GetPersonalReportUsecase{
+		gm: gm,
+		ur: ur,
+		pr: pr,
+		hr: hr,
+	}
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// IDで引くだけのユーザーリポジトリ
+type userRecords map[uuid.UUID]model.User
+
+func (ur userRecords) Save(u *model.User) error {
+	ur[u.GetUserID()] = *u
+	return nil
+}
+
+func (ur userRecords) SaveBulk(users []model.User) error {
+	for _, u := range users {
+		ur[u.GetUserID()] = u
+	}
+	return nil
+}
+
+func (ur userRecords) FetchByUserID(uid uuid.UUID) (*model.User, error) {
+	u, ok := ur[uid]
+	if !ok {
+		return nil, errors.New("User not found")
+	}
+	return &u, nil
+}
+
+func (ur userRecords) FetchByUserIDs(uids []uuid.UUID) ([]model.User, error) {
+	users := make([]model.User, 0, len(uids))
+	for _, uid := range uids {
+		if u, ok := ur[uid]; ok {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (ur userRecords) FetchByTeamID(tid uint32) ([]model.User, error) {
+	users := make([]model.User, 0)
+	for _, u := range ur {
+		if u.GetTeamID() == tid {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+// 参加者とプレイヤーの対応だけを持つプレイヤーリポジトリ
+type playerLinks struct {
+	IPlayerRepository
+	playerIDs map[uuid.UUID]uuid.UUID
+}
+
+func (pl playerLinks) FetchPlayerIDs(uids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	playerIDs := make(map[uuid.UUID]uuid.UUID, len(uids))
+	for _, uid := range uids {
+		if pid, ok := pl.playerIDs[uid]; ok {
+			playerIDs[uid] = pid
+		}
+	}
+	return playerIDs, nil
+}
+
+// 回答したプレイヤー毎に、これまでのゲームでの相手毎の成績だけを持つ履歴リポジトリ
+type pairHistory struct {
+	IHistoryRepository
+	records map[uuid.UUID][]model.PlayerPairRecord
+}
+
+func (ph pairHistory) FetchPairRecords(answerer uuid.UUID, targets []uuid.UUID) ([]model.PlayerPairRecord, error) {
+	records := make([]model.PlayerPairRecord, 0)
+	for _, record := range ph.records[answerer] {
+		if slices.Contains(targets, record.TargetPlayerID) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+var (
+	rightChoice = core.Choice{ChoiceID: 1, ChoiceText: "right"}
+	wrongChoice = core.Choice{ChoiceID: 2, ChoiceText: "wrong"}
+)
+
+// ２チームに分かれて出題を進めるゲーム
+type testGame struct {
+	gm    *core.GameManager
+	users userRecords
+	teams map[core.TeamID][]uuid.UUID
+	count <-chan struct{}
+	next  <-chan struct{}
+	// 出題した数
+	played int
+}
+
+func startTestGame(t *testing.T, userNum int) *testGame {
+	t.Helper()
+	rules := core.DefaultGameRules()
+	// 出題対象のチームは回答しないので、締め切りまで待つ時間を短くする
+	rules.AnswerTimeout = 300 * time.Millisecond
+	gm := core.NewGameManager(core.EntryLimits{}, 2, rules)
+	if _, err := gm.OpenLobby(); err != nil {
+		t.Fatal(err)
+	}
+	users := make(userRecords, userNum)
+	uids := make([]uuid.UUID, 0, userNum)
+	for range userNum {
+		u, err := model.NewUser("guest" + string(rune('A'+len(uids))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := gm.JoinLobby(u.GetUserID()); err != nil {
+			t.Fatal(err)
+		}
+		users[u.GetUserID()] = *u
+		uids = append(uids, u.GetUserID())
+	}
+	if err := gm.CloseLobby(); err != nil {
+		t.Fatal(err)
+	}
+	for uid, tid := range gm.SplitTeams(uids, 2) {
+		u := users[uid]
+		u.SetTeamID(tid)
+		users[uid] = u
+	}
+	count, next, err := gm.QuestStart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testGame{gm: gm, users: users, teams: gm.GetTeams(), count: count, next: next}
+}
+
+// targetについて出題し、answersの参加者が正解か不正解を選んでから答え合わせをする
+func (g *testGame) play(t *testing.T, target uuid.UUID, answers map[uuid.UUID]bool) {
+	t.Helper()
+	if g.played > 0 {
+		go func() { <-g.next }()
+		if err := g.gm.NextQuiz(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	g.played++
+	quiz := core.Quiz{
+		TeamID:       core.TeamID(g.users[target].GetTeamID()),
+		QuestionID:   uint(g.played),
+		QuestionText: "Q" + string(rune('0'+g.played)),
+		Choices:      []core.Choice{rightChoice, wrongChoice},
+	}
+	if err := g.gm.Broadcast(target, quiz, rightChoice); err != nil {
+		t.Fatal(err)
+	}
+	go func() { <-g.count }()
+	if err := g.gm.StartCount(); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for uid, isCorrect := range answers {
+		choice := wrongChoice
+		if isCorrect {
+			choice = rightChoice
+		}
+		wg.Go(func() {
+			_, _, _ = g.gm.Answer(uid, core.TeamID(g.users[uid].GetTeamID()), choice)
+		})
+	}
+	// 回収中は回答を記録できないので、全員の回答が記録されてから回収する
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		log := g.gm.GetQuestLog()
+		if len(log[len(log)-1].Answers) == len(answers) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("answers were not recorded")
+		}
+	}
+	results, answerMaps, err := g.gm.CollectAnswer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.gm.DistributeAnswer(results, answerMaps); err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+}
+
+func (g *testGame) end(t *testing.T) {
+	t.Helper()
+	if err := g.gm.EndQuest(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (g *testGame) name(uid uuid.UUID) string {
+	return g.users[uid].GetName()
+}
+
+func TestGetPersonalReportUsecaseBeforeResult(t *testing.T) {
+	g := startTestGame(t, 4)
+	gpru := NewGetPersonalReportUsecase(g.gm, g.users, playerLinks{}, pairHistory{})
+	if _, err := gpru.Execute(g.teams[1][0]); err == nil {
+		t.Error("Execute() during the quest error = nil, want error so that answers are not leaked")
+	}
+}
+
+func TestGetPersonalReportUsecase(t *testing.T) {
+	g := startTestGame(t, 4)
+	me, mate := g.teams[1][0], g.teams[1][1]
+	rival1, rival2 := g.teams[2][0], g.teams[2][1]
+	g.play(t, rival1, map[uuid.UUID]bool{me: true, mate: false})
+	g.play(t, me, map[uuid.UUID]bool{rival1: true, rival2: false})
+	g.play(t, rival2, map[uuid.UUID]bool{me: false, mate: true})
+	g.end(t)
+
+	// 以前のゲームでは、チームメイトとライバルのどちらについても答えたことがある
+	playerIDs := map[uuid.UUID]uuid.UUID{me: uuid.New(), mate: uuid.New(), rival1: uuid.New(), rival2: uuid.New()}
+	history := pairHistory{records: map[uuid.UUID][]model.PlayerPairRecord{
+		playerIDs[me]: {
+			{TargetPlayerID: playerIDs[mate], AnsweredCount: 3, CorrectCount: 2},
+			{TargetPlayerID: playerIDs[rival1], AnsweredCount: 2, CorrectCount: 2},
+		},
+	}}
+	report, err := NewGetPersonalReportUsecase(g.gm, g.users, playerLinks{playerIDs: playerIDs}, history).Execute(me)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.QuizzesAboutMe) != 1 {
+		t.Fatalf("QuizzesAboutMe = %+v, want the second quiz only", report.QuizzesAboutMe)
+	}
+	aboutMe := report.QuizzesAboutMe[0]
+	if aboutMe.Number != 2 || aboutMe.CorrectChoice != rightChoice || aboutMe.AnsweredCount != 2 || aboutMe.CorrectCount != 1 {
+		t.Errorf("QuizzesAboutMe[0] = %+v, want quiz 2 answered by two with one correct", aboutMe)
+	}
+	gotAnswers := make(map[string]core.Choice, len(aboutMe.Answers))
+	for _, a := range aboutMe.Answers {
+		gotAnswers[a.UserName] = a.Answer
+		if a.TeamID != 2 || a.IsCorrect != (a.Answer == rightChoice) {
+			t.Errorf("answer about me = %+v, want a consistent answer from team 2", a)
+		}
+	}
+	if gotAnswers[g.name(rival1)] != rightChoice || gotAnswers[g.name(rival2)] != wrongChoice {
+		t.Errorf("answers about me = %v, want %s right and %s wrong", gotAnswers, g.name(rival1), g.name(rival2))
+	}
+
+	numbers := make([]int, 0, len(report.MyAnswers))
+	for _, a := range report.MyAnswers {
+		numbers = append(numbers, a.Number)
+	}
+	if !slices.Equal(numbers, []int{1, 3}) {
+		t.Fatalf("MyAnswers numbers = %v, want [1 3]", numbers)
+	}
+	if first := report.MyAnswers[0]; first.TargetUserName != g.name(rival1) || !first.IsCorrect || first.Answer != rightChoice {
+		t.Errorf("MyAnswers[0] = %+v, want a right answer about %s", first, g.name(rival1))
+	}
+	if last := report.MyAnswers[1]; last.TargetUserName != g.name(rival2) || last.IsCorrect || last.CorrectChoice != rightChoice {
+		t.Errorf("MyAnswers[1] = %+v, want a wrong answer about %s", last, g.name(rival2))
+	}
+	if report.AnsweredCount != 2 || report.CorrectCount != 1 {
+		t.Errorf("report counts = %d/%d, want 1/2", report.CorrectCount, report.AnsweredCount)
+	}
+
+	if len(report.KnownPeople) != 1 {
+		t.Fatalf("KnownPeople = %+v, want only the teammate %s", report.KnownPeople, g.name(mate))
+	}
+	if kp := report.KnownPeople[0]; kp.UserName != g.name(mate) || kp.TeamID != 1 || kp.AnsweredCount != 3 || kp.CorrectCount != 2 {
+		t.Errorf("KnownPeople[0] = %+v, want %s answered 2 of 3 in earlier games", kp, g.name(mate))
+	}
+
+	// 初めて遊んだ参加者には、チームメイトについての記録が無い
+	first, err := NewGetPersonalReportUsecase(g.gm, g.users, playerLinks{}, history).Execute(me)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.KnownPeople) != 0 {
+		t.Errorf("KnownPeople without history = %+v, want none", first.KnownPeople)
+	}
+}
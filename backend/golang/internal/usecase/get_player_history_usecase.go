@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type PlayerHistoryDTO struct {
+	PlayerID uuid.UUID
+	// 最後に参加した時の名前
+	Name string
+	// 古いゲームから順に並べる
+	Games []model.PlayerGameRecord
+}
+
+type GetPlayerHistoryUsecase struct {
+	pr IPlayerRepository
+	hr IHistoryRepository
+}
+
+func (gphu *GetPlayerHistoryUsecase) Execute(pid uuid.UUID) (PlayerHistoryDTO, error) {
+	player, err := gphu.pr.FetchByPlayerID(pid)
+	if err != nil {
+		return PlayerHistoryDTO{}, err
+	}
+	games, err := gphu.hr.FetchPlayerGames(pid)
+	if err != nil {
+		return PlayerHistoryDTO{}, err
+	}
+	return PlayerHistoryDTO{
+		PlayerID: player.GetPlayerID(),
+		Name:     player.GetName(),
+		Games:    games,
+	}, nil
+}
+
+func NewGetPlayerHistoryUsecase(pr IPlayerRepository, hr IHistoryRepository) *GetPlayerHistoryUsecase {
+	return &GetPlayerHistoryUsecase{
+		pr: pr,
+		hr: hr,
+	}
+}
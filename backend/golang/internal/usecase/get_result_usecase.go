@@ -7,21 +7,27 @@ import (
 )
 
 type GetResultUsecase struct {
-	gm *core.GameManager
-	resultStateMapper func(float32) int32
+	gm                *core.GameManager
+	ur                IUserRepository
+	resultStateMapper func(float32, core.ResultThresholds) int32
 }
 
-func (gru *GetResultUsecase) Execute(uid uuid.UUID, tid uint32) (resultState int32, personal core.Stats, team core.Stats, err error) {
+func (gru *GetResultUsecase) Execute(uid uuid.UUID, tid uint32) (resultState int32, personal core.Stats, team core.Stats, awards []AwardDTO, err error) {
 	totalRate, personalStats, teamStats, err := gru.gm.GetResultStats(uid, core.TeamID(tid))
 	if err != nil {
-		return 0, core.Stats{}, core.Stats{}, err
+		return 0, core.Stats{}, core.Stats{}, nil, err
 	}
-	return gru.resultStateMapper(totalRate), personalStats, teamStats, nil
+	awards, err = evaluateAwards(gru.gm, gru.ur)
+	if err != nil {
+		return 0, core.Stats{}, core.Stats{}, nil, err
+	}
+	return gru.resultStateMapper(totalRate, gru.gm.GetRules().ResultThresholds), personalStats, teamStats, awards, nil
 }
 
-func NewGetResultUsecase(gm *core.GameManager, mapper func(float32) int32) *GetResultUsecase {
+func NewGetResultUsecase(gm *core.GameManager, ur IUserRepository, mapper func(float32, core.ResultThresholds) int32) *GetResultUsecase {
 	return &GetResultUsecase{
-		gm: gm,
+		gm:                gm,
+		ur:                ur,
 		resultStateMapper: mapper,
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import "github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+
+type GetRulesUsecase struct {
+	gm *core.GameManager
+}
+
+func (gru *GetRulesUsecase) Execute() core.GameRules {
+	return gru.gm.GetRules()
+}
+
+func NewGetRulesUsecase(gm *core.GameManager) *GetRulesUsecase {
+	return &GetRulesUsecase{
+		gm: gm,
+	}
+}
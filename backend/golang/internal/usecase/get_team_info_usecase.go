@@ -3,34 +3,49 @@ package usecase
 import (
 	"errors"
 
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 )
 
+type TeamMemberDTO struct {
+	UserName string
+	// 写真が無い場合は代わりの画像のURL
+	ImageURL string
+}
+
 type GetTeamInfoUsecase struct {
-	ur IUserRepository
+	ur     IUserRepository
+	uir    IUserImageRepository
+	signer *ImageURLSigner
 }
 
-func (gtu *GetTeamInfoUsecase) Execute(user *model.User) (uint32, string, []string, error) {
+func (gtu *GetTeamInfoUsecase) Execute(user *model.User) (uint32, string, []TeamMemberDTO, error) {
 	if user.GetTeamID() == model.UNDEFINED.Raw() {
-		return 0, model.UNDEFINED.String(), []string{}, errors.New("Teams have not been splitted yet")
+		return 0, model.UNDEFINED.String(), []TeamMemberDTO{}, errors.New("Teams have not been splitted yet")
 	}
 
 	members, err := gtu.ur.FetchByTeamID(user.GetTeamID())
 	if err != nil {
-		return 0, model.UNDEFINED.String(), []string{}, err
+		return 0, model.UNDEFINED.String(), []TeamMemberDTO{}, err
 	}
-	memberNames := make([]string, 0, len(members)-1)
+	memberDTOs := make([]TeamMemberDTO, 0, len(members)-1)
 	for _, member := range members {
 		if member.GetUserID() == user.GetUserID() {
 			continue
 		}
-		memberNames = append(memberNames, member.GetName())
+		imageID := userImageID(gtu.uir, member.GetUserID(), member.GetTeamID())
+		memberDTOs = append(memberDTOs, TeamMemberDTO{
+			UserName: member.GetName(),
+			ImageURL: gtu.signer.SignedURL(imageID, core.ImageThumbnail),
+		})
 	}
-	return user.GetTeamID(), model.TeamColor(user.GetTeamID()).String(), memberNames, nil
+	return user.GetTeamID(), model.TeamColor(user.GetTeamID()).String(), memberDTOs, nil
 }
 
-func NewGetTeamInfoUsecase(ur IUserRepository) *GetTeamInfoUsecase {
+func NewGetTeamInfoUsecase(ur IUserRepository, uir IUserImageRepository, signer *ImageURLSigner) *GetTeamInfoUsecase {
 	return &GetTeamInfoUsecase{
-		ur: ur,
+		ur:     ur,
+		uir:    uir,
+		signer: signer,
 	}
 }
@@ -1,34 +1,81 @@
 package usecase
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 )
 
 const ImageFileExtension string = ".jpg"
 
+// 画像は存在していても見せられない場合があるので、取得できない場合と区別する
+var ErrImageForbidden = errors.New("You are not allowed to see this image")
+
+// largeは以前と同じ<画像ID>.jpg、他のサイズは<画像ID>.<サイズ>.jpgに保存する
+// 画像IDには.が含まれないので、サイズ毎のファイル名が他の画像IDと被ることは無い
+func imageFileName(imageID string, variant core.ImageVariant) string {
+	if variant == core.ImageLarge {
+		return imageID + ImageFileExtension
+	}
+	return imageID + "." + string(variant) + ImageFileExtension
+}
+
+// 写真が無い参加者は代わりの画像のIDを返す
+func userImageID(uir IUserImageRepository, uid uuid.UUID, teamID uint32) string {
+	imageID, err := uir.FetchByUserID(uid)
+	if err != nil {
+		return core.AvatarImageID(uid, teamID)
+	}
+	return imageID
+}
+
 type ImageDownloadUsecase struct {
+	gm  *core.GameManager
 	uir IUserImageRepository
+	as  *AvatarStore
 }
 
-func (idu *ImageDownloadUsecase) Execute(imagePath string, uid uuid.UUID) (string, error) {
-	if strings.HasSuffix(imagePath, "/") {
-		imageID, err := idu.uir.FetchByUserID(uid)
-		if err != nil {
-			return "", err
+// 今のゲームの参加者の画像か、本人の画像だけ見せる
+// 弾かれた参加者の画像や、以前のゲームの画像のIDを知っていても取得できないようにする
+func checkImageAccess(gm *core.GameManager, uir IUserImageRepository, imageID string, reqUserID uuid.UUID) error {
+	if imageID == "" || strings.ContainsAny(imageID, `/\.`) {
+		return errors.New("Invalid image ID")
+	}
+	owner, _, isAvatar := core.ParseAvatarImageID(imageID)
+	if !isAvatar {
+		var err error
+		if owner, err = uir.FetchUserIDByImageID(imageID); err != nil {
+			return ErrImageForbidden
 		}
-		imagePath = imagePath + imageID + ImageFileExtension
-		return imagePath, nil
-	} else if !strings.HasSuffix(imagePath, ImageFileExtension) {
-		return imagePath + ImageFileExtension, nil
+	}
+	if owner != reqUserID && !gm.IsParticipant(owner) {
+		return ErrImageForbidden
+	}
+	return nil
+}
+
+// 返した画像は呼び出し側でCloseする
+func (idu *ImageDownloadUsecase) Execute(imagePath string, user *model.User, variant core.ImageVariant) (*model.StoredImage, error) {
+	if strings.HasSuffix(imagePath, "/") {
+		imageID := userImageID(idu.uir, user.GetUserID(), user.GetTeamID())
+		return idu.as.Open(imageID, variant)
 	}
 
-	return imagePath, nil
+	imageID := strings.TrimSuffix(strings.TrimPrefix(imagePath, "/"), ImageFileExtension)
+	if err := checkImageAccess(idu.gm, idu.uir, imageID, user.GetUserID()); err != nil {
+		return nil, err
+	}
+	return idu.as.Open(imageID, variant)
 }
 
-func NewImageDownloadUsecase(uir IUserImageRepository) *ImageDownloadUsecase {
+func NewImageDownloadUsecase(gm *core.GameManager, uir IUserImageRepository, as *AvatarStore) *ImageDownloadUsecase {
 	return &ImageDownloadUsecase{
+		gm:  gm,
 		uir: uir,
+		as:  as,
 	}
 }
@@ -1,44 +1,79 @@
 package usecase
 
 import (
-	"fmt"
+	"errors"
 	"io"
-	"os"
 
 	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
 )
 
 type ImageUploadUsecase struct {
-	imgDirName string
-	uir        IUserImageRepository
+	is  IImageStore
+	gm  *core.GameManager
+	uir IUserImageRepository
+	ip  *core.ImageProcessor
 }
 
-func (iuu *ImageUploadUsecase) Execute(fileSrc io.Reader, uid uuid.UUID) error {
-	fileName, err := util.CreateRandStr(8)
+// 画像IDの全てのサイズのファイルを消す、既に無いファイルは無視する
+func removeImageFiles(is IImageStore, imageID string) error {
+	var errs []error
+	for _, variant := range core.ImageVariants {
+		if err := is.Delete(imageFileName(imageID, variant)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// 送られてきたバイト列はそのまま保存せず、画像として読めるか確認して作り直したJPEGを保存する
+// cropがnilの場合は、ブラウザで切り抜き済みの画像として全体を使う
+func (iuu *ImageUploadUsecase) Execute(fileSrc io.Reader, uid uuid.UUID, crop *core.ImageCrop) error {
+	variants, err := iuu.ip.Normalize(fileSrc, crop)
 	if err != nil {
 		return err
 	}
-	fileDest, err := os.Create(fmt.Sprintf("%s/%s.jpg", iuu.imgDirName, fileName))
+
+	// 撮り直しの場合は、新しい画像を保存できてから前の画像を消す
+	oldImageID, err := iuu.uir.FetchByUserID(uid)
 	if err != nil {
-		return err
+		oldImageID = ""
 	}
-	defer fileDest.Close()
 
-	if _, err := io.Copy(fileDest, fileSrc); err != nil {
+	fileName, err := util.CreateRandStr(8)
+	if err != nil {
 		return err
 	}
+	for variant, data := range variants {
+		if err := iuu.is.Put(imageFileName(fileName, variant), data); err != nil {
+			_ = removeImageFiles(iuu.is, fileName)
+			return err
+		}
+	}
 
 	if err = iuu.uir.Save(uid, fileName); err != nil {
+		_ = removeImageFiles(iuu.is, fileName)
 		return err
 	}
+	iuu.gm.ClearImageUploadRequest(uid)
 
+	if oldImageID != "" {
+		return removeImageFiles(iuu.is, oldImageID)
+	}
 	return nil
 }
 
-func NewImageUploadUsecase(imgDirName string, uir IUserImageRepository) *ImageUploadUsecase {
+func (iuu *ImageUploadUsecase) MaxUploadBytes() int64 {
+	return iuu.ip.MaxUploadBytes()
+}
+
+func NewImageUploadUsecase(is IImageStore, gm *core.GameManager, uir IUserImageRepository, ip *core.ImageProcessor) *ImageUploadUsecase {
 	return &ImageUploadUsecase{
-		imgDirName: imgDirName,
-		uir:        uir,
+		is:  is,
+		gm:  gm,
+		uir: uir,
+		ip:  ip,
 	}
 }
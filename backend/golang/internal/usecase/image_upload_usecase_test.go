@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// Saveの時に差し込む処理を指定できる画像リポジトリ
+type hookedImageOwners struct {
+	imageOwners
+	beforeSave func(uid uuid.UUID, imageID string) error
+}
+
+func (hio hookedImageOwners) Save(uid uuid.UUID, imageID string) error {
+	if err := hio.beforeSave(uid, imageID); err != nil {
+		return err
+	}
+	return hio.imageOwners.Save(uid, imageID)
+}
+
+func testJPEG(t *testing.T) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 30, 40)), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// キーと中身だけを持つ画像の保存先
+type storedFiles map[string][]byte
+
+func (sf storedFiles) Put(key string, data []byte) error {
+	sf[key] = data
+	return nil
+}
+
+func (sf storedFiles) Get(key string) (*model.StoredImage, error) {
+	data, ok := sf[key]
+	if !ok {
+		return nil, &fs.PathError{Op: "get", Path: key, Err: fs.ErrNotExist}
+	}
+	return model.NewStoredImage(key, int64(len(data)), time.Time{}, io.NopCloser(bytes.NewReader(data))), nil
+}
+
+func (sf storedFiles) Delete(key string) error {
+	delete(sf, key)
+	return nil
+}
+
+func (sf storedFiles) List(prefix string) ([]string, error) {
+	keys := make([]string, 0, len(sf))
+	for key := range sf {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func imageFilesExist(store storedFiles, imageID string) bool {
+	for _, variant := range core.ImageVariants {
+		if _, ok := store[imageFileName(imageID, variant)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func newTestImageUploadUsecase(store storedFiles, uir IUserImageRepository) (*ImageUploadUsecase, *core.GameManager) {
+	gm := core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules())
+	return NewImageUploadUsecase(store, gm, uir, core.NewImageProcessor(core.DefaultMaxUploadBytes, 100)), gm
+}
+
+// 撮り直しでは、新しい画像を全て保存先に置いて紐付けを差し替えてから前の画像を消す
+func TestImageUploadUsecaseReplacesAfterWritingNewImage(t *testing.T) {
+	store := storedFiles{}
+	uid := uuid.New()
+	owners := imageOwners{}
+	var oldImageID string
+	checked := false
+	uir := hookedImageOwners{imageOwners: owners, beforeSave: func(_ uuid.UUID, imageID string) error {
+		if oldImageID == "" {
+			return nil
+		}
+		checked = true
+		if !imageFilesExist(store, imageID) {
+			t.Error("new image was saved before its files were written")
+		}
+		if !imageFilesExist(store, oldImageID) {
+			t.Error("old image was removed before the new one was saved")
+		}
+		return nil
+	}}
+	iuu, _ := newTestImageUploadUsecase(store, uir)
+
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err != nil {
+		t.Fatal(err)
+	}
+	oldImageID, _ = owners.FetchByUserID(uid)
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !checked {
+		t.Fatal("replacement did not save a new image")
+	}
+
+	newImageID, _ := owners.FetchByUserID(uid)
+	if newImageID == oldImageID || !imageFilesExist(store, newImageID) {
+		t.Errorf("image %q was not replaced by a stored new image %q", oldImageID, newImageID)
+	}
+	if n := len(store); n != len(core.ImageVariants) {
+		t.Errorf("%d files are left, want only the new image's %d", n, len(core.ImageVariants))
+	}
+}
+
+// 紐付けの保存に失敗したら、前の画像を残して新しいファイルを片付ける
+func TestImageUploadUsecaseKeepsOldImageOnFailure(t *testing.T) {
+	store := storedFiles{}
+	uid := uuid.New()
+	owners := imageOwners{}
+	fail := false
+	uir := hookedImageOwners{imageOwners: owners, beforeSave: func(uuid.UUID, string) error {
+		if fail {
+			return errors.New("database is locked")
+		}
+		return nil
+	}}
+	iuu, _ := newTestImageUploadUsecase(store, uir)
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err != nil {
+		t.Fatal(err)
+	}
+	oldImageID, _ := owners.FetchByUserID(uid)
+
+	fail = true
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err == nil {
+		t.Fatal("Execute() error = nil, want the save error")
+	}
+	if current, _ := owners.FetchByUserID(uid); current != oldImageID || !imageFilesExist(store, oldImageID) {
+		t.Errorf("old image %q was lost, current image is %q", oldImageID, current)
+	}
+	if n := len(store); n != len(core.ImageVariants) {
+		t.Errorf("%d files are left, want only the old image's %d", n, len(core.ImageVariants))
+	}
+}
+
+func TestDeleteImageUsecase(t *testing.T) {
+	store := storedFiles{}
+	uid := uuid.New()
+	owners := imageOwners{}
+	iuu, _ := newTestImageUploadUsecase(store, owners)
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewDeleteImageUsecase(store, owners).Execute(uid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := owners.FetchByUserID(uid); err == nil {
+		t.Error("image is still linked to the user")
+	}
+	if n := len(store); n != 0 {
+		t.Errorf("%d files are left, want none", n)
+	}
+	if err := NewDeleteImageUsecase(store, owners).Execute(uid); err == nil {
+		t.Error("Execute() without an image error = nil, want error")
+	}
+}
+
+// 管理者が消した画像は配信されなくなり、撮り直すまで参加者に求め続ける
+func TestRemoveUserImageUsecaseRequestsNewPhoto(t *testing.T) {
+	store := storedFiles{}
+	uid := uuid.New()
+	owners := imageOwners{}
+	iuu, gm := newTestImageUploadUsecase(store, owners)
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err != nil {
+		t.Fatal(err)
+	}
+	removed, _ := owners.FetchByUserID(uid)
+
+	if err := NewRemoveUserImageUsecase(store, gm, owners).Execute(uid.String()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := owners.FetchUserIDByImageID(removed); err == nil {
+		t.Error("removed image can still be looked up")
+	}
+	if len(store) != 0 {
+		t.Error("files of the removed image are left")
+	}
+	if !gm.IsImageUploadRequested(uid) {
+		t.Error("a new photo was not requested")
+	}
+
+	if err := iuu.Execute(bytes.NewReader(testJPEG(t)), uid, nil); err != nil {
+		t.Fatal(err)
+	}
+	if gm.IsImageUploadRequested(uid) {
+		t.Error("the request was kept after a new photo was uploaded")
+	}
+}
+
+func TestRemoveUserImageUsecaseInvalidUser(t *testing.T) {
+	gm := core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules())
+	ruiu := NewRemoveUserImageUsecase(storedFiles{}, gm, imageOwners{})
+	if err := ruiu.Execute("not-a-uuid"); err == nil {
+		t.Error("Execute() with an invalid user ID error = nil, want error")
+	}
+	uid := uuid.New()
+	if err := ruiu.Execute(uid.String()); err == nil {
+		t.Error("Execute() for a user without an image error = nil, want error")
+	}
+	if gm.IsImageUploadRequested(uid) {
+		t.Error("a new photo was requested although nothing was removed")
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
+)
+
+// 署名付き画像URLのクエリパラメータ名
+const (
+	ImageURLExpiresKey   string = "exp"
+	ImageURLSignatureKey string = "sig"
+	ImageURLSizeKey      string = "size"
+)
+
+// <img>タグやUnityのテクスチャ読み込みはAuthorizationヘッダを付けにくいので、
+// 有効期限付きの署名をクエリに含めたURLを発行して、ヘッダ無しでも画像を取得できるようにする
+type ImageURLSigner struct {
+	secret []byte
+	ttl    time.Duration
+	// 署名付きURLで画像を返すパス（例: /xxxx/guest/images/）
+	basePath string
+}
+
+func signedImagePayload(imageID string, expires int64) string {
+	return fmt.Sprintf("%s|%d", imageID, expires)
+}
+
+// サイズは署名に含めないので、クライアントがsizeを付け替えて別のサイズを取得することもできる
+func (ius *ImageURLSigner) SignedURL(imageID string, variant core.ImageVariant) string {
+	expires := time.Now().Add(ius.ttl).Unix()
+	query := url.Values{}
+	if variant != core.ImageLarge {
+		query.Set(ImageURLSizeKey, string(variant))
+	}
+	query.Set(ImageURLExpiresKey, strconv.FormatInt(expires, 10))
+	query.Set(ImageURLSignatureKey, util.Sign(signedImagePayload(imageID, expires), ius.secret))
+	return ius.basePath + url.PathEscape(imageID) + "?" + query.Encode()
+}
+
+func (ius *ImageURLSigner) Verify(imageID string, expiresStr string, signature string) error {
+	expires, err := strconv.ParseInt(expiresStr, 10, 64)
+	if err != nil {
+		return errors.New("Invalid image URL")
+	}
+	if !util.VerifySignature(signedImagePayload(imageID, expires), signature, ius.secret) {
+		return errors.New("Invalid image URL")
+	}
+	if time.Now().Unix() > expires {
+		return errors.New("Image URL has expired")
+	}
+	return nil
+}
+
+func NewImageURLSigner(secret []byte, ttl time.Duration, basePath string) *ImageURLSigner {
+	return &ImageURLSigner{
+		secret:   secret,
+		ttl:      ttl,
+		basePath: basePath,
+	}
+}
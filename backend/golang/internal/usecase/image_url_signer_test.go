@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
+)
+
+const testImageBasePath = "/admin/guest/images/"
+
+// 画像IDから持ち主を引くだけの画像リポジトリ
+type imageOwners map[string]uuid.UUID
+
+func (io imageOwners) Save(uid uuid.UUID, imageID string) error {
+	_ = io.Delete(uid)
+	io[imageID] = uid
+	return nil
+}
+
+func (io imageOwners) FetchByUserID(uid uuid.UUID) (string, error) {
+	for imageID, owner := range io {
+		if owner == uid {
+			return imageID, nil
+		}
+	}
+	return "", errors.New("Image not found")
+}
+
+func (io imageOwners) Delete(uid uuid.UUID) error {
+	for imageID, owner := range io {
+		if owner == uid {
+			delete(io, imageID)
+		}
+	}
+	return nil
+}
+
+func (io imageOwners) FetchUserIDByImageID(imageID string) (uuid.UUID, error) {
+	owner, ok := io[imageID]
+	if !ok {
+		return uuid.Nil, errors.New("Image not found")
+	}
+	return owner, nil
+}
+
+func splitSignedURL(t *testing.T, signed string) (string, url.Values) {
+	t.Helper()
+	path, rawQuery, _ := strings.Cut(signed, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path, query
+}
+
+func TestImageURLSignerRoundTrip(t *testing.T) {
+	ius := NewImageURLSigner([]byte("secret"), time.Minute, testImageBasePath)
+	for _, imageID := range []string{"abc", "a b/c"} {
+		path, query := splitSignedURL(t, ius.SignedURL(imageID, core.ImageLarge))
+		if want := testImageBasePath + url.PathEscape(imageID); path != want {
+			t.Errorf("SignedURL(%q) path = %s, want %s", imageID, path, want)
+		}
+		expires, err := strconv.ParseInt(query.Get(ImageURLExpiresKey), 10, 64)
+		if err != nil || expires < time.Now().Unix() || expires > time.Now().Add(time.Minute).Unix() {
+			t.Errorf("SignedURL(%q) expires at %s, want within a minute", imageID, query.Get(ImageURLExpiresKey))
+		}
+		if err := ius.Verify(imageID, query.Get(ImageURLExpiresKey), query.Get(ImageURLSignatureKey)); err != nil {
+			t.Errorf("Verify() of a freshly signed URL error = %v", err)
+		}
+	}
+}
+
+// largeは以前と同じURL、他のサイズはsizeを付ける
+func TestImageURLSignerSignedURLSize(t *testing.T) {
+	ius := NewImageURLSigner([]byte("secret"), time.Minute, testImageBasePath)
+	for variant, want := range map[core.ImageVariant]string{core.ImageLarge: "", core.ImageMedium: "medium", core.ImageThumbnail: "thumbnail"} {
+		_, query := splitSignedURL(t, ius.SignedURL("abc", variant))
+		if got := query.Get(ImageURLSizeKey); got != want {
+			t.Errorf("SignedURL(%s) size = %q, want %q", variant, got, want)
+		}
+	}
+}
+
+// 画像ID、有効期限、署名のどれを書き換えても通らない
+func TestImageURLSignerVerifyRejectsTampering(t *testing.T) {
+	secret := []byte("secret")
+	ius := NewImageURLSigner(secret, time.Minute, testImageBasePath)
+	_, query := splitSignedURL(t, ius.SignedURL("abc", core.ImageLarge))
+	expires, signature := query.Get(ImageURLExpiresKey), query.Get(ImageURLSignatureKey)
+	later, _ := strconv.ParseInt(expires, 10, 64)
+
+	if err := ius.Verify("abd", expires, signature); err == nil {
+		t.Error("Verify() accepted the signature of another image")
+	}
+	if err := ius.Verify("abc", strconv.FormatInt(later+3600, 10), signature); err == nil {
+		t.Error("Verify() accepted an extended expiry")
+	}
+	if err := ius.Verify("abc", "tomorrow", signature); err == nil {
+		t.Error("Verify() accepted a non-numeric expiry")
+	}
+	if err := ius.Verify("abc", expires, ""); err == nil {
+		t.Error("Verify() accepted an empty signature")
+	}
+	if err := NewImageURLSigner([]byte("other"), time.Minute, testImageBasePath).Verify("abc", expires, signature); err == nil {
+		t.Error("Verify() accepted a URL signed with another secret")
+	}
+}
+
+func TestImageURLSignerVerifyExpired(t *testing.T) {
+	secret := []byte("secret")
+	past := time.Now().Add(-time.Second).Unix()
+	err := NewImageURLSigner(secret, time.Minute, testImageBasePath).Verify("abc", strconv.FormatInt(past, 10), util.Sign(signedImagePayload("abc", past), secret))
+	if err == nil || err.Error() != "Image URL has expired" {
+		t.Errorf("Verify() error = %v, want the URL to have expired", err)
+	}
+}
+
+// 署名が正しくても、今のゲームの参加者でない人の画像は返さない
+func TestSignedImageDownloadUsecaseChecksParticipant(t *testing.T) {
+	gm := core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules())
+	if _, err := gm.OpenLobby(); err != nil {
+		t.Fatal(err)
+	}
+	guest, outsider := uuid.New(), uuid.New()
+	if _, err := gm.JoinLobby(guest); err != nil {
+		t.Fatal(err)
+	}
+	signer := NewImageURLSigner([]byte("secret"), time.Minute, testImageBasePath)
+	store := storedFiles{"guest.jpg": []byte("guest"), "outsider.jpg": []byte("outsider")}
+	as := NewAvatarStore(store, core.NewImageProcessor(core.DefaultMaxUploadBytes, 100))
+	sidu := NewSignedImageDownloadUsecase(gm, imageOwners{"guest": guest, "outsider": outsider}, signer, as)
+	download := func(imageID string) (string, error) {
+		_, query := splitSignedURL(t, signer.SignedURL(imageID, core.ImageLarge))
+		img, err := sidu.Execute(imageID, query.Get(ImageURLExpiresKey), query.Get(ImageURLSignatureKey), core.ImageLarge)
+		if err != nil {
+			return "", err
+		}
+		defer img.Close()
+		return img.GetKey(), nil
+	}
+
+	if key, err := download("guest"); err != nil || key != "guest"+ImageFileExtension {
+		t.Errorf("Execute(guest) = (%q, %v), want the image", key, err)
+	}
+	if _, err := download("outsider"); !errors.Is(err, ErrImageForbidden) {
+		t.Errorf("Execute(outsider) error = %v, want ErrImageForbidden", err)
+	}
+	if _, err := sidu.Execute("guest", "0", "forged", core.ImageLarge); !errors.Is(err, ErrImageForbidden) {
+		t.Errorf("Execute() with a forged signature error = %v, want ErrImageForbidden", err)
+	}
+}
+
+// 本人の画像は参加者でなくても見られる
+func TestImageDownloadUsecaseAllowsOwnImage(t *testing.T) {
+	gm := core.NewGameManager(core.EntryLimits{}, 2, core.DefaultGameRules())
+	owner, err := model.NewUser("owner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := model.NewUser("other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := storedFiles{"mine.jpg": []byte("large"), "mine.thumbnail.jpg": []byte("thumbnail")}
+	idu := NewImageDownloadUsecase(gm, imageOwners{"mine": owner.GetUserID()}, NewAvatarStore(store, core.NewImageProcessor(core.DefaultMaxUploadBytes, 100)))
+	download := func(imagePath string, user *model.User, variant core.ImageVariant) (string, error) {
+		img, err := idu.Execute(imagePath, user, variant)
+		if err != nil {
+			return "", err
+		}
+		defer img.Close()
+		return img.GetKey(), nil
+	}
+	if key, err := download("/mine"+ImageFileExtension, owner, core.ImageLarge); err != nil || key != "mine"+ImageFileExtension {
+		t.Errorf("Execute() by the owner = (%q, %v), want the image", key, err)
+	}
+	if _, err := download("/mine"+ImageFileExtension, other, core.ImageLarge); !errors.Is(err, ErrImageForbidden) {
+		t.Errorf("Execute() by another user error = %v, want ErrImageForbidden", err)
+	}
+	if key, err := download("/mine"+ImageFileExtension, owner, core.ImageThumbnail); err != nil || key != "mine.thumbnail"+ImageFileExtension {
+		t.Errorf("Execute() of a thumbnail = (%q, %v), want the thumbnail", key, err)
+	}
+	if _, err := download("/../mine"+ImageFileExtension, owner, core.ImageLarge); err == nil {
+		t.Error("Execute() accepted a path with a parent directory")
+	}
+}
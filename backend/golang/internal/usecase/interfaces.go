@@ -17,14 +17,63 @@ type IUserRepository interface {
 type IUserImageRepository interface {
 	Save(uuid.UUID, string) error
 	FetchByUserID(uuid.UUID) (string, error)
+	FetchUserIDByImageID(string) (uuid.UUID, error)
+	Delete(uuid.UUID) error
 }
 
 type IUserProfileRepository interface {
 	Save(*model.UserProfile) error
 	FetchByProfileIDWithUserGroup(uint, []uuid.UUID) ([]model.UserProfile, error)
+	FetchByProfileID(uint) ([]model.UserProfile, error)
+	FetchByUserID(uuid.UUID) ([]model.UserProfile, error)
 }
 
 type IProfileQuestionRepository interface {
 	FetchByQuestionID(uint) (*model.ProfileQuestion, error)
 	FetchAllQuestions() ([]model.ProfileQuestion, error)
 }
+
+type IUserProfileRepositoryForAdmin interface {
+	IUserProfileRepository
+	FetchFlagged() ([]model.UserProfile, error)
+	Delete(uuid.UUID, uint) error
+}
+
+type IQuestionAssignmentRepository interface {
+	SaveAll(uuid.UUID, []uint) error
+	FetchByUserID(uuid.UUID) ([]model.QuestionAssignment, error)
+	SetSkipped(uuid.UUID, uint, bool) error
+}
+
+type IProfileQuestionRepositoryForAdmin interface {
+	IProfileQuestionRepository
+	Create(*model.ProfileQuestion) (uint, error)
+	Update(*model.ProfileQuestion) error
+	Delete(uint) error
+	Reorder([]uint) error
+}
+
+// 画像ファイルの保存先、キーは<画像ID>.jpgのようなファイル名
+// 無いキーを取得した場合はfs.ErrNotExistを返し、無いキーの削除はエラーにしない
+type IImageStore interface {
+	Put(key string, data []byte) error
+	Get(key string) (*model.StoredImage, error)
+	Delete(key string) error
+	List(prefix string) ([]string, error)
+}
+
+type IPlayerRepository interface {
+	Save(*model.Player) error
+	FetchByPlayerKey(string) (*model.Player, error)
+	FetchByPlayerID(uuid.UUID) (*model.Player, error)
+	Link(uuid.UUID, uuid.UUID) error
+	IsLinked(uuid.UUID) (bool, error)
+	FetchPlayerIDs([]uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+}
+
+type IHistoryRepository interface {
+	SaveGame(*model.GameRecord) error
+	FetchGames(limit int, offset int) ([]model.GameRecord, error)
+	FetchPlayerGames(uuid.UUID) ([]model.PlayerGameRecord, error)
+	FetchPairRecords(answerer uuid.UUID, targets []uuid.UUID) ([]model.PlayerPairRecord, error)
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 )
 
-const TickDuration time.Duration = 5 * time.Second
 const MaxFailedCount int = 3
 
 type JoinLobbyUsecase struct {
@@ -18,7 +17,7 @@ type JoinLobbyUsecase struct {
 func (jlu *JoinLobbyUsecase) Execute(
 	networkCtx context.Context,
 	uid uuid.UUID,
-	onTick func() error,
+	onTick func(imageUploadRequested bool) error,
 	doneCallback func(),
 	failedCallback func(error) error,
 ) error {
@@ -26,7 +25,7 @@ func (jlu *JoinLobbyUsecase) Execute(
 	if err != nil {
 		return failedCallback(err)
 	}
-	ticker := time.NewTicker(TickDuration)
+	ticker := time.NewTicker(jlu.gm.GetRules().LobbyTick)
 	defer ticker.Stop()
 	var onTickFailedCount int = 0
 	for {
@@ -38,7 +37,7 @@ func (jlu *JoinLobbyUsecase) Execute(
 			_ = jlu.gm.DisconnectLobby(uid)
 			return failedCallback(networkCtx.Err())
 		case <-ticker.C:
-			if err := onTick(); err != nil {
+			if err := onTick(jlu.gm.IsImageUploadRequested(uid)); err != nil {
 				onTickFailedCount++
 				if onTickFailedCount > MaxFailedCount {
 					_ = jlu.gm.DisconnectLobby(uid)
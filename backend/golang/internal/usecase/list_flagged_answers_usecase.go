@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+type FlaggedAnswerDTO struct {
+	UserID       uuid.UUID
+	UserName     string
+	QuestionID   uint
+	QuestionText string
+	Answer       string
+	MatchedTerm  string
+}
+
+type ListFlaggedAnswersUsecase struct {
+	ur  IUserRepository
+	upr IUserProfileRepositoryForAdmin
+	pqr IProfileQuestionRepository
+	mod *core.Moderator
+}
+
+func (lfau *ListFlaggedAnswersUsecase) Execute() ([]FlaggedAnswerDTO, error) {
+	profiles, err := lfau.upr.FetchFlagged()
+	if err != nil {
+		return nil, err
+	}
+	flagged := make([]FlaggedAnswerDTO, 0, len(profiles))
+	for _, profile := range profiles {
+		dto := FlaggedAnswerDTO{
+			UserID:     profile.GetUserID(),
+			QuestionID: profile.GetProfileID(),
+			Answer:     profile.GetAnswer(),
+		}
+		// 参加拒否されたユーザや削除された質問でも、一覧には残しておく
+		if user, err := lfau.ur.FetchByUserID(profile.GetUserID()); err == nil {
+			dto.UserName = user.GetName()
+		}
+		if question, err := lfau.pqr.FetchByQuestionID(profile.GetProfileID()); err == nil {
+			dto.QuestionText = question.GetQuestionText()
+		}
+		dto.MatchedTerm, _ = lfau.mod.Check(profile.GetAnswer())
+		flagged = append(flagged, dto)
+	}
+	return flagged, nil
+}
+
+func NewListFlaggedAnswersUsecase(ur IUserRepository, upr IUserProfileRepositoryForAdmin, pqr IProfileQuestionRepository, mod *core.Moderator) *ListFlaggedAnswersUsecase {
+	return &ListFlaggedAnswersUsecase{
+		ur:  ur,
+		upr: upr,
+		pqr: pqr,
+		mod: mod,
+	}
+}
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type MyProfileDTO struct {
+	QuestionID   uint
+	QuestionText string
+	Answer       string
+	IsAnswered   bool
+	IsOptional   bool
+	IsSkipped    bool
+}
+
+type ListMyProfileUsecase struct {
+	gm *core.GameManager
+	qn *Questionnaire
+}
+
+// 回答の一覧と、まだ編集できるかどうかを返す
+func (lmpu *ListMyProfileUsecase) Execute(user *model.User) ([]MyProfileDTO, bool, error) {
+	answers, err := lmpu.qn.answers(user.GetUserID())
+	if err != nil {
+		return nil, false, err
+	}
+	return answers, checkProfileEditable(lmpu.gm) == nil, nil
+}
+
+func NewListMyProfileUsecase(gm *core.GameManager, qn *Questionnaire) *ListMyProfileUsecase {
+	return &ListMyProfileUsecase{
+		gm: gm,
+		qn: qn,
+	}
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import "github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+
+type ListQuestionsUsecase struct {
+	pqr IProfileQuestionRepository
+}
+
+func (lqu *ListQuestionsUsecase) Execute() ([]model.ProfileQuestion, error) {
+	return lqu.pqr.FetchAllQuestions()
+}
+
+func NewListQuestionsUsecase(pqr IProfileQuestionRepository) *ListQuestionsUsecase {
+	return &ListQuestionsUsecase{
+		pqr: pqr,
+	}
+}
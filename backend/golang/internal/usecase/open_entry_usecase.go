@@ -15,7 +15,7 @@ type OpenEntryUsecase struct {
 
 func (oeu *OpenEntryUsecase) Execute(
 	networkCtx context.Context,
-	onTick func([]model.User) error,
+	onTick func([]model.User, core.EntryLimits) error,
 	doneCallback func(),
 	failedCallback func(error) error,
 ) error {
@@ -23,7 +23,7 @@ func (oeu *OpenEntryUsecase) Execute(
 	if err != nil {
 		return failedCallback(err)
 	}
-	ticker := time.NewTicker(TickDuration)
+	ticker := time.NewTicker(oeu.gm.GetRules().LobbyTick)
 	defer ticker.Stop()
 	var onTickFailedCount int = 0
 	for {
@@ -35,14 +35,14 @@ func (oeu *OpenEntryUsecase) Execute(
 			// users取得をselectの前に出して共通化すると情報が古くなってしまうのでコピペで
 			uids := oeu.gm.GetLobbyUsers()
 			users, _ := oeu.ur.FetchByUserIDs(uids)
-			_ = onTick(users)
+			_ = onTick(users, oeu.gm.GetEntryLimits())
 			return nil
 		case <-networkCtx.Done():
 			return failedCallback(networkCtx.Err())
 		case <-ticker.C:
 			uids := oeu.gm.GetLobbyUsers()
 			users, _ := oeu.ur.FetchByUserIDs(uids)
-			if err := onTick(users); err != nil {
+			if err := onTick(users, oeu.gm.GetEntryLimits()); err != nil {
 				onTickFailedCount++
 				if onTickFailedCount > MaxFailedCount {
 					return failedCallback(err)
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 参加者毎の質問の割り当てと回答の進み具合を扱う
+// RegistProfileUsecaseとGetNextQuestionUsecaseで共有する
+type Questionnaire struct {
+	pqr    IProfileQuestionRepository
+	upr    IUserProfileRepository
+	qar    IQuestionAssignmentRepository
+	policy core.QuestionnairePolicy
+}
+
+// まだ割り当てが無い場合はここで決めて保存する
+func (q *Questionnaire) assignments(uid uuid.UUID) ([]model.QuestionAssignment, error) {
+	assignments, err := q.qar.FetchByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+	if len(assignments) > 0 {
+		return assignments, nil
+	}
+	questions, err := q.pqr.FetchAllQuestions()
+	if err != nil {
+		return nil, err
+	}
+	questionIDs := make([]uint, 0, len(questions))
+	for _, question := range questions {
+		questionIDs = append(questionIDs, question.GetQuestionID())
+	}
+	if err := q.qar.SaveAll(uid, q.policy.Pick(questionIDs)); err != nil {
+		// 同時に呼ばれて先に保存された場合は、そちらの割り当てを使う
+		if assignments, fetchErr := q.qar.FetchByUserID(uid); fetchErr == nil && len(assignments) > 0 {
+			return assignments, nil
+		}
+		return nil, err
+	}
+	return q.qar.FetchByUserID(uid)
+}
+
+func (q *Questionnaire) findAssignment(uid uuid.UUID, questionID uint) (*model.QuestionAssignment, error) {
+	assignments, err := q.assignments(uid)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.IndexFunc(assignments, func(a model.QuestionAssignment) bool {
+		return a.GetQuestionID() == questionID
+	})
+	if idx < 0 {
+		return nil, errors.New("This question is not assigned to you")
+	}
+	return &assignments[idx], nil
+}
+
+// 割り当てられた質問のうち、回答もスキップもしていない最初の質問を返す
+func (q *Questionnaire) next(uid uuid.UUID) (ProfileQuestionDTO, error) {
+	assignments, err := q.assignments(uid)
+	if err != nil {
+		return ProfileQuestionDTO{}, err
+	}
+	profiles, err := q.upr.FetchByUserID(uid)
+	if err != nil {
+		return ProfileQuestionDTO{}, err
+	}
+	answered := make(map[uint]bool, len(profiles))
+	for _, profile := range profiles {
+		answered[profile.GetProfileID()] = true
+	}
+	var pending *model.QuestionAssignment
+	answeredCount := 0
+	for i, assignment := range assignments {
+		if assignment.IsSkipped() || answered[assignment.GetQuestionID()] {
+			answeredCount++
+			continue
+		}
+		if pending == nil {
+			pending = &assignments[i]
+		}
+	}
+	if pending == nil {
+		return ProfileQuestionDTO{
+			QuestionText:  NoMoreQuestionText,
+			NoMoreAnswer:  true,
+			AnsweredCount: answeredCount,
+			TotalCount:    len(assignments),
+		}, nil
+	}
+	question, err := q.pqr.FetchByQuestionID(pending.GetQuestionID())
+	if err != nil {
+		return ProfileQuestionDTO{}, err
+	}
+	return ProfileQuestionDTO{
+		QuestionID:    question.GetQuestionID(),
+		QuestionText:  question.GetQuestionText(),
+		NoMoreAnswer:  false,
+		IsOptional:    question.IsOptional(),
+		AnsweredCount: answeredCount,
+		TotalCount:    len(assignments),
+	}, nil
+}
+
+// 割り当てられた質問を回答順に、回答やスキップの状態と合わせて返す
+func (q *Questionnaire) answers(uid uuid.UUID) ([]MyProfileDTO, error) {
+	assignments, err := q.assignments(uid)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := q.upr.FetchByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+	answerMap := make(map[uint]string, len(profiles))
+	for _, profile := range profiles {
+		answerMap[profile.GetProfileID()] = profile.GetAnswer()
+	}
+	answers := make([]MyProfileDTO, 0, len(assignments))
+	for _, assignment := range assignments {
+		question, err := q.pqr.FetchByQuestionID(assignment.GetQuestionID())
+		if err != nil {
+			return nil, err
+		}
+		answer, isAnswered := answerMap[assignment.GetQuestionID()]
+		answers = append(answers, MyProfileDTO{
+			QuestionID:   question.GetQuestionID(),
+			QuestionText: question.GetQuestionText(),
+			Answer:       answer,
+			IsAnswered:   isAnswered,
+			IsOptional:   question.IsOptional(),
+			IsSkipped:    assignment.IsSkipped(),
+		})
+	}
+	return answers, nil
+}
+
+func NewQuestionnaire(
+	pqr IProfileQuestionRepository,
+	upr IUserProfileRepository,
+	qar IQuestionAssignmentRepository,
+	policy core.QuestionnairePolicy,
+) *Questionnaire {
+	return &Questionnaire{
+		pqr:    pqr,
+		upr:    upr,
+		qar:    qar,
+		policy: policy,
+	}
+}
@@ -1,16 +1,22 @@
 package usecase
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 )
 
 const NoMoreQuestionText string = "これで質問は全て終わりです。\n御回答ありがとうございました。\nゲーム開始まで暫くお待ちください。"
 
 type ProfileQuestionDTO struct {
-	QuestionID   uint
-	QuestionText string
-	NoMoreAnswer bool
+	QuestionID    uint
+	QuestionText  string
+	NoMoreAnswer  bool
+	IsOptional    bool
+	AnsweredCount int
+	TotalCount    int
 }
 
 type UserProfileDTO struct {
@@ -24,39 +30,60 @@ func (profile UserProfileDTO) ToUserProfileModel() (*model.UserProfile, error) {
 }
 
 type RegistProfileUsecase struct {
+	gm  *core.GameManager
 	pqr IProfileQuestionRepository
 	upr IUserProfileRepository
+	qar IQuestionAssignmentRepository
+	qn  *Questionnaire
+	mod *core.Moderator
 }
 
-func (rpu *RegistProfileUsecase) Execute(profile UserProfileDTO) (ProfileQuestionDTO, error) {
+// ProfileIDが0の場合は回答を保存せず、最初の質問を返す
+func (rpu *RegistProfileUsecase) Execute(profile UserProfileDTO, skip bool) (ProfileQuestionDTO, error) {
+	if profile.ProfileID == 0 {
+		return rpu.qn.next(profile.UserID)
+	}
+	if err := checkProfileEditable(rpu.gm); err != nil {
+		return ProfileQuestionDTO{}, err
+	}
+	if _, err := rpu.qn.findAssignment(profile.UserID, profile.ProfileID); err != nil {
+		return ProfileQuestionDTO{}, err
+	}
+	if skip {
+		question, err := rpu.pqr.FetchByQuestionID(profile.ProfileID)
+		if err != nil {
+			return ProfileQuestionDTO{}, err
+		}
+		if !question.IsOptional() {
+			return ProfileQuestionDTO{}, errors.New("This question cannot be skipped")
+		}
+		if err = rpu.qar.SetSkipped(profile.UserID, profile.ProfileID, true); err != nil {
+			return ProfileQuestionDTO{}, err
+		}
+		return rpu.qn.next(profile.UserID)
+	}
 	up, err := profile.ToUserProfileModel()
 	if err != nil {
 		return ProfileQuestionDTO{}, err
 	}
+	// 誤検知もあり得るので回答自体は受け付けて、管理者の確認待ちにする
+	if _, found := rpu.mod.Check(up.GetAnswer()); found {
+		up.Flag()
+	}
 	err = rpu.upr.Save(up)
 	if err != nil {
 		return ProfileQuestionDTO{}, err
 	}
-	nextQuestionID := profile.ProfileID + 1
-	question, err := rpu.pqr.FetchByQuestionID(nextQuestionID)
-	if err != nil {
-		// Repositoryに次のIDがない＝今のAnswerが最後の質問のもの
-		return ProfileQuestionDTO{
-			QuestionID:   nextQuestionID,
-			QuestionText: NoMoreQuestionText,
-			NoMoreAnswer: true,
-		}, nil
-	}
-	return ProfileQuestionDTO{
-		QuestionID:   question.GetQuestionID(),
-		QuestionText: question.GetQuestionText(),
-		NoMoreAnswer: false,
-	}, nil
+	return rpu.qn.next(profile.UserID)
 }
 
-func NewRegistProfileUsecase(pqr IProfileQuestionRepository, upr IUserProfileRepository) *RegistProfileUsecase {
+func NewRegistProfileUsecase(gm *core.GameManager, pqr IProfileQuestionRepository, upr IUserProfileRepository, qar IQuestionAssignmentRepository, qn *Questionnaire, mod *core.Moderator) *RegistProfileUsecase {
 	return &RegistProfileUsecase{
+		gm:  gm,
 		pqr: pqr,
 		upr: upr,
+		qar: qar,
+		qn:  qn,
+		mod: mod,
 	}
 }
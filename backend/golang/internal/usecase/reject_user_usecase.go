@@ -25,6 +25,8 @@ func (ruu *RejectUserUsecase) Execute(userIDStr string) error {
 	if err = ruu.ur.RemoveUser(uid); err != nil {
 		return err
 	}
+	// 拒否した参加者の分は上限の枠を空ける
+	ruu.gm.ReleaseEntry(uid)
 
 	if err = ruu.gm.DisconnectLobby(uid); err != nil {
 		return nil
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// DOTの文字列リテラルとして使えるようにエスケープする
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+func teamColorHex(tid uint32) string {
+	c := model.TeamColor(tid).RGBA()
+	return fmt.Sprintf("#%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// 明るいチームカラーの上では黒い文字の方が読みやすい
+func teamFontColor(tid uint32) string {
+	c := model.TeamColor(tid).RGBA()
+	if 299*int(c.R)+587*int(c.G)+114*int(c.B) > 150000 {
+		return "black"
+	}
+	return "white"
+}
+
+func (g RelationshipGraphDTO) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// Graphvizで描けるように、参加者をチームカラーのノード、回答した人から出題対象の人への正解数を辺にする
+// 正解率が高いほど辺を太くし、一度も正解しなかった辺は点線にする
+func (g RelationshipGraphDTO) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph relationships {\n")
+	b.WriteString("  graph [rankdir=LR];\n")
+	b.WriteString("  node [shape=ellipse, style=filled];\n")
+	for _, n := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s, fillcolor=%s, fontcolor=%s];\n",
+			dotQuote(n.UserID), dotQuote(n.UserName), dotQuote(teamColorHex(n.TeamID)), teamFontColor(n.TeamID))
+	}
+	for _, e := range g.Edges {
+		style := "solid"
+		if e.CorrectCount == 0 {
+			style = "dashed"
+		}
+		fmt.Fprintf(&b, "  %s -> %s [label=%s, penwidth=%.1f, style=%s];\n",
+			dotQuote(e.AnswererID), dotQuote(e.TargetID), dotQuote(fmt.Sprintf("%d/%d", e.CorrectCount, e.AnsweredCount)), 1+4*e.CorrectRate, style)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDotQuote(t *testing.T) {
+	for s, want := range map[string]string{
+		"Alice":                  `"Alice"`,
+		"":                       `""`,
+		"山田 太郎":                  `"山田 太郎"`,
+		`say "hi"`:               `"say \"hi\""`,
+		`a\b`:                    `"a\\b"`,
+		`a\"];`:                  `"a\\\"];"`,
+		"a\nb":                   `"a\nb"`,
+		"x -> y [color=red]; {}": `"x -> y [color=red]; {}"`,
+	} {
+		if got := dotQuote(s); got != want {
+			t.Errorf("dotQuote(%q) = %s, want %s", s, got, want)
+		}
+	}
+}
+
+// 名前に何が入っていても、ノードの定義が１行に収まり属性を書き換えられないこと
+func TestRelationshipGraphDOTEscapesNames(t *testing.T) {
+	g := RelationshipGraphDTO{
+		Nodes: []RelationshipNodeDTO{{UserID: "u1", UserName: "evil\", fillcolor=\"red\"\n}", TeamID: 1}},
+		Edges: []RelationshipEdgeDTO{},
+	}
+	lines := strings.Split(strings.TrimSpace(g.DOT()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("DOT() has %d lines, want 5:\n%s", len(lines), g.DOT())
+	}
+	if want := `  "u1" [label="evil\", fillcolor=\"red\"\n}", fillcolor=`; !strings.HasPrefix(lines[3], want) {
+		t.Errorf("node line = %s, want prefix %s", lines[3], want)
+	}
+}
+
+// 一度も正解しなかった辺は点線、正解率が高いほど太くする
+func TestRelationshipGraphDOTEdges(t *testing.T) {
+	g := RelationshipGraphDTO{
+		Nodes: []RelationshipNodeDTO{},
+		Edges: []RelationshipEdgeDTO{
+			{AnswererID: "a", TargetID: "b", AnsweredCount: 2, CorrectCount: 2, CorrectRate: 1},
+			{AnswererID: "b", TargetID: "a", AnsweredCount: 3},
+		},
+	}
+	dot := g.DOT()
+	for _, want := range []string{
+		`  "a" -> "b" [label="2/2", penwidth=5.0, style=solid];`,
+		`  "b" -> "a" [label="0/3", penwidth=1.0, style=dashed];`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT() does not contain %s:\n%s", want, dot)
+		}
+	}
+
+	data, err := g.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string][]map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if edge := decoded["edges"][0]; edge["source"] != "a" || edge["target"] != "b" {
+		t.Errorf("JSON() edge = %v, want source a and target b", edge)
+	}
+}
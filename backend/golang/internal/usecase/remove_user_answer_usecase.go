@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+type RemoveUserAnswerUsecase struct {
+	gm  *core.GameManager
+	upr IUserProfileRepositoryForAdmin
+}
+
+// 削除された回答はクイズにも選択肢にも使われない
+// 参加登録の受付中であれば、参加者は同じ質問にもう一度回答することになる
+func (ruau *RemoveUserAnswerUsecase) Execute(userIDStr string, questionID uint) error {
+	if err := checkAnswersModeratable(ruau.gm); err != nil {
+		return err
+	}
+	uid, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return err
+	}
+	return ruau.upr.Delete(uid, questionID)
+}
+
+func NewRemoveUserAnswerUsecase(gm *core.GameManager, upr IUserProfileRepositoryForAdmin) *RemoveUserAnswerUsecase {
+	return &RemoveUserAnswerUsecase{
+		gm:  gm,
+		upr: upr,
+	}
+}
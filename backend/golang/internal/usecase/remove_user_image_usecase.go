@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+// 管理者が不適切な画像を消して、参加者に撮り直しを求める
+type RemoveUserImageUsecase struct {
+	is  IImageStore
+	gm  *core.GameManager
+	uir IUserImageRepository
+}
+
+func (ruiu *RemoveUserImageUsecase) Execute(userIDStr string) error {
+	uid, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return err
+	}
+	imageID, err := ruiu.uir.FetchByUserID(uid)
+	if err != nil {
+		return err
+	}
+	if err := ruiu.uir.Delete(uid); err != nil {
+		return err
+	}
+	ruiu.gm.RequestImageUpload(uid)
+	return removeImageFiles(ruiu.is, imageID)
+}
+
+func NewRemoveUserImageUsecase(is IImageStore, gm *core.GameManager, uir IUserImageRepository) *RemoveUserImageUsecase {
+	return &RemoveUserImageUsecase{
+		is:  is,
+		gm:  gm,
+		uir: uir,
+	}
+}
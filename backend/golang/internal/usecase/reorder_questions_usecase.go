@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type ReorderQuestionsUsecase struct {
+	gm  *core.GameManager
+	pqr IProfileQuestionRepositoryForAdmin
+}
+
+func (rqu *ReorderQuestionsUsecase) Execute(questionIDs []uint) ([]model.ProfileQuestion, error) {
+	if err := editQuestions(rqu.gm, func() error {
+		questions, err := rqu.pqr.FetchAllQuestions()
+		if err != nil {
+			return err
+		}
+		// 全ての質問を過不足なく１回ずつ指定しているか確認
+		currentIDs := make([]uint, 0, len(questions))
+		for _, q := range questions {
+			currentIDs = append(currentIDs, q.GetQuestionID())
+		}
+		sortedIDs := slices.Clone(questionIDs)
+		slices.Sort(currentIDs)
+		slices.Sort(sortedIDs)
+		if !slices.Equal(currentIDs, sortedIDs) {
+			return errors.New("Question IDs must contain every question exactly once")
+		}
+		return rqu.pqr.Reorder(questionIDs)
+	}); err != nil {
+		return nil, err
+	}
+	return rqu.pqr.FetchAllQuestions()
+}
+
+func NewReorderQuestionsUsecase(gm *core.GameManager, pqr IProfileQuestionRepositoryForAdmin) *ReorderQuestionsUsecase {
+	return &ReorderQuestionsUsecase{
+		gm:  gm,
+		pqr: pqr,
+	}
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Excelで開いても文字化けしないように、CSVの先頭にBOMを付ける
+const csvBOM string = "\uFEFF"
+
+func writeZipCSV(zw *zip.Writer, name string, header []string, rows [][]string) error {
+	f, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(f, csvBOM); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(f)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func formatBool(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+func formatRate(r float32) string {
+	return strconv.FormatFloat(float64(r), 'f', 4, 32)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatChoice(c SessionChoiceDTO) string {
+	if c.ChoiceID == 0 {
+		return ""
+	}
+	return c.ChoiceText
+}
+
+// session.jsonに全てを含め、表計算ソフトで見やすいように表毎のCSVも付ける
+// 削除済みなどで取り出せない画像は飛ばす
+func writeSessionArchive(w io.Writer, export *SessionExportDTO, openImage func(string) (io.ReadCloser, error)) error {
+	zw := zip.NewWriter(w)
+
+	f, err := zw.Create("session.json")
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(export); err != nil {
+		return err
+	}
+
+	users := make([][]string, 0, len(export.Users))
+	for _, u := range export.Users {
+		users = append(users, []string{u.UserID, u.UserName, strconv.FormatUint(uint64(u.TeamID), 10), u.TeamColor, formatBool(u.IsReady), u.ImageFile})
+	}
+	if err := writeZipCSV(zw, "users.csv", []string{"user_id", "user_name", "team_id", "team_color", "is_ready", "image_file"}, users); err != nil {
+		return err
+	}
+
+	teams := make([][]string, 0, len(export.Teams))
+	for _, t := range export.Teams {
+		teams = append(teams, []string{strconv.FormatUint(uint64(t.TeamID), 10), t.TeamColor, strings.Join(t.MemberIDs, " "), strconv.Itoa(t.CorrectCount), strconv.Itoa(t.Points), formatRate(t.CorrectRate), strconv.Itoa(t.Order)})
+	}
+	if err := writeZipCSV(zw, "teams.csv", []string{"team_id", "team_color", "member_ids", "correct_count", "points", "correct_rate", "order"}, teams); err != nil {
+		return err
+	}
+
+	profiles := make([][]string, 0, len(export.ProfileAnswers))
+	for _, p := range export.ProfileAnswers {
+		profiles = append(profiles, []string{p.UserID, p.UserName, strconv.FormatUint(uint64(p.QuestionID), 10), p.QuestionText, p.Answer, formatBool(p.IsFlagged)})
+	}
+	if err := writeZipCSV(zw, "profile_answers.csv", []string{"user_id", "user_name", "question_id", "question_text", "answer", "is_flagged"}, profiles); err != nil {
+		return err
+	}
+
+	quizzes := make([][]string, 0, len(export.Quizzes))
+	teamAnswers := make([][]string, 0)
+	answers := make([][]string, 0)
+	for _, q := range export.Quizzes {
+		choices := make([]string, 0, len(q.Choices))
+		for _, c := range q.Choices {
+			choices = append(choices, c.ChoiceText)
+		}
+		number := strconv.Itoa(q.Number)
+		quizzes = append(quizzes, []string{number, q.TargetUserID, q.TargetUserName, strconv.FormatUint(uint64(q.TargetTeamID), 10), strconv.FormatUint(uint64(q.QuestionID), 10), q.QuestionText, strings.Join(choices, " / "), formatChoice(q.CorrectChoice), q.Hint, formatTime(q.StartedAt), formatBool(q.IsChecked)})
+		for _, ta := range q.TeamAnswers {
+			teamAnswers = append(teamAnswers, []string{number, strconv.FormatUint(uint64(ta.TeamID), 10), ta.TeamColor, formatChoice(ta.Answer), formatBool(ta.IsCorrect), formatBool(ta.IsDoublePoints), strings.Join(ta.Lifelines, " ")})
+		}
+		for _, a := range q.Answers {
+			answers = append(answers, []string{number, a.UserID, a.UserName, strconv.FormatUint(uint64(a.TeamID), 10), formatChoice(a.Answer), formatBool(a.IsCorrect), strconv.FormatInt(a.ResponseTimeMs, 10), formatTime(a.AnsweredAt)})
+		}
+	}
+	if err := writeZipCSV(zw, "quizzes.csv", []string{"number", "target_user_id", "target_user_name", "target_team_id", "question_id", "question_text", "choices", "correct_choice", "hint", "started_at", "is_checked"}, quizzes); err != nil {
+		return err
+	}
+	if err := writeZipCSV(zw, "team_answers.csv", []string{"quiz_number", "team_id", "team_color", "answer", "is_correct", "is_double_points", "lifelines"}, teamAnswers); err != nil {
+		return err
+	}
+	if err := writeZipCSV(zw, "answers.csv", []string{"quiz_number", "user_id", "user_name", "team_id", "answer", "is_correct", "response_time_ms", "answered_at"}, answers); err != nil {
+		return err
+	}
+
+	stats := make([][]string, 0, len(export.UserStats))
+	for _, s := range export.UserStats {
+		stats = append(stats, []string{s.UserID, s.UserName, strconv.FormatUint(uint64(s.TeamID), 10), strconv.Itoa(s.AnsweredCount), strconv.Itoa(s.CorrectCount), formatRate(s.CorrectRate), strconv.FormatInt(s.AverageResponseTimeMs, 10), strconv.Itoa(s.Order)})
+	}
+	if err := writeZipCSV(zw, "user_stats.csv", []string{"user_id", "user_name", "team_id", "answered_count", "correct_count", "correct_rate", "average_response_time_ms", "order"}, stats); err != nil {
+		return err
+	}
+
+	if err := writeRelationships(zw, export); err != nil {
+		return err
+	}
+
+	for _, u := range export.Users {
+		if u.ImageFile == "" {
+			continue
+		}
+		if err := copyImageToZip(zw, u.ImageFile, openImage); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+// 誰が誰を知っていたかの表と、そのままグラフにできるJSONとDOT
+func writeRelationships(zw *zip.Writer, export *SessionExportDTO) error {
+	names := make(map[string]string, len(export.Analytics.Graph.Nodes))
+	for _, n := range export.Analytics.Graph.Nodes {
+		names[n.UserID] = n.UserName
+	}
+	relationships := make([][]string, 0, len(export.Analytics.Graph.Edges))
+	for _, e := range export.Analytics.Graph.Edges {
+		relationships = append(relationships, []string{e.AnswererID, names[e.AnswererID], e.TargetID, names[e.TargetID], strconv.Itoa(e.AnsweredCount), strconv.Itoa(e.CorrectCount), formatRate(e.CorrectRate)})
+	}
+	if err := writeZipCSV(zw, "relationships.csv", []string{"answerer_id", "answerer_name", "target_id", "target_name", "answered_count", "correct_count", "correct_rate"}, relationships); err != nil {
+		return err
+	}
+
+	graphJSON, err := export.Analytics.Graph.JSON()
+	if err != nil {
+		return err
+	}
+	f, err := zw.Create("relationships.json")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(graphJSON); err != nil {
+		return err
+	}
+	f, err = zw.Create("relationships.dot")
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, export.Analytics.Graph.DOT())
+	return err
+}
+
+func copyImageToZip(zw *zip.Writer, path string, openImage func(string) (io.ReadCloser, error)) error {
+	img, err := openImage(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	defer img.Close()
+	// JPEGは既に圧縮されているので、圧縮せずに格納する
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: path, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, img)
+	return err
+}
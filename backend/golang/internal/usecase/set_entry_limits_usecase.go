@@ -0,0 +1,28 @@
+package usecase
+
+import "github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+
+type SetEntryLimitsUsecase struct {
+	gm *core.GameManager
+}
+
+// nilの項目は現在の値のまま、変更後の値を返す
+func (selu *SetEntryLimitsUsecase) Execute(expectedUserNum *int, maxUserNum *int) (core.EntryLimits, error) {
+	limits := selu.gm.GetEntryLimits()
+	if expectedUserNum != nil {
+		limits.ExpectedUserNum = *expectedUserNum
+	}
+	if maxUserNum != nil {
+		limits.MaxUserNum = *maxUserNum
+	}
+	if err := selu.gm.SetEntryLimits(limits); err != nil {
+		return core.EntryLimits{}, err
+	}
+	return selu.gm.GetEntryLimits(), nil
+}
+
+func NewSetEntryLimitsUsecase(gm *core.GameManager) *SetEntryLimitsUsecase {
+	return &SetEntryLimitsUsecase{
+		gm: gm,
+	}
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import "github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+
+type SetRulesUsecase struct {
+	gm *core.GameManager
+}
+
+// 検証に通った場合のみ反映し、反映後のルールを返す
+func (sru *SetRulesUsecase) Execute(rules core.GameRules) (core.GameRules, error) {
+	if err := sru.gm.SetRules(rules); err != nil {
+		return core.GameRules{}, err
+	}
+	return sru.gm.GetRules(), nil
+}
+
+func NewSetRulesUsecase(gm *core.GameManager) *SetRulesUsecase {
+	return &SetRulesUsecase{
+		gm: gm,
+	}
+}
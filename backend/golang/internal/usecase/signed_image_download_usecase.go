@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+// 認証ヘッダの代わりにURLの署名で画像の取得を許可する
+type SignedImageDownloadUsecase struct {
+	gm     *core.GameManager
+	uir    IUserImageRepository
+	signer *ImageURLSigner
+	as     *AvatarStore
+}
+
+func (sidu *SignedImageDownloadUsecase) Execute(imageID string, expires string, signature string, variant core.ImageVariant) (*model.StoredImage, error) {
+	if err := sidu.signer.Verify(imageID, expires, signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImageForbidden, err)
+	}
+	// 署名が有効でも、発行後に弾かれた参加者の画像は見せない
+	if err := checkImageAccess(sidu.gm, sidu.uir, imageID, uuid.Nil); err != nil {
+		return nil, err
+	}
+	return sidu.as.Open(imageID, variant)
+}
+
+func NewSignedImageDownloadUsecase(gm *core.GameManager, uir IUserImageRepository, signer *ImageURLSigner, as *AvatarStore) *SignedImageDownloadUsecase {
+	return &SignedImageDownloadUsecase{
+		gm:     gm,
+		uir:    uir,
+		signer: signer,
+		as:     as,
+	}
+}
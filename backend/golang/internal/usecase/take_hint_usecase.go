@@ -1,21 +1,28 @@
 package usecase
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 )
 
 type TakeHintUsecase struct {
-	gm *core.GameManager
+	gm  *core.GameManager
+	mod *core.Moderator
 }
 
 func (thu *TakeHintUsecase) Execute(uid uuid.UUID, hint string) error {
+	if _, found := thu.mod.Check(hint); found {
+		return errors.New("Your hint contains a prohibited word")
+	}
 	return thu.gm.TakeHint(uid, hint)
 }
 
-func NewTakeHintUsecase(gm *core.GameManager) *TakeHintUsecase {
+func NewTakeHintUsecase(gm *core.GameManager, mod *core.Moderator) *TakeHintUsecase {
 	return &TakeHintUsecase{
-		gm: gm,
+		gm:  gm,
+		mod: mod,
 	}
-}
\ No newline at end of file
+}
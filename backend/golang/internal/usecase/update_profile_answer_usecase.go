@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+)
+
+// チーム分けやクイズの作成は締め切り時点の回答を元にするので、締め切り後は編集できない
+func checkProfileEditable(gm *core.GameManager) error {
+	if gm.GetState() > core.ACCEPTING {
+		return errors.New("Profile answers cannot be edited after entry has been closed")
+	}
+	return nil
+}
+
+type UpdateProfileAnswerUsecase struct {
+	gm  *core.GameManager
+	upr IUserProfileRepository
+	qar IQuestionAssignmentRepository
+	qn  *Questionnaire
+	mod *core.Moderator
+}
+
+// スキップした質問に回答した場合は、スキップを取り消す
+func (upau *UpdateProfileAnswerUsecase) Execute(profile UserProfileDTO) (MyProfileDTO, error) {
+	if err := checkProfileEditable(upau.gm); err != nil {
+		return MyProfileDTO{}, err
+	}
+	if strings.TrimSpace(profile.Answer) == "" {
+		return MyProfileDTO{}, errors.New("Answer is required")
+	}
+	assignment, err := upau.qn.findAssignment(profile.UserID, profile.ProfileID)
+	if err != nil {
+		return MyProfileDTO{}, err
+	}
+	up, err := profile.ToUserProfileModel()
+	if err != nil {
+		return MyProfileDTO{}, err
+	}
+	if _, found := upau.mod.Check(up.GetAnswer()); found {
+		up.Flag()
+	}
+	if err = upau.upr.Save(up); err != nil {
+		return MyProfileDTO{}, err
+	}
+	if assignment.IsSkipped() {
+		if err = upau.qar.SetSkipped(profile.UserID, profile.ProfileID, false); err != nil {
+			return MyProfileDTO{}, err
+		}
+	}
+	answers, err := upau.qn.answers(profile.UserID)
+	if err != nil {
+		return MyProfileDTO{}, err
+	}
+	idx := slices.IndexFunc(answers, func(a MyProfileDTO) bool {
+		return a.QuestionID == profile.ProfileID
+	})
+	// 保存した後に割り当てが変わった場合は見つからないことがある
+	if idx < 0 {
+		return MyProfileDTO{}, errors.New("Updated question is no longer assigned to you")
+	}
+	return answers[idx], nil
+}
+
+func NewUpdateProfileAnswerUsecase(gm *core.GameManager, upr IUserProfileRepository, qar IQuestionAssignmentRepository, qn *Questionnaire, mod *core.Moderator) *UpdateProfileAnswerUsecase {
+	return &UpdateProfileAnswerUsecase{
+		gm:  gm,
+		upr: upr,
+		qar: qar,
+		qn:  qn,
+		mod: mod,
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type UpdateQuestionUsecase struct {
+	gm  *core.GameManager
+	pqr IProfileQuestionRepositoryForAdmin
+}
+
+func (uqu *UpdateQuestionUsecase) Execute(question QuestionDTO) (*model.ProfileQuestion, error) {
+	pq, err := question.ToProfileQuestionModel()
+	if err != nil {
+		return nil, err
+	}
+	if err := editQuestions(uqu.gm, func() error {
+		// 存在しない質問を更新しようとしていないか確認
+		if _, err := uqu.pqr.FetchByQuestionID(question.QuestionID); err != nil {
+			return err
+		}
+		return uqu.pqr.Update(pq)
+	}); err != nil {
+		return nil, err
+	}
+	return uqu.pqr.FetchByQuestionID(question.QuestionID)
+}
+
+func NewUpdateQuestionUsecase(gm *core.GameManager, pqr IProfileQuestionRepositoryForAdmin) *UpdateQuestionUsecase {
+	return &UpdateQuestionUsecase{
+		gm:  gm,
+		pqr: pqr,
+	}
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
+)
+
+type UseLifelineUsecase struct {
+	gm *core.GameManager
+}
+
+func (ulu *UseLifelineUsecase) Execute(user *model.User, questionID uint, lifeline core.Lifeline) (core.LifelineResult, error) {
+	return ulu.gm.UseLifeline(core.TeamID(user.GetTeamID()), questionID, lifeline)
+}
+
+func NewUseLifelineUsecase(gm *core.GameManager) *UseLifelineUsecase {
+	return &UseLifelineUsecase{
+		gm: gm,
+	}
+}
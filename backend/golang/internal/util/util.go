@@ -3,7 +3,9 @@ package util
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
 	"io"
 	mrand "math/rand"
@@ -61,3 +63,21 @@ func ShuffleSlice[S any](s []S) []S {
 	mrand.Shuffle(len(cs), func(i, j int) { cs[i], cs[j] = cs[j], cs[i] })
 	return cs
 }
+
+// keyによるHMAC-SHA256の署名をURLで使える形式で返す
+func Sign(data string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// 署名の比較は時間差で推測されないようにhmac.Equalで行う
+func VerifySignature(data string, signature string, key []byte) bool {
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"embed"
 	"encoding/base64"
+	"errors"
 	"flag"
 	"fmt"
 	"io/fs"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -19,6 +23,7 @@ import (
 	rpccontroller "github.com/itsuabush1003/cursed-frame/backend/golang/internal/controller/rpc"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/core"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/infra"
+	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/model"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/repository"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/usecase"
 	"github.com/itsuabush1003/cursed-frame/backend/golang/internal/util"
@@ -26,15 +31,16 @@ import (
 
 const SecretLength int = 16
 const TempDirName string = "user_images"
+const DataDirName string = "cursed_frame"
+const SelfSignedDirName string = "selfsigned"
 const EnvPrefix string = "PCF_"
 
 var (
-	userNum     int
-	teamNum     int
-	certFile    string
-	keyFile     string
-	domain      string
-	useAutoCert bool
+	configFile string
+	// フラグで指定された設定の項目、設定ファイルや環境変数より優先する
+	configFlags = make(map[string]string)
+	// フラグで指定されたルールの項目、ファイルや環境変数より優先する
+	ruleFlags = make(map[string]string)
 )
 
 //go:embed dist/*
@@ -43,74 +49,248 @@ var staticFiles embed.FS
 //go:embed migration/*
 var dbSourceFiles embed.FS
 
+func configFlag(name string, key string, usage string) {
+	flag.Func(name, usage, func(v string) error {
+		configFlags[key] = v
+		return nil
+	})
+}
+
 func init() {
-	flag.IntVar(&userNum, "N", 6, "総参加者数")
-	flag.IntVar(&teamNum, "T", 2, "参加者を振り分けるチーム数")
-	flag.StringVar(&certFile, "cert", os.Getenv(EnvPrefix+"SSL_CERT_FILE"), "TLS用証明書ファイル")
-	flag.StringVar(&keyFile, "key", os.Getenv(EnvPrefix+"SSL_KEY_FILE"), "TLS用鍵ファイル")
-	flag.StringVar(&domain, "domain", os.Getenv(EnvPrefix+"DOMAIN"), "ドメイン")
-	flag.BoolVar(&useAutoCert, "autocert", false, "証明書の自動生成を有効にするか")
+	defaults := infra.DefaultConfig()
+	flag.StringVar(&configFile, "config", os.Getenv(EnvPrefix+"CONFIG"), "設定ファイル（YAML/JSON/TOML）")
+	configFlag("listen", "listen", fmt.Sprintf("待ち受けるアドレス（既定値: %s）", defaults.Listen))
+	configFlag("url", "public_url", "参加者に案内するURLの起点（例: https://example.com、未指定の場合はドメインかLANのIPアドレス）")
+	configFlag("admin-path", "paths.admin", "管理者用のパス（未指定の場合は起動毎にランダムに生成）")
+	configFlag("guest-path", "paths.guest", "参加者用のパス（未指定の場合は起動毎にランダムに生成）")
+	configFlag("N", "user_num", "総参加者数の見込み（未指定の場合は未定、管理画面から変更できる）")
+	configFlag("max", "max_user_num", "参加者数の上限（未指定の場合は無制限、管理画面から変更できる）")
+	configFlag("T", "team_num", fmt.Sprintf("参加者を振り分けるチーム数（既定値: %d）", defaults.TeamNum))
+	configFlag("cert", "tls.cert_file", "TLS用証明書ファイル")
+	configFlag("key", "tls.key_file", "TLS用鍵ファイル")
+	configFlag("domain", "tls.domain", "ドメイン")
+	flag.BoolFunc("autocert", "証明書の自動生成を有効にするか", func(v string) error {
+		configFlags["tls.autocert"] = v
+		return nil
+	})
+	flag.BoolFunc("selfsigned", "LAN内で使うための自己署名の証明書を生成して使うか", func(v string) error {
+		configFlags["tls.self_signed"] = v
+		return nil
+	})
+	configFlag("Q", "questionnaire.per_guest", "参加者一人あたりの質問数（0の場合は全ての質問）")
+	configFlag("Qc", "questionnaire.common", fmt.Sprintf("参加者全員に共通して聞く質問数（並び順で先頭から、既定値: %d）", defaults.Questionnaire.Common))
+	configFlag("rules", "rules_file", "ゲームのルールを書いたYAML/JSONファイル")
+	for _, key := range infra.GameRuleKeys {
+		flag.Func("rule."+key, fmt.Sprintf("ルールの%sを上書きする（環境変数%s）", key, infra.GameRuleEnvName(EnvPrefix, key)), func(v string) error {
+			ruleFlags[key] = v
+			return nil
+		})
+	}
+	configFlag("denylist", "deny_list", "名前や回答、ヒントで禁止する語句の一覧ファイル（１行に１語）")
+	configFlag("questions", "questions", "起動時に読み込む質問パックのファイルもしくはディレクトリ（CSV/TSV/JSON/YAML）")
+	flag.BoolFunc("replace-questions", "質問パックで保存されている質問を置き換えるか（未指定の場合は今回の起動の間だけ使う）", func(v string) error {
+		configFlags["replace_questions"] = v
+		return nil
+	})
+	configFlag("data", "data_dir", "再起動後も残す質問などのデータの保存先ディレクトリ（未指定の場合はユーザ設定ディレクトリ配下）")
+	configFlag("tmp", "temp_dir", "画像などゲーム中だけ使うデータの一時ディレクトリを作る場所（未指定の場合はOSの既定の場所）")
+	configFlag("export", "export_path", "終了時にゲームのデータをZIPで書き出すファイルもしくはディレクトリ")
 }
 
 func main() {
+	// サーバは起動せずに、起動中のサーバからゲームのデータを受け取る
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	flag.Parse()
 
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// 既定値 -> 設定ファイル -> 環境変数 -> フラグの順に上書きする
+func loadConfig() (infra.Config, error) {
+	config := infra.DefaultConfig()
+	var err error
+	if configFile != "" {
+		if config, err = infra.LoadConfig(configFile, config); err != nil {
+			return config, err
+		}
+	}
+	errs := make([]error, 0)
+	for _, key := range infra.ConfigKeys(EnvPrefix) {
+		if v, ok := os.LookupEnv(key.Env); ok && v != "" {
+			if config, err = infra.OverrideConfig(config, key.Key, v); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", key.Env, err))
+			}
+		}
+	}
+	for _, key := range infra.ConfigKeys(EnvPrefix) {
+		if v, ok := configFlags[key.Key]; ok {
+			if config, err = infra.OverrideConfig(config, key.Key, v); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if config.Rules, err = loadGameRules(config.RulesFile, config.Rules); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return config, errors.Join(errs...)
+	}
+	return config, config.Validate()
+}
+
+func run() error {
+	config, err := loadConfig()
+	if err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+	teamNum := config.TeamNum
+	rules := config.Rules
+
+	questionnairePolicy, err := core.NewQuestionnairePolicy(config.Questionnaire.PerGuest, config.Questionnaire.Common)
+	if err != nil {
+		return err
+	}
+
+	denyTerms := make([]string, 0)
+	if config.DenyList != "" {
+		denyTerms, err = infra.LoadDenyList(config.DenyList)
+		if err != nil {
+			return fmt.Errorf("failed to load deny list: %w", err)
+		}
+	}
+	moderator := core.NewModerator(denyTerms)
+
+	// 質問パックの誤りは起動前に全部まとめて知らせたいので、サーバを立ち上げる前に検証する
+	var questionPack []model.ProfileQuestion
+	if config.Questions != "" {
+		loaded, err := infra.LoadQuestionPacks(config.Questions)
+		if err != nil {
+			return fmt.Errorf("failed to load question packs:\n%w", err)
+		}
+		questionPack = loaded
+	}
+
 	secret, err := util.CreateRandStr(SecretLength)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	byteSecret, err := base64.RawURLEncoding.DecodeString(secret)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	// 画像URLの署名にはトークンの暗号化とは別の鍵を使う
+	imageSecret, err := util.CreateRandStr(SecretLength)
+	if err != nil {
+		return err
+	}
+	imageURLSecret, err := base64.RawURLEncoding.DecodeString(imageSecret)
+	if err != nil {
+		return err
 	}
 
-	imageDirname, err := os.MkdirTemp("", TempDirName)
+	imageDirname, err := os.MkdirTemp(config.TempDir, TempDirName)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("failed to create image directory: %w", err)
 	}
 	defer os.RemoveAll(imageDirname)
+	imageStore, clearImageStore, err := infra.NewImageStore(config.Images, imageDirname)
+	if err != nil {
+		return fmt.Errorf("failed to set up image store: %w", err)
+	}
+	defer func() {
+		if err := clearImageStore(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to remove images from image store: %v\n", err)
+		}
+	}()
 
-	dbDirname, err := os.MkdirTemp("", "db")
+	dbDirname, err := os.MkdirTemp(config.TempDir, "db")
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("failed to create database directory: %w", err)
 	}
 	defer os.RemoveAll(dbDirname)
 
+	dataDir := config.DataDir
+	if dataDir == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			return fmt.Errorf("failed to find data directory: %w", err)
+		}
+		dataDir = filepath.Join(configDir, DataDirName)
+	}
+	if err = os.MkdirAll(dataDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	var caCertificateHandler *filecontroller.CACertificateHandler
 	tlsConfig := &tls.Config{
 		MinVersion: tls.VersionTLS12,
 	}
-	if certFile != "" && keyFile != "" {
-		certs, err := infra.CreateCertificateFromFiles(certFile, keyFile)
+	if config.TLS.CertFile != "" && config.TLS.KeyFile != "" {
+		getCertificate, stopReload, err := infra.CreateCertificateFromFiles(config.TLS.CertFile, config.TLS.KeyFile, config.TLS.ReloadInterval)
 		if err != nil {
-			panic(err)
+			return fmt.Errorf("failed to load certificate: %w", err)
 		}
-		tlsConfig.Certificates = certs
-	} else if useAutoCert {
-		getCertificate, cleanAutoCert, err := infra.CreateCertificateWithAutoCert(domain)
+		defer stopReload()
+		tlsConfig.GetCertificate = getCertificate
+	} else if config.TLS.AutoCert {
+		getCertificate, cleanAutoCert, err := infra.CreateCertificateWithAutoCert(config.TLS.Domain)
 		if err != nil {
-			panic(err)
+			return fmt.Errorf("failed to set up autocert: %w", err)
 		}
 		defer cleanAutoCert()
 		tlsConfig.GetCertificate = getCertificate
+	} else if config.TLS.SelfSigned {
+		hosts := infra.LocalHostNames()
+		if config.TLS.Domain != "" {
+			hosts = append([]string{config.TLS.Domain}, hosts...)
+		}
+		certs, caDER, err := infra.CreateSelfSignedCertificate(filepath.Join(dataDir, SelfSignedDirName), hosts)
+		if err != nil {
+			return fmt.Errorf("failed to create self-signed certificate: %w", err)
+		}
+		tlsConfig.Certificates = certs
+		caCertificateHandler = filecontroller.NewCACertificateHandler(caDER)
 	}
 
 	// "dist"ディレクトリをルートとして扱う
 	dist, err := fs.Sub(staticFiles, "dist")
 	if err != nil {
-		panic(err)
+		return err
 	}
 	dbSource, err := fs.Sub(dbSourceFiles, "migration")
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	gameManager := core.NewGameManager(userNum, teamNum)
-	database, err := infra.NewSQLiteDB(dbDirname, dbSource)
+	gameManager := core.NewGameManager(core.EntryLimits{
+		ExpectedUserNum: config.UserNum,
+		MaxUserNum:      config.MaxUserNum,
+	}, teamNum, rules)
+	// 質問パックを今回だけ使う場合は、管理画面で編集された質問を消さないように質問のDBを一時ディレクトリに作る
+	sessionDatabases := make([]string, 0, 1)
+	if questionPack != nil && !config.ReplaceQuestions {
+		sessionDatabases = append(sessionDatabases, "Master")
+	}
+	database, err := infra.NewSQLiteDB(dbDirname, dataDir, dbSource, sessionDatabases...)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("failed to open database: %w", err)
 	}
 	defer database.Close()
 
+	adminPath, guestPath, err := infra.ResolvePaths(config.Paths.Admin, config.Paths.Guest)
+	if err != nil {
+		return err
+	}
+
 	// 初期化 TODO: DIにする
 	fileHandler := filecontroller.NewStaticFileHandler(http.FS(dist))
 	c := cache.New(10*time.Minute, 30*time.Minute)
@@ -118,45 +298,174 @@ func main() {
 	adminCheckMiddleware := middleware.NewAdminCheckMiddleware()
 	authorizeMiddleware := middleware.NewAuthorizeMiddleware(userRepository)
 	corsMiddleware := middleware.NewCorsMiddleware()
-	rateLimitMiddleware := middleware.NewRateLimitMiddleware(userNum)
+	rateLimitMiddleware := middleware.NewRateLimitMiddleware(gameManager.GetRosterSize, config.RateLimit.MinUsers, config.RateLimit.PerUser, config.RateLimit.BurstPerUser)
 	userImageRepository := repository.NewUserImageRepository(database)
-	imageUploadUsecase := usecase.NewImageUploadUsecase(imageDirname, userImageRepository)
-	imageDownloadUsecase := usecase.NewImageDownloadUsecase(userImageRepository)
-	imageHandler := restcontroller.NewImageHandler(imageUploadUsecase, imageDownloadUsecase, imageDirname)
-	entryUsecase := usecase.NewEntryUsecase(userRepository, byteSecret)
+	imageProcessor := core.NewImageProcessor(config.Images.MaxUploadBytes, config.Images.OutputSize)
+	imageUploadUsecase := usecase.NewImageUploadUsecase(imageStore, gameManager, userImageRepository, imageProcessor)
+	avatarStore := usecase.NewAvatarStore(imageStore, imageProcessor)
+	imageDownloadUsecase := usecase.NewImageDownloadUsecase(gameManager, userImageRepository, avatarStore)
+	imageURLSigner := usecase.NewImageURLSigner(imageURLSecret, config.Images.URLTTL, guestPath+infra.SignedImagePath)
+	signedImageDownloadUsecase := usecase.NewSignedImageDownloadUsecase(gameManager, userImageRepository, imageURLSigner, avatarStore)
+	deleteImageUsecase := usecase.NewDeleteImageUsecase(imageStore, userImageRepository)
+	imageHandler := restcontroller.NewImageHandler(imageUploadUsecase, imageDownloadUsecase, signedImageDownloadUsecase, deleteImageUsecase)
+	playerRepository := repository.NewPlayerRepository(database)
+	historyRepository := repository.NewHistoryRepository(database)
+	entryUsecase := usecase.NewEntryUsecase(gameManager, userRepository, byteSecret, moderator, playerRepository)
 	reconnectUsecase := usecase.NewReconnectUsecase(byteSecret, userRepository)
 	entryServiceHandler := rpccontroller.NewEntryServiceHandler(entryUsecase, reconnectUsecase)
 	profileQuestionRepository := repository.NewProfileQuestionRepository(database)
 	userProfileRepository := repository.NewUserProfileRepository(database)
+	if questionPack != nil {
+		if err = profileQuestionRepository.ReplaceAll(questionPack); err != nil {
+			return fmt.Errorf("failed to save question packs: %w", err)
+		}
+	}
 	joinLobbyUsecase := usecase.NewJoinLobbyUsecase(gameManager)
-	registProfileUsecase := usecase.NewRegistProfileUsecase(profileQuestionRepository, userProfileRepository)
+	questionAssignmentRepository := repository.NewQuestionAssignmentRepository(database)
+	questionnaire := usecase.NewQuestionnaire(profileQuestionRepository, userProfileRepository, questionAssignmentRepository, questionnairePolicy)
+	registProfileUsecase := usecase.NewRegistProfileUsecase(gameManager, profileQuestionRepository, userProfileRepository, questionAssignmentRepository, questionnaire, moderator)
+	getNextQuestionUsecase := usecase.NewGetNextQuestionUsecase(questionnaire)
+	listMyProfileUsecase := usecase.NewListMyProfileUsecase(gameManager, questionnaire)
+	updateProfileAnswerUsecase := usecase.NewUpdateProfileAnswerUsecase(gameManager, userProfileRepository, questionAssignmentRepository, questionnaire, moderator)
 	setReadyUsecase := usecase.NewSetReadyUsecase(userRepository)
-	getTeamInfoUsecase := usecase.NewGetTeamInfoUsecase(userRepository)
-	lobbyServiceHandler := rpccontroller.NewLobbyServiceHandler(joinLobbyUsecase, registProfileUsecase, setReadyUsecase, getTeamInfoUsecase)
+	getTeamInfoUsecase := usecase.NewGetTeamInfoUsecase(userRepository, userImageRepository, imageURLSigner)
+	lobbyServiceHandler := rpccontroller.NewLobbyServiceHandler(joinLobbyUsecase, registProfileUsecase, setReadyUsecase, getTeamInfoUsecase, getNextQuestionUsecase, listMyProfileUsecase, updateProfileAnswerUsecase)
 	guestStartQuestUsecase := usecase.NewGuestStartQuestUsecase(gameManager)
 	answerUsecase := usecase.NewAnswerUsecase(gameManager)
-	takeHintUsecase := usecase.NewTakeHintUsecase(gameManager)
-	getResultUsecase := usecase.NewGetResultUsecase(gameManager, infra.ResultStateMapper)
-	questServiceHandler := rpccontroller.NewQuestServiceHandler(guestStartQuestUsecase, answerUsecase, takeHintUsecase, getResultUsecase)
+	takeHintUsecase := usecase.NewTakeHintUsecase(gameManager, moderator)
+	getResultUsecase := usecase.NewGetResultUsecase(gameManager, userRepository, infra.ResultStateMapper)
+	useLifelineUsecase := usecase.NewUseLifelineUsecase(gameManager)
+	getPersonalReportUsecase := usecase.NewGetPersonalReportUsecase(gameManager, userRepository, playerRepository, historyRepository)
+	questServiceHandler := rpccontroller.NewQuestServiceHandler(guestStartQuestUsecase, answerUsecase, takeHintUsecase, getResultUsecase, useLifelineUsecase, getPersonalReportUsecase)
 	openEntryUsecase := usecase.NewOpenEntryUsecase(gameManager, userRepository)
 	closeEntryUsecase := usecase.NewCloseEntryUsecase(gameManager, userRepository, teamNum)
 	rejectUserUsecase := usecase.NewRejectUserUsecase(gameManager, userRepository)
-	changeTeamUsecase := usecase.NewChangeTeamUsecase(userRepository)
-	adminStartQuestUsecase := usecase.NewAdminStartQuestUsecase(gameManager, userRepository, userImageRepository, userProfileRepository, profileQuestionRepository)
+	changeTeamUsecase := usecase.NewChangeTeamUsecase(gameManager, userRepository)
+	adminStartQuestUsecase := usecase.NewAdminStartQuestUsecase(gameManager, userRepository, userImageRepository, userProfileRepository, profileQuestionRepository, imageURLSigner)
 	readyQuizUsecase := usecase.NewReadyQuizUsecase(gameManager)
 	checkAnswersUsecase := usecase.NewCheckAnswersUsecase(gameManager)
 	nextQuizUsecase := usecase.NewNextQuizUsecase(gameManager)
-	endQuestUsecase := usecase.NewEndQuestUsecase(gameManager, userRepository, infra.ResultStateMapper)
-	adminServiceHandler := rpccontroller.NewAdminServiceHandler(openEntryUsecase, closeEntryUsecase, rejectUserUsecase, changeTeamUsecase, adminStartQuestUsecase, readyQuizUsecase, checkAnswersUsecase, nextQuizUsecase, endQuestUsecase, userNum)
-	router := infra.NewRouter(fileHandler, imageHandler, entryServiceHandler, lobbyServiceHandler, questServiceHandler, adminServiceHandler, adminCheckMiddleware, authorizeMiddleware, rateLimitMiddleware, corsMiddleware)
+	gameRecorder := usecase.NewGameRecorder(gameManager, userRepository, playerRepository, historyRepository)
+	endQuestUsecase := usecase.NewEndQuestUsecase(gameManager, userRepository, infra.ResultStateMapper, gameRecorder)
+	listQuestionsUsecase := usecase.NewListQuestionsUsecase(profileQuestionRepository)
+	createQuestionUsecase := usecase.NewCreateQuestionUsecase(gameManager, profileQuestionRepository)
+	updateQuestionUsecase := usecase.NewUpdateQuestionUsecase(gameManager, profileQuestionRepository)
+	deleteQuestionUsecase := usecase.NewDeleteQuestionUsecase(gameManager, profileQuestionRepository)
+	reorderQuestionsUsecase := usecase.NewReorderQuestionsUsecase(gameManager, profileQuestionRepository)
+	listFlaggedAnswersUsecase := usecase.NewListFlaggedAnswersUsecase(userRepository, userProfileRepository, profileQuestionRepository, moderator)
+	editUserAnswerUsecase := usecase.NewEditUserAnswerUsecase(gameManager, userProfileRepository)
+	removeUserAnswerUsecase := usecase.NewRemoveUserAnswerUsecase(gameManager, userProfileRepository)
+	removeUserImageUsecase := usecase.NewRemoveUserImageUsecase(imageStore, gameManager, userImageRepository)
+	getRulesUsecase := usecase.NewGetRulesUsecase(gameManager)
+	setRulesUsecase := usecase.NewSetRulesUsecase(gameManager)
+	setEntryLimitsUsecase := usecase.NewSetEntryLimitsUsecase(gameManager)
+	exportSessionUsecase := usecase.NewExportSessionUsecase(gameManager, userRepository, userImageRepository, userProfileRepository, profileQuestionRepository, imageStore)
+	if config.ExportPath != "" {
+		// 一時ディレクトリや画像を消すより先に書き出す
+		defer func() {
+			if path, err := exportSessionOnExit(exportSessionUsecase, config.ExportPath); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to export session: %v\n", err)
+			} else {
+				fmt.Printf("Session exported to %s\n", path)
+			}
+		}()
+	}
+	exportHandler := restcontroller.NewExportHandler(exportSessionUsecase)
+	getHistoryUsecase := usecase.NewGetHistoryUsecase(historyRepository)
+	getPlayerHistoryUsecase := usecase.NewGetPlayerHistoryUsecase(playerRepository, historyRepository)
+	getAnalyticsUsecase := usecase.NewGetAnalyticsUsecase(gameManager, userRepository)
+	adminServiceHandler := rpccontroller.NewAdminServiceHandler(openEntryUsecase, closeEntryUsecase, rejectUserUsecase, changeTeamUsecase, adminStartQuestUsecase, readyQuizUsecase, checkAnswersUsecase, nextQuizUsecase, endQuestUsecase, listQuestionsUsecase, createQuestionUsecase, updateQuestionUsecase, deleteQuestionUsecase, reorderQuestionsUsecase, listFlaggedAnswersUsecase, editUserAnswerUsecase, removeUserAnswerUsecase, removeUserImageUsecase, getRulesUsecase, setRulesUsecase, setEntryLimitsUsecase, exportSessionUsecase, getHistoryUsecase, getPlayerHistoryUsecase, getAnalyticsUsecase)
+	useTLS := len(tlsConfig.Certificates) > 0 || tlsConfig.GetCertificate != nil
+	adminURL := infra.InvitationURL(config.PublicURL, config.Listen, config.TLS.Domain, useTLS, adminPath)
+	guestURL := infra.InvitationURL(config.PublicURL, config.Listen, config.TLS.Domain, useTLS, guestPath)
+	getInvitationQRCodeUsecase := usecase.NewGetInvitationQRCodeUsecase(guestURL, infra.EncodeQRCodePNG)
+	qrCodeHandler := restcontroller.NewQRCodeHandler(getInvitationQRCodeUsecase, infra.DefaultQRCodeSize, infra.MinQRCodeSize, infra.MaxQRCodeSize)
+	router := infra.NewRouter(adminPath, guestPath, fileHandler, caCertificateHandler, imageHandler, qrCodeHandler, exportHandler, entryServiceHandler, lobbyServiceHandler, questServiceHandler, adminServiceHandler, adminCheckMiddleware, authorizeMiddleware, rateLimitMiddleware, corsMiddleware)
+
+	server := infra.NewServer(config.Listen, tlsConfig, router)
+	fmt.Printf("Server started at\n\tadmin: %s\n\tguest: %s\n", adminURL, guestURL)
+	// プロジェクタに映したターミナルからそのまま読み取れるように表示する
+	if qr, err := infra.QRCodeASCII(guestURL); err == nil {
+		fmt.Println(qr)
+	}
+	if caCertificateHandler != nil {
+		fmt.Printf("Participants can trust the self-signed certificate by installing\n\t%s/%s\n", guestURL, filecontroller.CACertificateFileName)
+	}
+	return server.ListenAndServe()
+}
 
-	server := infra.NewServer(":8888", tlsConfig, router)
-	domainStr := domain
-	if domainStr == "" {
-		domainStr = "<your_domain>"
+// pathが既存のディレクトリの場合は、その中に日時を含む名前で書き出す
+func exportSessionOnExit(esu *usecase.ExportSessionUsecase, path string) (string, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, esu.FileName(time.Now()))
 	}
-	println(fmt.Sprintf("Server started at\n\tadmin: %s:8888%s\n\tguest: %s:8888%s", domainStr, router.AdminPath, domainStr, router.GuestPath))
-	if err = server.ListenAndServe(); err != nil {
-		panic(err)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return path, err
+	}
+	if err := esu.Execute(f); err != nil {
+		f.Close()
+		return path, err
+	}
+	return path, f.Close()
+}
+
+func runExport(args []string) error {
+	exportFlags := flag.NewFlagSet("export", flag.ExitOnError)
+	exportFlags.Usage = func() {
+		fmt.Fprintf(exportFlags.Output(), "Usage: %s export [flags] <admin URL>\n", filepath.Base(os.Args[0]))
+		exportFlags.PrintDefaults()
+	}
+	key := exportFlags.String("key", os.Getenv(EnvPrefix+"ADMIN_KEY"), "管理画面のブラウザに保存された再接続キー（環境変数"+EnvPrefix+"ADMIN_KEY）")
+	caFile := exportFlags.String("ca", "", "自己署名の証明書で起動したサーバに繋ぐ場合に信頼するCA証明書（データディレクトリのselfsigned/"+infra.SelfSignedCAFile+"）")
+	output := exportFlags.String("o", ".", "ZIPを書き出すファイルもしくはディレクトリ")
+	if err := exportFlags.Parse(args); err != nil {
+		return err
+	}
+	if exportFlags.NArg() != 1 {
+		exportFlags.Usage()
+		return errors.New("admin URL is required")
+	}
+	if *key == "" {
+		return errors.New("-key or " + EnvPrefix + "ADMIN_KEY is required")
+	}
+	client, err := infra.NewExportHTTPClient(*caFile)
+	if err != nil {
+		return fmt.Errorf("failed to load CA certificate: %w", err)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	path, err := infra.NewSessionExportClient(client, exportFlags.Arg(0)).Download(ctx, *key, *output)
+	if err != nil {
+		return fmt.Errorf("failed to export session: %w", err)
+	}
+	fmt.Printf("Session exported to %s\n", path)
+	return nil
+}
+
+// 設定ファイルのrules -> ルールファイル -> 環境変数 -> フラグの順に上書きする
+// 検証は他の設定とまとめてConfig.Validateで行う
+func loadGameRules(rulesFile string, base core.GameRules) (core.GameRules, error) {
+	rules := base
+	var err error
+	if rulesFile != "" {
+		if rules, err = infra.LoadGameRules(rulesFile, rules); err != nil {
+			return rules, err
+		}
+	}
+	for _, key := range infra.GameRuleKeys {
+		if v, ok := os.LookupEnv(infra.GameRuleEnvName(EnvPrefix, key)); ok && v != "" {
+			if rules, err = infra.OverrideGameRule(rules, key, v); err != nil {
+				return rules, err
+			}
+		}
+	}
+	for _, key := range infra.GameRuleKeys {
+		if v, ok := ruleFlags[key]; ok {
+			if rules, err = infra.OverrideGameRule(rules, key, v); err != nil {
+				return rules, err
+			}
+		}
 	}
+	return rules, nil
 }
@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { ChangeTeamRequest, CheckAnswersResponse, EndQuestResponse, RegistAdminUserResponse, RejectUserRequest } from "./admin_pb.js";
+import { ChangeTeamRequest, CheckAnswersResponse, DeleteQuestionRequest, EditUserAnswerRequest, EndQuestResponse, EntryLimits, GameRules, GetAnalyticsResponse, GetHistoryRequest, GetHistoryResponse, GetPlayerHistoryRequest, GetPlayerHistoryResponse, ListFlaggedAnswersResponse, ListQuestionsResponse, ProfileQuestion, RegistAdminUserResponse, RejectUserRequest, RemoveUserAnswerRequest, RemoveUserImageRequest, ReorderQuestionsRequest, SetEntryLimitsRequest } from "./admin_pb.js";
 
 export const typeName = "admin.v1.AdminService";
 
@@ -144,3 +144,258 @@ export const endQuest = createQueryService({
     typeName: "admin.v1.AdminService",
   },
 }).endQuest;
+
+/**
+ * @generated from rpc admin.v1.AdminService.ListQuestions
+ */
+export const listQuestions = createQueryService({
+  service: {
+    methods: {
+      listQuestions: {
+        name: "ListQuestions",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: ListQuestionsResponse,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).listQuestions;
+
+/**
+ * @generated from rpc admin.v1.AdminService.CreateQuestion
+ */
+export const createQuestion = createQueryService({
+  service: {
+    methods: {
+      createQuestion: {
+        name: "CreateQuestion",
+        kind: MethodKind.Unary,
+        I: ProfileQuestion,
+        O: ProfileQuestion,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).createQuestion;
+
+/**
+ * @generated from rpc admin.v1.AdminService.UpdateQuestion
+ */
+export const updateQuestion = createQueryService({
+  service: {
+    methods: {
+      updateQuestion: {
+        name: "UpdateQuestion",
+        kind: MethodKind.Unary,
+        I: ProfileQuestion,
+        O: ProfileQuestion,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).updateQuestion;
+
+/**
+ * @generated from rpc admin.v1.AdminService.DeleteQuestion
+ */
+export const deleteQuestion = createQueryService({
+  service: {
+    methods: {
+      deleteQuestion: {
+        name: "DeleteQuestion",
+        kind: MethodKind.Unary,
+        I: DeleteQuestionRequest,
+        O: Empty,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).deleteQuestion;
+
+/**
+ * @generated from rpc admin.v1.AdminService.ReorderQuestions
+ */
+export const reorderQuestions = createQueryService({
+  service: {
+    methods: {
+      reorderQuestions: {
+        name: "ReorderQuestions",
+        kind: MethodKind.Unary,
+        I: ReorderQuestionsRequest,
+        O: ListQuestionsResponse,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).reorderQuestions;
+
+/**
+ * @generated from rpc admin.v1.AdminService.ListFlaggedAnswers
+ */
+export const listFlaggedAnswers = createQueryService({
+  service: {
+    methods: {
+      listFlaggedAnswers: {
+        name: "ListFlaggedAnswers",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: ListFlaggedAnswersResponse,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).listFlaggedAnswers;
+
+/**
+ * @generated from rpc admin.v1.AdminService.EditUserAnswer
+ */
+export const editUserAnswer = createQueryService({
+  service: {
+    methods: {
+      editUserAnswer: {
+        name: "EditUserAnswer",
+        kind: MethodKind.Unary,
+        I: EditUserAnswerRequest,
+        O: Empty,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).editUserAnswer;
+
+/**
+ * @generated from rpc admin.v1.AdminService.RemoveUserAnswer
+ */
+export const removeUserAnswer = createQueryService({
+  service: {
+    methods: {
+      removeUserAnswer: {
+        name: "RemoveUserAnswer",
+        kind: MethodKind.Unary,
+        I: RemoveUserAnswerRequest,
+        O: Empty,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).removeUserAnswer;
+
+/**
+ * @generated from rpc admin.v1.AdminService.RemoveUserImage
+ */
+export const removeUserImage = createQueryService({
+  service: {
+    methods: {
+      removeUserImage: {
+        name: "RemoveUserImage",
+        kind: MethodKind.Unary,
+        I: RemoveUserImageRequest,
+        O: Empty,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).removeUserImage;
+
+/**
+ * @generated from rpc admin.v1.AdminService.GetRules
+ */
+export const getRules = createQueryService({
+  service: {
+    methods: {
+      getRules: {
+        name: "GetRules",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: GameRules,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).getRules;
+
+/**
+ * @generated from rpc admin.v1.AdminService.SetRules
+ */
+export const setRules = createQueryService({
+  service: {
+    methods: {
+      setRules: {
+        name: "SetRules",
+        kind: MethodKind.Unary,
+        I: GameRules,
+        O: GameRules,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).setRules;
+
+/**
+ * @generated from rpc admin.v1.AdminService.SetEntryLimits
+ */
+export const setEntryLimits = createQueryService({
+  service: {
+    methods: {
+      setEntryLimits: {
+        name: "SetEntryLimits",
+        kind: MethodKind.Unary,
+        I: SetEntryLimitsRequest,
+        O: EntryLimits,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).setEntryLimits;
+
+/**
+ * @generated from rpc admin.v1.AdminService.GetHistory
+ */
+export const getHistory = createQueryService({
+  service: {
+    methods: {
+      getHistory: {
+        name: "GetHistory",
+        kind: MethodKind.Unary,
+        I: GetHistoryRequest,
+        O: GetHistoryResponse,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).getHistory;
+
+/**
+ * @generated from rpc admin.v1.AdminService.GetPlayerHistory
+ */
+export const getPlayerHistory = createQueryService({
+  service: {
+    methods: {
+      getPlayerHistory: {
+        name: "GetPlayerHistory",
+        kind: MethodKind.Unary,
+        I: GetPlayerHistoryRequest,
+        O: GetPlayerHistoryResponse,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).getPlayerHistory;
+
+/**
+ * @generated from rpc admin.v1.AdminService.GetAnalytics
+ */
+export const getAnalytics = createQueryService({
+  service: {
+    methods: {
+      getAnalytics: {
+        name: "GetAnalytics",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: GetAnalyticsResponse,
+      },
+    },
+    typeName: "admin.v1.AdminService",
+  },
+}).getAnalytics;
@@ -5,7 +5,8 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Choice, Result } from "../../common/v1/common_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Award, Choice, Result } from "../../common/v1/common_pb";
 import { file_common_v1_common } from "../../common/v1/common_pb";
 import type { EmptySchema } from "../../google/protobuf/empty_pb";
 import { file_google_protobuf_empty } from "../../google/protobuf/empty_pb";
@@ -15,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file admin/v1/admin.proto.
  */
 export const file_admin_v1_admin: GenFile = /*@__PURE__*/
-  fileDesc("ChRhZG1pbi92MS9hZG1pbi5wcm90bxIIYWRtaW4udjEiOAoXUmVnaXN0QWRtaW5Vc2VyUmVzcG9uc2USDQoFdG9rZW4YASABKAkSDgoGc2VjcmV0GAIgASgJIk0KBFVzZXISDwoHdXNlcl9pZBgBIAEoCRIRCgl1c2VyX25hbWUYAiABKAkSDwoHdGVhbV9pZBgDIAEoDRIQCghpc19yZWFkeRgEIAEoCCJVChFPcGVuRW50cnlSZXNwb25zZRIlCg1lbnRlcmVkX3VzZXJzGAEgAygLMg4uYWRtaW4udjEuVXNlchIZChFleHBlY3RlZF91c2VyX251bRgCIAEoBSIkChFSZWplY3RVc2VyUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJIjkKEUNoYW5nZVRlYW1SZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEwoLbmV3X3RlYW1faWQYAiABKA0iuwEKElN0YXJ0UXVlc3RSZXNwb25zZRIcChR0YXJnZXRfdXNlcl9pbWFnZV9pZBgBIAEoCRIWCg50YXJnZXRfdGVhbV9pZBgCIAEoDRITCgtxdWVzdGlvbl9pZBgDIAEoDRIQCghxdWVzdGlvbhgEIAEoCRIiCgdjaG9pY2VzGAUgAygLMhEuY29tbW9uLnYxLkNob2ljZRIRCglsYXN0X3RpbWUYBiABKAUSEQoJaGludF90ZXh0GAcgASgJImgKClRlYW1BbnN3ZXISDwoHdGVhbV9pZBgBIAEoDRISCgp0ZWFtX2NvbG9yGAQgASgJEiEKBmFuc3dlchgCIAEoCzIRLmNvbW1vbi52MS5DaG9pY2USEgoKaXNfY29ycmVjdBgDIAEoCCJoChRDaGVja0Fuc3dlcnNSZXNwb25zZRIlCgdhbnN3ZXJzGAEgAygLMhQuYWRtaW4udjEuVGVhbUFuc3dlchIpCg5jb3JyZWN0X2Nob2ljZRgCIAEoCzIRLmNvbW1vbi52MS5DaG9pY2UiTAoJVXNlclN0YXRzEhEKCXVzZXJfbmFtZRgBIAEoCRIUCgxjb3JyZWN0X3JhdGUYAiABKAISFgoOcGVyc29uYWxfb3JkZXIYAyABKA0iiwEKCVRlYW1TdGF0cxIPCgd0ZWFtX2lkGAEgASgNEhIKCnRlYW1fY29sb3IYBSABKAkSKgoNbWVtYmVyc19zdGF0cxgCIAMoCzITLmFkbWluLnYxLlVzZXJTdGF0cxIZChF0ZWFtX2NvcnJlY3RfcmF0ZRgDIAEoAhISCgp0ZWFtX29yZGVyGAQgASgNIlkKEEVuZFF1ZXN0UmVzcG9uc2USIQoGcmVzdWx0GAEgASgOMhEuY29tbW9uLnYxLlJlc3VsdBIiCgVzdGF0cxgCIAMoCzITLmFkbWluLnYxLlRlYW1TdGF0czKrBQoMQWRtaW5TZXJ2aWNlEkwKD1JlZ2lzdEFkbWluVXNlchIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLmFkbWluLnYxLlJlZ2lzdEFkbWluVXNlclJlc3BvbnNlEkIKCU9wZW5FbnRyeRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRobLmFkbWluLnYxLk9wZW5FbnRyeVJlc3BvbnNlMAESPAoKQ2xvc2VFbnRyeRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJBCgpSZWplY3RVc2VyEhsuYWRtaW4udjEuUmVqZWN0VXNlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSQQoKQ2hhbmdlVGVhbRIbLmFkbWluLnYxLkNoYW5nZVRlYW1SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkQKClN0YXJ0UXVlc3QSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHC5hZG1pbi52MS5TdGFydFF1ZXN0UmVzcG9uc2UwARI7CglSZWFkeVF1aXoSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSRgoMQ2hlY2tBbnN3ZXJzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh4uYWRtaW4udjEuQ2hlY2tBbnN3ZXJzUmVzcG9uc2USOgoITmV4dFF1aXoSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSPgoIRW5kUXVlc3QSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGi5hZG1pbi52MS5FbmRRdWVzdFJlc3BvbnNlQlRaUmdpdGh1Yi5jb20vaXRzdWFidXNoMTAwMy9jdXJzZWQtZnJhbWUvYmFja2VuZC9nb2xhbmcvaW50ZXJuYWwvZ2VuL2FkbWluL3YxO2FkbWludjFiBnByb3RvMw", [file_common_v1_common, file_google_protobuf_empty]);
+  fileDesc("ChRhZG1pbi92MS9hZG1pbi5wcm90bxIIYWRtaW4udjEiOAoXUmVnaXN0QWRtaW5Vc2VyUmVzcG9uc2USDQoFdG9rZW4YASABKAkSDgoGc2VjcmV0GAIgASgJIk0KBFVzZXISDwoHdXNlcl9pZBgBIAEoCRIRCgl1c2VyX25hbWUYAiABKAkSDwoHdGVhbV9pZBgDIAEoDRIQCghpc19yZWFkeRgEIAEoCCJrChFPcGVuRW50cnlSZXNwb25zZRIlCg1lbnRlcmVkX3VzZXJzGAEgAygLMg4uYWRtaW4udjEuVXNlchIZChFleHBlY3RlZF91c2VyX251bRgCIAEoBRIUCgxtYXhfdXNlcl9udW0YAyABKAUiJAoRUmVqZWN0VXNlclJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSI5ChFDaGFuZ2VUZWFtUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhMKC25ld190ZWFtX2lkGAIgASgNItoBChJTdGFydFF1ZXN0UmVzcG9uc2USHAoUdGFyZ2V0X3VzZXJfaW1hZ2VfaWQYASABKAkSFgoOdGFyZ2V0X3RlYW1faWQYAiABKA0SEwoLcXVlc3Rpb25faWQYAyABKA0SEAoIcXVlc3Rpb24YBCABKAkSIgoHY2hvaWNlcxgFIAMoCzIRLmNvbW1vbi52MS5DaG9pY2USEQoJbGFzdF90aW1lGAYgASgFEhEKCWhpbnRfdGV4dBgHIAEoCRIdChV0YXJnZXRfdXNlcl9pbWFnZV91cmwYCCABKAkiaAoKVGVhbUFuc3dlchIPCgd0ZWFtX2lkGAEgASgNEhIKCnRlYW1fY29sb3IYBCABKAkSIQoGYW5zd2VyGAIgASgLMhEuY29tbW9uLnYxLkNob2ljZRISCgppc19jb3JyZWN0GAMgASgIImgKFENoZWNrQW5zd2Vyc1Jlc3BvbnNlEiUKB2Fuc3dlcnMYASADKAsyFC5hZG1pbi52MS5UZWFtQW5zd2VyEikKDmNvcnJlY3RfY2hvaWNlGAIgASgLMhEuY29tbW9uLnYxLkNob2ljZSJMCglVc2VyU3RhdHMSEQoJdXNlcl9uYW1lGAEgASgJEhQKDGNvcnJlY3RfcmF0ZRgCIAEoAhIWCg5wZXJzb25hbF9vcmRlchgDIAEoDSKLAQoJVGVhbVN0YXRzEg8KB3RlYW1faWQYASABKA0SEgoKdGVhbV9jb2xvchgFIAEoCRIqCg1tZW1iZXJzX3N0YXRzGAIgAygLMhMuYWRtaW4udjEuVXNlclN0YXRzEhkKEXRlYW1fY29ycmVjdF9yYXRlGAMgASgCEhIKCnRlYW1fb3JkZXIYBCABKA0iewoQRW5kUXVlc3RSZXNwb25zZRIhCgZyZXN1bHQYASABKA4yES5jb21tb24udjEuUmVzdWx0EiIKBXN0YXRzGAIgAygLMhMuYWRtaW4udjEuVGVhbVN0YXRzEiAKBmF3YXJkcxgDIAMoCzIQLmNvbW1vbi52MS5Bd2FyZCKPAQoPUHJvZmlsZVF1ZXN0aW9uEhMKC3F1ZXN0aW9uX2lkGAEgASgNEh4KDXF1ZXN0aW9uX3RleHQYAiABKAlCB7pIBHICEAESGgoJcXVpel90ZXh0GAMgASgJQge6SARyAhABEhYKDnNhbXBsZV9hbnN3ZXJzGAQgAygJEhMKC2lzX29wdGlvbmFsGAUgASgIIkUKFUxpc3RRdWVzdGlvbnNSZXNwb25zZRIsCglxdWVzdGlvbnMYASADKAsyGS5hZG1pbi52MS5Qcm9maWxlUXVlc3Rpb24iLAoVRGVsZXRlUXVlc3Rpb25SZXF1ZXN0EhMKC3F1ZXN0aW9uX2lkGAEgASgNIjkKF1Jlb3JkZXJRdWVzdGlvbnNSZXF1ZXN0Eh4KDHF1ZXN0aW9uX2lkcxgBIAMoDUIIukgFkgECCAEihQEKDUZsYWdnZWRBbnN3ZXISDwoHdXNlcl9pZBgBIAEoCRIRCgl1c2VyX25hbWUYAiABKAkSEwoLcXVlc3Rpb25faWQYAyABKA0SFQoNcXVlc3Rpb25fdGV4dBgEIAEoCRIOCgZhbnN3ZXIYBSABKAkSFAoMbWF0Y2hlZF90ZXJtGAYgASgJIkYKGkxpc3RGbGFnZ2VkQW5zd2Vyc1Jlc3BvbnNlEigKB2Fuc3dlcnMYASADKAsyFy5hZG1pbi52MS5GbGFnZ2VkQW5zd2VyImAKFUVkaXRVc2VyQW5zd2VyUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABARITCgtxdWVzdGlvbl9pZBgCIAEoDRIXCgZhbnN3ZXIYAyABKAlCB7pIBHICEAEiSQoXUmVtb3ZlVXNlckFuc3dlclJlcXVlc3QSGQoHdXNlcl9pZBgBIAEoCUIIukgFcgOwAQESEwoLcXVlc3Rpb25faWQYAiABKA0iMwoWUmVtb3ZlVXNlckltYWdlUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABASJVChBSZXN1bHRUaHJlc2hvbGRzEhEKCWV4Y2VsbGVudBgBIAEoAhINCgVncmVhdBgCIAEoAhIQCghnb29kX2pvYhgDIAEoAhINCgVjbGVhchgEIAEoAiIvCglBd2FyZFJ1bGUSDwoHZW5hYmxlZBgBIAEoCBIRCgltaW5fY291bnQYAiABKAUimAIKCkF3YXJkUnVsZXMSNQoQZmFzdGVzdF9hbnN3ZXJlchgBIAEoCzITLmFkbWluLnYxLkF3YXJkUnVsZUIGukgDyAEBEjQKD21vc3RfbXlzdGVyaW91cxgCIAEoCzITLmFkbWluLnYxLkF3YXJkUnVsZUIGukgDyAEBEjQKD2Jlc3RfaGludF9naXZlchgDIAEoCzITLmFkbWluLnYxLkF3YXJkUnVsZUIGukgDyAEBEjIKDWNvbWViYWNrX3RlYW0YBCABKAsyEy5hZG1pbi52MS5Bd2FyZFJ1bGVCBrpIA8gBARIzCg5wZXJmZWN0X3N0cmVhaxgFIAEoCzITLmFkbWluLnYxLkF3YXJkUnVsZUIGukgDyAEBIqkCCglHYW1lUnVsZXMSGQoRY291bnRkb3duX3NlY29uZHMYASABKAUSGgoSaGludF9ib251c19zZWNvbmRzGAIgASgFEhcKD21heF9oaW50X2xlbmd0aBgDIAEoBRIZChFhbnN3ZXJfdGltZW91dF9tcxgEIAEoAxIWCg5tYXhfY2hvaWNlX251bRgFIAEoBRIVCg1sb2JieV90aWNrX21zGAYgASgDEhUKDW1pbl90ZWFtX3VzZXIYByABKAUSPQoRcmVzdWx0X3RocmVzaG9sZHMYCCABKAsyGi5hZG1pbi52MS5SZXN1bHRUaHJlc2hvbGRzQga6SAPIAQESLAoGYXdhcmRzGAkgASgLMhQuYWRtaW4udjEuQXdhcmRSdWxlc0IGukgDyAEBIosBChVTZXRFbnRyeUxpbWl0c1JlcXVlc3QSJwoRZXhwZWN0ZWRfdXNlcl9udW0YASABKAVCB7pIBBoCKABIAIgBARIiCgxtYXhfdXNlcl9udW0YAiABKAVCB7pIBBoCKABIAYgBAUIUChJfZXhwZWN0ZWRfdXNlcl9udW1CDwoNX21heF91c2VyX251bSI+CgtFbnRyeUxpbWl0cxIZChFleHBlY3RlZF91c2VyX251bRgBIAEoBRIUCgxtYXhfdXNlcl9udW0YAiABKAUiOQoVRXhwb3J0U2Vzc2lvblJlc3BvbnNlEhEKCWZpbGVfbmFtZRgBIAEoCRINCgVjaHVuaxgCIAEoDCI7ChFHZXRIaXN0b3J5UmVxdWVzdBIWCgVsaW1pdBgBIAEoDUIHukgEKgIYZBIOCgZvZmZzZXQYAiABKA0igwEKC0hpc3RvcnlUZWFtEg8KB3RlYW1faWQYASABKA0SEgoKdGVhbV9jb2xvchgCIAEoCRIVCg1jb3JyZWN0X2NvdW50GAMgASgNEg4KBnBvaW50cxgEIAEoDRIUCgxjb3JyZWN0X3JhdGUYBSABKAISEgoKdGVhbV9vcmRlchgGIAEoDSLHAQoNSGlzdG9yeVBsYXllchIRCglwbGF5ZXJfaWQYASABKAkSEwoLcGxheWVyX25hbWUYAiABKAkSDwoHdGVhbV9pZBgDIAEoDRIWCg5hbnN3ZXJlZF9jb3VudBgEIAEoDRIVCg1jb3JyZWN0X2NvdW50GAUgASgNEhQKDGNvcnJlY3RfcmF0ZRgGIAEoAhIgChhhdmVyYWdlX3Jlc3BvbnNlX3RpbWVfbXMYByABKAMSFgoOcGVyc29uYWxfb3JkZXIYCCABKA0i0gEKC0dhbWVIaXN0b3J5Eg8KB2dhbWVfaWQYASABKAkSFQoNc3RhcnRlZF9hdF9tcxgCIAEoAxITCgtlbmRlZF9hdF9tcxgDIAEoAxIiCgVydWxlcxgEIAEoCzITLmFkbWluLnYxLkdhbWVSdWxlcxISCgpxdWl6X2NvdW50GAUgASgNEiQKBXRlYW1zGAYgAygLMhUuYWRtaW4udjEuSGlzdG9yeVRlYW0SKAoHcGxheWVycxgHIAMoCzIXLmFkbWluLnYxLkhpc3RvcnlQbGF5ZXIiOgoSR2V0SGlzdG9yeVJlc3BvbnNlEiQKBWdhbWVzGAEgAygLMhUuYWRtaW4udjEuR2FtZUhpc3RvcnkiNgoXR2V0UGxheWVySGlzdG9yeVJlcXVlc3QSGwoJcGxheWVyX2lkGAEgASgJQgi6SAVyA7ABASKwAgoRUGxheWVyR2FtZUhpc3RvcnkSDwoHZ2FtZV9pZBgBIAEoCRIVCg1zdGFydGVkX2F0X21zGAIgASgDEhMKC3BsYXllcl9uYW1lGAMgASgJEg8KB3RlYW1faWQYBCABKA0SEgoKcXVpel9jb3VudBgFIAEoDRIWCg5hbnN3ZXJlZF9jb3VudBgGIAEoDRIVCg1jb3JyZWN0X2NvdW50GAcgASgNEhQKDGNvcnJlY3RfcmF0ZRgIIAEoAhIgChhhdmVyYWdlX3Jlc3BvbnNlX3RpbWVfbXMYCSABKAMSFgoOcGVyc29uYWxfb3JkZXIYCiABKA0SFAoMcGxheWVyX2NvdW50GAsgASgNEhIKCnRlYW1fb3JkZXIYDCABKA0SEAoIdGVhbV9udW0YDSABKA0ibgoYR2V0UGxheWVySGlzdG9yeVJlc3BvbnNlEhEKCXBsYXllcl9pZBgBIAEoCRITCgtwbGF5ZXJfbmFtZRgCIAEoCRIqCgVnYW1lcxgDIAMoCzIbLmFkbWluLnYxLlBsYXllckdhbWVIaXN0b3J5IrMBCg9NZW1iZXJLbm93bGVkZ2USDwoHdXNlcl9pZBgBIAEoCRIRCgl1c2VyX25hbWUYAiABKAkSDwoHdGVhbV9pZBgDIAEoDRISCgp0ZWFtX2NvbG9yGAQgASgJEhIKCnF1aXpfY291bnQYBSABKA0SFgoOYW5zd2VyZWRfY291bnQYBiABKA0SFQoNY29ycmVjdF9jb3VudBgHIAEoDRIUCgxjb3JyZWN0X3JhdGUYCCABKAIimQEKElF1ZXN0aW9uRGlmZmljdWx0eRITCgtxdWVzdGlvbl9pZBgBIAEoDRIVCg1xdWVzdGlvbl90ZXh0GAIgASgJEhIKCnF1aXpfY291bnQYAyABKA0SFgoOYW5zd2VyZWRfY291bnQYBCABKA0SFQoNY29ycmVjdF9jb3VudBgFIAEoDRIUCgxjb3JyZWN0X3JhdGUYBiABKAIipwEKDFJlbGF0aW9uc2hpcBITCgthbnN3ZXJlcl9pZBgBIAEoCRIVCg1hbnN3ZXJlcl9uYW1lGAIgASgJEhEKCXRhcmdldF9pZBgDIAEoCRITCgt0YXJnZXRfbmFtZRgEIAEoCRIWCg5hbnN3ZXJlZF9jb3VudBgFIAEoDRIVCg1jb3JyZWN0X2NvdW50GAYgASgNEhQKDGNvcnJlY3RfcmF0ZRgHIAEoAiKEAgoUR2V0QW5hbHl0aWNzUmVzcG9uc2USLQoKbW9zdF9rbm93bhgBIAMoCzIZLmFkbWluLnYxLk1lbWJlcktub3dsZWRnZRIuCgtsZWFzdF9rbm93bhgCIAMoCzIZLmFkbWluLnYxLk1lbWJlcktub3dsZWRnZRI3ChFoYXJkZXN0X3F1ZXN0aW9ucxgDIAMoCzIcLmFkbWluLnYxLlF1ZXN0aW9uRGlmZmljdWx0eRItCg1yZWxhdGlvbnNoaXBzGAQgAygLMhYuYWRtaW4udjEuUmVsYXRpb25zaGlwEhIKCmdyYXBoX2pzb24YBSABKAkSEQoJZ3JhcGhfZG90GAYgASgJMtQOCgxBZG1pblNlcnZpY2USTAoPUmVnaXN0QWRtaW5Vc2VyEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiEuYWRtaW4udjEuUmVnaXN0QWRtaW5Vc2VyUmVzcG9uc2USQgoJT3BlbkVudHJ5EhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhsuYWRtaW4udjEuT3BlbkVudHJ5UmVzcG9uc2UwARI8CgpDbG9zZUVudHJ5EhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkEKClJlamVjdFVzZXISGy5hZG1pbi52MS5SZWplY3RVc2VyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJBCgpDaGFuZ2VUZWFtEhsuYWRtaW4udjEuQ2hhbmdlVGVhbVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSRAoKU3RhcnRRdWVzdBIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRocLmFkbWluLnYxLlN0YXJ0UXVlc3RSZXNwb25zZTABEjsKCVJlYWR5UXVpehIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJGCgxDaGVja0Fuc3dlcnMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHi5hZG1pbi52MS5DaGVja0Fuc3dlcnNSZXNwb25zZRI6CghOZXh0UXVpehIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRI+CghFbmRRdWVzdBIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoaLmFkbWluLnYxLkVuZFF1ZXN0UmVzcG9uc2USSAoNTGlzdFF1ZXN0aW9ucxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRofLmFkbWluLnYxLkxpc3RRdWVzdGlvbnNSZXNwb25zZRJGCg5DcmVhdGVRdWVzdGlvbhIZLmFkbWluLnYxLlByb2ZpbGVRdWVzdGlvbhoZLmFkbWluLnYxLlByb2ZpbGVRdWVzdGlvbhJGCg5VcGRhdGVRdWVzdGlvbhIZLmFkbWluLnYxLlByb2ZpbGVRdWVzdGlvbhoZLmFkbWluLnYxLlByb2ZpbGVRdWVzdGlvbhJJCg5EZWxldGVRdWVzdGlvbhIfLmFkbWluLnYxLkRlbGV0ZVF1ZXN0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJWChBSZW9yZGVyUXVlc3Rpb25zEiEuYWRtaW4udjEuUmVvcmRlclF1ZXN0aW9uc1JlcXVlc3QaHy5hZG1pbi52MS5MaXN0UXVlc3Rpb25zUmVzcG9uc2USUgoSTGlzdEZsYWdnZWRBbnN3ZXJzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiQuYWRtaW4udjEuTGlzdEZsYWdnZWRBbnN3ZXJzUmVzcG9uc2USSQoORWRpdFVzZXJBbnN3ZXISHy5hZG1pbi52MS5FZGl0VXNlckFuc3dlclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSTQoQUmVtb3ZlVXNlckFuc3dlchIhLmFkbWluLnYxLlJlbW92ZVVzZXJBbnN3ZXJSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EksKD1JlbW92ZVVzZXJJbWFnZRIgLmFkbWluLnYxLlJlbW92ZVVzZXJJbWFnZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSNwoIR2V0UnVsZXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaEy5hZG1pbi52MS5HYW1lUnVsZXMSNAoIU2V0UnVsZXMSEy5hZG1pbi52MS5HYW1lUnVsZXMaEy5hZG1pbi52MS5HYW1lUnVsZXMSSAoOU2V0RW50cnlMaW1pdHMSHy5hZG1pbi52MS5TZXRFbnRyeUxpbWl0c1JlcXVlc3QaFS5hZG1pbi52MS5FbnRyeUxpbWl0cxJKCg1FeHBvcnRTZXNzaW9uEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh8uYWRtaW4udjEuRXhwb3J0U2Vzc2lvblJlc3BvbnNlMAESRwoKR2V0SGlzdG9yeRIbLmFkbWluLnYxLkdldEhpc3RvcnlSZXF1ZXN0GhwuYWRtaW4udjEuR2V0SGlzdG9yeVJlc3BvbnNlElkKEEdldFBsYXllckhpc3RvcnkSIS5hZG1pbi52MS5HZXRQbGF5ZXJIaXN0b3J5UmVxdWVzdBoiLmFkbWluLnYxLkdldFBsYXllckhpc3RvcnlSZXNwb25zZRJGCgxHZXRBbmFseXRpY3MSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHi5hZG1pbi52MS5HZXRBbmFseXRpY3NSZXNwb25zZUJUWlJnaXRodWIuY29tL2l0c3VhYnVzaDEwMDMvY3Vyc2VkLWZyYW1lL2JhY2tlbmQvZ29sYW5nL2ludGVybmFsL2dlbi9hZG1pbi92MTthZG1pbnYxYgZwcm90bzM", [file_buf_validate_validate, file_common_v1_common, file_google_protobuf_empty]);
 
 /**
  * @generated from message admin.v1.RegistAdminUserResponse
@@ -81,9 +82,18 @@ export type OpenEntryResponse = Message<"admin.v1.OpenEntryResponse"> & {
   enteredUsers: User[];
 
   /**
+   * 0の場合は未定
+   *
    * @generated from field: int32 expected_user_num = 2;
    */
   expectedUserNum: number;
+
+  /**
+   * 0の場合は無制限
+   *
+   * @generated from field: int32 max_user_num = 3;
+   */
+  maxUserNum: number;
 };
 
 /**
@@ -170,6 +180,13 @@ export type StartQuestResponse = Message<"admin.v1.StartQuestResponse"> & {
    * @generated from field: string hint_text = 7;
    */
   hintText: string;
+
+  /**
+   * 認証ヘッダ無しで画像を取得できる、期限付きの署名付きURL
+   *
+   * @generated from field: string target_user_image_url = 8;
+   */
+  targetUserImageUrl: string;
 };
 
 /**
@@ -310,6 +327,11 @@ export type EndQuestResponse = Message<"admin.v1.EndQuestResponse"> & {
    * @generated from field: repeated admin.v1.TeamStats stats = 2;
    */
   stats: TeamStats[];
+
+  /**
+   * @generated from field: repeated common.v1.Award awards = 3;
+   */
+  awards: Award[];
 };
 
 /**
@@ -320,88 +342,1150 @@ export const EndQuestResponseSchema: GenMessage<EndQuestResponse> = /*@__PURE__*
   messageDesc(file_admin_v1_admin, 10);
 
 /**
- * @generated from service admin.v1.AdminService
+ * @generated from message admin.v1.ProfileQuestion
  */
-export const AdminService: GenService<{
+export type ProfileQuestion = Message<"admin.v1.ProfileQuestion"> & {
   /**
-   * @generated from rpc admin.v1.AdminService.RegistAdminUser
+   * @generated from field: uint32 question_id = 1;
    */
-  registAdminUser: {
-    methodKind: "unary";
-    input: typeof EmptySchema;
-    output: typeof RegistAdminUserResponseSchema;
-  },
+  questionId: number;
+
   /**
-   * @generated from rpc admin.v1.AdminService.OpenEntry
+   * @generated from field: string question_text = 2;
    */
-  openEntry: {
-    methodKind: "server_streaming";
-    input: typeof EmptySchema;
-    output: typeof OpenEntryResponseSchema;
-  },
+  questionText: string;
+
   /**
-   * @generated from rpc admin.v1.AdminService.CloseEntry
+   * @generated from field: string quiz_text = 3;
    */
-  closeEntry: {
-    methodKind: "unary";
-    input: typeof EmptySchema;
-    output: typeof EmptySchema;
-  },
+  quizText: string;
+
   /**
-   * @generated from rpc admin.v1.AdminService.RejectUser
+   * @generated from field: repeated string sample_answers = 4;
    */
-  rejectUser: {
-    methodKind: "unary";
-    input: typeof RejectUserRequestSchema;
-    output: typeof EmptySchema;
-  },
+  sampleAnswers: string[];
+
   /**
-   * @generated from rpc admin.v1.AdminService.ChangeTeam
+   * @generated from field: bool is_optional = 5;
    */
-  changeTeam: {
-    methodKind: "unary";
-    input: typeof ChangeTeamRequestSchema;
-    output: typeof EmptySchema;
-  },
+  isOptional: boolean;
+};
+
+/**
+ * Describes the message admin.v1.ProfileQuestion.
+ * Use `create(ProfileQuestionSchema)` to create a new message.
+ */
+export const ProfileQuestionSchema: GenMessage<ProfileQuestion> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 11);
+
+/**
+ * @generated from message admin.v1.ListQuestionsResponse
+ */
+export type ListQuestionsResponse = Message<"admin.v1.ListQuestionsResponse"> & {
   /**
-   * @generated from rpc admin.v1.AdminService.StartQuest
+   * @generated from field: repeated admin.v1.ProfileQuestion questions = 1;
    */
-  startQuest: {
-    methodKind: "server_streaming";
-    input: typeof EmptySchema;
-    output: typeof StartQuestResponseSchema;
-  },
+  questions: ProfileQuestion[];
+};
+
+/**
+ * Describes the message admin.v1.ListQuestionsResponse.
+ * Use `create(ListQuestionsResponseSchema)` to create a new message.
+ */
+export const ListQuestionsResponseSchema: GenMessage<ListQuestionsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 12);
+
+/**
+ * @generated from message admin.v1.DeleteQuestionRequest
+ */
+export type DeleteQuestionRequest = Message<"admin.v1.DeleteQuestionRequest"> & {
   /**
-   * @generated from rpc admin.v1.AdminService.ReadyQuiz
+   * @generated from field: uint32 question_id = 1;
    */
-  readyQuiz: {
-    methodKind: "unary";
-    input: typeof EmptySchema;
-    output: typeof EmptySchema;
-  },
+  questionId: number;
+};
+
+/**
+ * Describes the message admin.v1.DeleteQuestionRequest.
+ * Use `create(DeleteQuestionRequestSchema)` to create a new message.
+ */
+export const DeleteQuestionRequestSchema: GenMessage<DeleteQuestionRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 13);
+
+/**
+ * @generated from message admin.v1.ReorderQuestionsRequest
+ */
+export type ReorderQuestionsRequest = Message<"admin.v1.ReorderQuestionsRequest"> & {
   /**
-   * @generated from rpc admin.v1.AdminService.CheckAnswers
+   * @generated from field: repeated uint32 question_ids = 1;
    */
-  checkAnswers: {
-    methodKind: "unary";
-    input: typeof EmptySchema;
-    output: typeof CheckAnswersResponseSchema;
-  },
+  questionIds: number[];
+};
+
+/**
+ * Describes the message admin.v1.ReorderQuestionsRequest.
+ * Use `create(ReorderQuestionsRequestSchema)` to create a new message.
+ */
+export const ReorderQuestionsRequestSchema: GenMessage<ReorderQuestionsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 14);
+
+/**
+ * @generated from message admin.v1.FlaggedAnswer
+ */
+export type FlaggedAnswer = Message<"admin.v1.FlaggedAnswer"> & {
   /**
-   * @generated from rpc admin.v1.AdminService.NextQuiz
+   * @generated from field: string user_id = 1;
    */
-  nextQuiz: {
-    methodKind: "unary";
-    input: typeof EmptySchema;
-    output: typeof EmptySchema;
-  },
+  userId: string;
+
   /**
-   * @generated from rpc admin.v1.AdminService.EndQuest
+   * @generated from field: string user_name = 2;
    */
-  endQuest: {
-    methodKind: "unary";
-    input: typeof EmptySchema;
-    output: typeof EndQuestResponseSchema;
+  userName: string;
+
+  /**
+   * @generated from field: uint32 question_id = 3;
+   */
+  questionId: number;
+
+  /**
+   * @generated from field: string question_text = 4;
+   */
+  questionText: string;
+
+  /**
+   * @generated from field: string answer = 5;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: string matched_term = 6;
+   */
+  matchedTerm: string;
+};
+
+/**
+ * Describes the message admin.v1.FlaggedAnswer.
+ * Use `create(FlaggedAnswerSchema)` to create a new message.
+ */
+export const FlaggedAnswerSchema: GenMessage<FlaggedAnswer> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 15);
+
+/**
+ * @generated from message admin.v1.ListFlaggedAnswersResponse
+ */
+export type ListFlaggedAnswersResponse = Message<"admin.v1.ListFlaggedAnswersResponse"> & {
+  /**
+   * @generated from field: repeated admin.v1.FlaggedAnswer answers = 1;
+   */
+  answers: FlaggedAnswer[];
+};
+
+/**
+ * Describes the message admin.v1.ListFlaggedAnswersResponse.
+ * Use `create(ListFlaggedAnswersResponseSchema)` to create a new message.
+ */
+export const ListFlaggedAnswersResponseSchema: GenMessage<ListFlaggedAnswersResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 16);
+
+/**
+ * @generated from message admin.v1.EditUserAnswerRequest
+ */
+export type EditUserAnswerRequest = Message<"admin.v1.EditUserAnswerRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 question_id = 2;
+   */
+  questionId: number;
+
+  /**
+   * @generated from field: string answer = 3;
+   */
+  answer: string;
+};
+
+/**
+ * Describes the message admin.v1.EditUserAnswerRequest.
+ * Use `create(EditUserAnswerRequestSchema)` to create a new message.
+ */
+export const EditUserAnswerRequestSchema: GenMessage<EditUserAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 17);
+
+/**
+ * @generated from message admin.v1.RemoveUserAnswerRequest
+ */
+export type RemoveUserAnswerRequest = Message<"admin.v1.RemoveUserAnswerRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: uint32 question_id = 2;
+   */
+  questionId: number;
+};
+
+/**
+ * Describes the message admin.v1.RemoveUserAnswerRequest.
+ * Use `create(RemoveUserAnswerRequestSchema)` to create a new message.
+ */
+export const RemoveUserAnswerRequestSchema: GenMessage<RemoveUserAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 18);
+
+/**
+ * @generated from message admin.v1.RemoveUserImageRequest
+ */
+export type RemoveUserImageRequest = Message<"admin.v1.RemoveUserImageRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message admin.v1.RemoveUserImageRequest.
+ * Use `create(RemoveUserImageRequestSchema)` to create a new message.
+ */
+export const RemoveUserImageRequestSchema: GenMessage<RemoveUserImageRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 19);
+
+/**
+ * @generated from message admin.v1.ResultThresholds
+ */
+export type ResultThresholds = Message<"admin.v1.ResultThresholds"> & {
+  /**
+   * @generated from field: float excellent = 1;
+   */
+  excellent: number;
+
+  /**
+   * @generated from field: float great = 2;
+   */
+  great: number;
+
+  /**
+   * @generated from field: float good_job = 3;
+   */
+  goodJob: number;
+
+  /**
+   * @generated from field: float clear = 4;
+   */
+  clear: number;
+};
+
+/**
+ * Describes the message admin.v1.ResultThresholds.
+ * Use `create(ResultThresholdsSchema)` to create a new message.
+ */
+export const ResultThresholdsSchema: GenMessage<ResultThresholds> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 20);
+
+/**
+ * min_countは賞によって、受賞に必要な回答数、点差、連続正解数になる
+ *
+ * @generated from message admin.v1.AwardRule
+ */
+export type AwardRule = Message<"admin.v1.AwardRule"> & {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: int32 min_count = 2;
+   */
+  minCount: number;
+};
+
+/**
+ * Describes the message admin.v1.AwardRule.
+ * Use `create(AwardRuleSchema)` to create a new message.
+ */
+export const AwardRuleSchema: GenMessage<AwardRule> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 21);
+
+/**
+ * @generated from message admin.v1.AwardRules
+ */
+export type AwardRules = Message<"admin.v1.AwardRules"> & {
+  /**
+   * @generated from field: admin.v1.AwardRule fastest_answerer = 1;
+   */
+  fastestAnswerer?: AwardRule;
+
+  /**
+   * @generated from field: admin.v1.AwardRule most_mysterious = 2;
+   */
+  mostMysterious?: AwardRule;
+
+  /**
+   * @generated from field: admin.v1.AwardRule best_hint_giver = 3;
+   */
+  bestHintGiver?: AwardRule;
+
+  /**
+   * @generated from field: admin.v1.AwardRule comeback_team = 4;
+   */
+  comebackTeam?: AwardRule;
+
+  /**
+   * @generated from field: admin.v1.AwardRule perfect_streak = 5;
+   */
+  perfectStreak?: AwardRule;
+};
+
+/**
+ * Describes the message admin.v1.AwardRules.
+ * Use `create(AwardRulesSchema)` to create a new message.
+ */
+export const AwardRulesSchema: GenMessage<AwardRules> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 22);
+
+/**
+ * @generated from message admin.v1.GameRules
+ */
+export type GameRules = Message<"admin.v1.GameRules"> & {
+  /**
+   * @generated from field: int32 countdown_seconds = 1;
+   */
+  countdownSeconds: number;
+
+  /**
+   * @generated from field: int32 hint_bonus_seconds = 2;
+   */
+  hintBonusSeconds: number;
+
+  /**
+   * @generated from field: int32 max_hint_length = 3;
+   */
+  maxHintLength: number;
+
+  /**
+   * @generated from field: int64 answer_timeout_ms = 4;
+   */
+  answerTimeoutMs: bigint;
+
+  /**
+   * @generated from field: int32 max_choice_num = 5;
+   */
+  maxChoiceNum: number;
+
+  /**
+   * @generated from field: int64 lobby_tick_ms = 6;
+   */
+  lobbyTickMs: bigint;
+
+  /**
+   * @generated from field: int32 min_team_user = 7;
+   */
+  minTeamUser: number;
+
+  /**
+   * @generated from field: admin.v1.ResultThresholds result_thresholds = 8;
+   */
+  resultThresholds?: ResultThresholds;
+
+  /**
+   * @generated from field: admin.v1.AwardRules awards = 9;
+   */
+  awards?: AwardRules;
+};
+
+/**
+ * Describes the message admin.v1.GameRules.
+ * Use `create(GameRulesSchema)` to create a new message.
+ */
+export const GameRulesSchema: GenMessage<GameRules> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 23);
+
+/**
+ * 指定しなかった項目は変更しない
+ *
+ * @generated from message admin.v1.SetEntryLimitsRequest
+ */
+export type SetEntryLimitsRequest = Message<"admin.v1.SetEntryLimitsRequest"> & {
+  /**
+   * @generated from field: optional int32 expected_user_num = 1;
+   */
+  expectedUserNum?: number;
+
+  /**
+   * @generated from field: optional int32 max_user_num = 2;
+   */
+  maxUserNum?: number;
+};
+
+/**
+ * Describes the message admin.v1.SetEntryLimitsRequest.
+ * Use `create(SetEntryLimitsRequestSchema)` to create a new message.
+ */
+export const SetEntryLimitsRequestSchema: GenMessage<SetEntryLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 24);
+
+/**
+ * @generated from message admin.v1.EntryLimits
+ */
+export type EntryLimits = Message<"admin.v1.EntryLimits"> & {
+  /**
+   * @generated from field: int32 expected_user_num = 1;
+   */
+  expectedUserNum: number;
+
+  /**
+   * @generated from field: int32 max_user_num = 2;
+   */
+  maxUserNum: number;
+};
+
+/**
+ * Describes the message admin.v1.EntryLimits.
+ * Use `create(EntryLimitsSchema)` to create a new message.
+ */
+export const EntryLimitsSchema: GenMessage<EntryLimits> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 25);
+
+/**
+ * ユーザ、チーム、プロフィールの回答、出題、回答、成績をJSONとCSVで、画像と合わせてZIPにまとめたものを分割して送る
+ * 受け取った順にchunkを繋げるとZIPになる
+ *
+ * @generated from message admin.v1.ExportSessionResponse
+ */
+export type ExportSessionResponse = Message<"admin.v1.ExportSessionResponse"> & {
+  /**
+   * 最初のメッセージにだけ入る
+   *
+   * @generated from field: string file_name = 1;
+   */
+  fileName: string;
+
+  /**
+   * @generated from field: bytes chunk = 2;
+   */
+  chunk: Uint8Array;
+};
+
+/**
+ * Describes the message admin.v1.ExportSessionResponse.
+ * Use `create(ExportSessionResponseSchema)` to create a new message.
+ */
+export const ExportSessionResponseSchema: GenMessage<ExportSessionResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 26);
+
+/**
+ * @generated from message admin.v1.GetHistoryRequest
+ */
+export type GetHistoryRequest = Message<"admin.v1.GetHistoryRequest"> & {
+  /**
+   * 0の場合は既定の件数
+   *
+   * @generated from field: uint32 limit = 1;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: uint32 offset = 2;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message admin.v1.GetHistoryRequest.
+ * Use `create(GetHistoryRequestSchema)` to create a new message.
+ */
+export const GetHistoryRequestSchema: GenMessage<GetHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 27);
+
+/**
+ * @generated from message admin.v1.HistoryTeam
+ */
+export type HistoryTeam = Message<"admin.v1.HistoryTeam"> & {
+  /**
+   * @generated from field: uint32 team_id = 1;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: string team_color = 2;
+   */
+  teamColor: string;
+
+  /**
+   * @generated from field: uint32 correct_count = 3;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: uint32 points = 4;
+   */
+  points: number;
+
+  /**
+   * @generated from field: float correct_rate = 5;
+   */
+  correctRate: number;
+
+  /**
+   * @generated from field: uint32 team_order = 6;
+   */
+  teamOrder: number;
+};
+
+/**
+ * Describes the message admin.v1.HistoryTeam.
+ * Use `create(HistoryTeamSchema)` to create a new message.
+ */
+export const HistoryTeamSchema: GenMessage<HistoryTeam> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 28);
+
+/**
+ * @generated from message admin.v1.HistoryPlayer
+ */
+export type HistoryPlayer = Message<"admin.v1.HistoryPlayer"> & {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * そのゲームで登録した名前
+   *
+   * @generated from field: string player_name = 2;
+   */
+  playerName: string;
+
+  /**
+   * @generated from field: uint32 team_id = 3;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: uint32 answered_count = 4;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 5;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: float correct_rate = 6;
+   */
+  correctRate: number;
+
+  /**
+   * @generated from field: int64 average_response_time_ms = 7;
+   */
+  averageResponseTimeMs: bigint;
+
+  /**
+   * @generated from field: uint32 personal_order = 8;
+   */
+  personalOrder: number;
+};
+
+/**
+ * Describes the message admin.v1.HistoryPlayer.
+ * Use `create(HistoryPlayerSchema)` to create a new message.
+ */
+export const HistoryPlayerSchema: GenMessage<HistoryPlayer> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 29);
+
+/**
+ * 時刻はUNIXミリ秒
+ *
+ * @generated from message admin.v1.GameHistory
+ */
+export type GameHistory = Message<"admin.v1.GameHistory"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: int64 started_at_ms = 2;
+   */
+  startedAtMs: bigint;
+
+  /**
+   * @generated from field: int64 ended_at_ms = 3;
+   */
+  endedAtMs: bigint;
+
+  /**
+   * @generated from field: admin.v1.GameRules rules = 4;
+   */
+  rules?: GameRules;
+
+  /**
+   * @generated from field: uint32 quiz_count = 5;
+   */
+  quizCount: number;
+
+  /**
+   * @generated from field: repeated admin.v1.HistoryTeam teams = 6;
+   */
+  teams: HistoryTeam[];
+
+  /**
+   * @generated from field: repeated admin.v1.HistoryPlayer players = 7;
+   */
+  players: HistoryPlayer[];
+};
+
+/**
+ * Describes the message admin.v1.GameHistory.
+ * Use `create(GameHistorySchema)` to create a new message.
+ */
+export const GameHistorySchema: GenMessage<GameHistory> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 30);
+
+/**
+ * @generated from message admin.v1.GetHistoryResponse
+ */
+export type GetHistoryResponse = Message<"admin.v1.GetHistoryResponse"> & {
+  /**
+   * @generated from field: repeated admin.v1.GameHistory games = 1;
+   */
+  games: GameHistory[];
+};
+
+/**
+ * Describes the message admin.v1.GetHistoryResponse.
+ * Use `create(GetHistoryResponseSchema)` to create a new message.
+ */
+export const GetHistoryResponseSchema: GenMessage<GetHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 31);
+
+/**
+ * @generated from message admin.v1.GetPlayerHistoryRequest
+ */
+export type GetPlayerHistoryRequest = Message<"admin.v1.GetPlayerHistoryRequest"> & {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+};
+
+/**
+ * Describes the message admin.v1.GetPlayerHistoryRequest.
+ * Use `create(GetPlayerHistoryRequestSchema)` to create a new message.
+ */
+export const GetPlayerHistoryRequestSchema: GenMessage<GetPlayerHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 32);
+
+/**
+ * @generated from message admin.v1.PlayerGameHistory
+ */
+export type PlayerGameHistory = Message<"admin.v1.PlayerGameHistory"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: int64 started_at_ms = 2;
+   */
+  startedAtMs: bigint;
+
+  /**
+   * @generated from field: string player_name = 3;
+   */
+  playerName: string;
+
+  /**
+   * @generated from field: uint32 team_id = 4;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: uint32 quiz_count = 5;
+   */
+  quizCount: number;
+
+  /**
+   * @generated from field: uint32 answered_count = 6;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 7;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: float correct_rate = 8;
+   */
+  correctRate: number;
+
+  /**
+   * @generated from field: int64 average_response_time_ms = 9;
+   */
+  averageResponseTimeMs: bigint;
+
+  /**
+   * @generated from field: uint32 personal_order = 10;
+   */
+  personalOrder: number;
+
+  /**
+   * @generated from field: uint32 player_count = 11;
+   */
+  playerCount: number;
+
+  /**
+   * @generated from field: uint32 team_order = 12;
+   */
+  teamOrder: number;
+
+  /**
+   * @generated from field: uint32 team_num = 13;
+   */
+  teamNum: number;
+};
+
+/**
+ * Describes the message admin.v1.PlayerGameHistory.
+ * Use `create(PlayerGameHistorySchema)` to create a new message.
+ */
+export const PlayerGameHistorySchema: GenMessage<PlayerGameHistory> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 33);
+
+/**
+ * @generated from message admin.v1.GetPlayerHistoryResponse
+ */
+export type GetPlayerHistoryResponse = Message<"admin.v1.GetPlayerHistoryResponse"> & {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: string player_name = 2;
+   */
+  playerName: string;
+
+  /**
+   * 古いゲームから順に並ぶ
+   *
+   * @generated from field: repeated admin.v1.PlayerGameHistory games = 3;
+   */
+  games: PlayerGameHistory[];
+};
+
+/**
+ * Describes the message admin.v1.GetPlayerHistoryResponse.
+ * Use `create(GetPlayerHistoryResponseSchema)` to create a new message.
+ */
+export const GetPlayerHistoryResponseSchema: GenMessage<GetPlayerHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 34);
+
+/**
+ * 出題対象としてどれだけ知られていたか
+ *
+ * @generated from message admin.v1.MemberKnowledge
+ */
+export type MemberKnowledge = Message<"admin.v1.MemberKnowledge"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string user_name = 2;
+   */
+  userName: string;
+
+  /**
+   * @generated from field: uint32 team_id = 3;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: string team_color = 4;
+   */
+  teamColor: string;
+
+  /**
+   * @generated from field: uint32 quiz_count = 5;
+   */
+  quizCount: number;
+
+  /**
+   * @generated from field: uint32 answered_count = 6;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 7;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: float correct_rate = 8;
+   */
+  correctRate: number;
+};
+
+/**
+ * Describes the message admin.v1.MemberKnowledge.
+ * Use `create(MemberKnowledgeSchema)` to create a new message.
+ */
+export const MemberKnowledgeSchema: GenMessage<MemberKnowledge> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 35);
+
+/**
+ * @generated from message admin.v1.QuestionDifficulty
+ */
+export type QuestionDifficulty = Message<"admin.v1.QuestionDifficulty"> & {
+  /**
+   * @generated from field: uint32 question_id = 1;
+   */
+  questionId: number;
+
+  /**
+   * @generated from field: string question_text = 2;
+   */
+  questionText: string;
+
+  /**
+   * @generated from field: uint32 quiz_count = 3;
+   */
+  quizCount: number;
+
+  /**
+   * @generated from field: uint32 answered_count = 4;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 5;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: float correct_rate = 6;
+   */
+  correctRate: number;
+};
+
+/**
+ * Describes the message admin.v1.QuestionDifficulty.
+ * Use `create(QuestionDifficultySchema)` to create a new message.
+ */
+export const QuestionDifficultySchema: GenMessage<QuestionDifficulty> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 36);
+
+/**
+ * 回答した人が出題対象の人についてどれだけ正解したか
+ *
+ * @generated from message admin.v1.Relationship
+ */
+export type Relationship = Message<"admin.v1.Relationship"> & {
+  /**
+   * @generated from field: string answerer_id = 1;
+   */
+  answererId: string;
+
+  /**
+   * @generated from field: string answerer_name = 2;
+   */
+  answererName: string;
+
+  /**
+   * @generated from field: string target_id = 3;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: string target_name = 4;
+   */
+  targetName: string;
+
+  /**
+   * @generated from field: uint32 answered_count = 5;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 6;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: float correct_rate = 7;
+   */
+  correctRate: number;
+};
+
+/**
+ * Describes the message admin.v1.Relationship.
+ * Use `create(RelationshipSchema)` to create a new message.
+ */
+export const RelationshipSchema: GenMessage<Relationship> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 37);
+
+/**
+ * @generated from message admin.v1.GetAnalyticsResponse
+ */
+export type GetAnalyticsResponse = Message<"admin.v1.GetAnalyticsResponse"> & {
+  /**
+   * @generated from field: repeated admin.v1.MemberKnowledge most_known = 1;
+   */
+  mostKnown: MemberKnowledge[];
+
+  /**
+   * @generated from field: repeated admin.v1.MemberKnowledge least_known = 2;
+   */
+  leastKnown: MemberKnowledge[];
+
+  /**
+   * @generated from field: repeated admin.v1.QuestionDifficulty hardest_questions = 3;
+   */
+  hardestQuestions: QuestionDifficulty[];
+
+  /**
+   * @generated from field: repeated admin.v1.Relationship relationships = 4;
+   */
+  relationships: Relationship[];
+
+  /**
+   * 参加者をノード、回答した人から出題対象の人への正解数を辺にしたグラフ
+   *
+   * @generated from field: string graph_json = 5;
+   */
+  graphJson: string;
+
+  /**
+   * @generated from field: string graph_dot = 6;
+   */
+  graphDot: string;
+};
+
+/**
+ * Describes the message admin.v1.GetAnalyticsResponse.
+ * Use `create(GetAnalyticsResponseSchema)` to create a new message.
+ */
+export const GetAnalyticsResponseSchema: GenMessage<GetAnalyticsResponse> = /*@__PURE__*/
+  messageDesc(file_admin_v1_admin, 38);
+
+/**
+ * @generated from service admin.v1.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * @generated from rpc admin.v1.AdminService.RegistAdminUser
+   */
+  registAdminUser: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof RegistAdminUserResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.OpenEntry
+   */
+  openEntry: {
+    methodKind: "server_streaming";
+    input: typeof EmptySchema;
+    output: typeof OpenEntryResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.CloseEntry
+   */
+  closeEntry: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.RejectUser
+   */
+  rejectUser: {
+    methodKind: "unary";
+    input: typeof RejectUserRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ChangeTeam
+   */
+  changeTeam: {
+    methodKind: "unary";
+    input: typeof ChangeTeamRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.StartQuest
+   */
+  startQuest: {
+    methodKind: "server_streaming";
+    input: typeof EmptySchema;
+    output: typeof StartQuestResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ReadyQuiz
+   */
+  readyQuiz: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.CheckAnswers
+   */
+  checkAnswers: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof CheckAnswersResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.NextQuiz
+   */
+  nextQuiz: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.EndQuest
+   */
+  endQuest: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EndQuestResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ListQuestions
+   */
+  listQuestions: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListQuestionsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.CreateQuestion
+   */
+  createQuestion: {
+    methodKind: "unary";
+    input: typeof ProfileQuestionSchema;
+    output: typeof ProfileQuestionSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.UpdateQuestion
+   */
+  updateQuestion: {
+    methodKind: "unary";
+    input: typeof ProfileQuestionSchema;
+    output: typeof ProfileQuestionSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.DeleteQuestion
+   */
+  deleteQuestion: {
+    methodKind: "unary";
+    input: typeof DeleteQuestionRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ReorderQuestions
+   */
+  reorderQuestions: {
+    methodKind: "unary";
+    input: typeof ReorderQuestionsRequestSchema;
+    output: typeof ListQuestionsResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ListFlaggedAnswers
+   */
+  listFlaggedAnswers: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListFlaggedAnswersResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.EditUserAnswer
+   */
+  editUserAnswer: {
+    methodKind: "unary";
+    input: typeof EditUserAnswerRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.RemoveUserAnswer
+   */
+  removeUserAnswer: {
+    methodKind: "unary";
+    input: typeof RemoveUserAnswerRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.RemoveUserImage
+   */
+  removeUserImage: {
+    methodKind: "unary";
+    input: typeof RemoveUserImageRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.GetRules
+   */
+  getRules: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GameRulesSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.SetRules
+   */
+  setRules: {
+    methodKind: "unary";
+    input: typeof GameRulesSchema;
+    output: typeof GameRulesSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.SetEntryLimits
+   */
+  setEntryLimits: {
+    methodKind: "unary";
+    input: typeof SetEntryLimitsRequestSchema;
+    output: typeof EntryLimitsSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.ExportSession
+   */
+  exportSession: {
+    methodKind: "server_streaming";
+    input: typeof EmptySchema;
+    output: typeof ExportSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.GetHistory
+   */
+  getHistory: {
+    methodKind: "unary";
+    input: typeof GetHistoryRequestSchema;
+    output: typeof GetHistoryResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.GetPlayerHistory
+   */
+  getPlayerHistory: {
+    methodKind: "unary";
+    input: typeof GetPlayerHistoryRequestSchema;
+    output: typeof GetPlayerHistoryResponseSchema;
+  },
+  /**
+   * @generated from rpc admin.v1.AdminService.GetAnalytics
+   */
+  getAnalytics: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetAnalyticsResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_admin_v1_admin, 0);
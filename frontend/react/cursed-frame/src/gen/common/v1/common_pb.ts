@@ -11,7 +11,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file common/v1/common.proto.
  */
 export const file_common_v1_common: GenFile = /*@__PURE__*/
-  fileDesc("ChZjb21tb24vdjEvY29tbW9uLnByb3RvEgljb21tb24udjEiMAoGQ2hvaWNlEhEKCWNob2ljZV9pZBgBIAEoDRITCgtjaG9pY2VfdGV4dBgCIAEoCSpkCgZSZXN1bHQSDwoLVU5TUEVDSUZJRUQQABILCgdQRVJGRUNUEAESDQoJRVhDRUxMRU5UEAISCQoFR1JFQVQQAxILCgdHT09ESk9CEAQSCQoFQ0xFQVIQBRIKCgZGQUlMRUQQBkJWWlRnaXRodWIuY29tL2l0c3VhYnVzaDEwMDMvY3Vyc2VkLWZyYW1lL2JhY2tlbmQvZ29sYW5nL2ludGVybmFsL2dlbi9jb21tb24vdjE7Y29tbW9udjFiBnByb3RvMw");
+  fileDesc("ChZjb21tb24vdjEvY29tbW9uLnByb3RvEgljb21tb24udjEiMAoGQ2hvaWNlEhEKCWNob2ljZV9pZBgBIAEoDRITCgtjaG9pY2VfdGV4dBgCIAEoCSKiAQoFQXdhcmQSIgoEa2luZBgBIAEoDjIULmNvbW1vbi52MS5Bd2FyZEtpbmQSEQoJdXNlcl9uYW1lGAIgASgJEg8KB3RlYW1faWQYAyABKA0SEgoKdGVhbV9jb2xvchgEIAEoCRINCgVjb3VudBgFIAEoDRIUCgxjb3JyZWN0X3JhdGUYBiABKAISGAoQcmVzcG9uc2VfdGltZV9tcxgHIAEoAypkCgZSZXN1bHQSDwoLVU5TUEVDSUZJRUQQABILCgdQRVJGRUNUEAESDQoJRVhDRUxMRU5UEAISCQoFR1JFQVQQAxILCgdHT09ESk9CEAQSCQoFQ0xFQVIQBRIKCgZGQUlMRUQQBirFAQoJQXdhcmRLaW5kEhoKFkFXQVJEX0tJTkRfVU5TUEVDSUZJRUQQABIfChtBV0FSRF9LSU5EX0ZBU1RFU1RfQU5TV0VSRVIQARIeChpBV0FSRF9LSU5EX01PU1RfTVlTVEVSSU9VUxACEh4KGkFXQVJEX0tJTkRfQkVTVF9ISU5UX0dJVkVSEAMSHAoYQVdBUkRfS0lORF9DT01FQkFDS19URUFNEAQSHQoZQVdBUkRfS0lORF9QRVJGRUNUX1NUUkVBSxAFQlZaVGdpdGh1Yi5jb20vaXRzdWFidXNoMTAwMy9jdXJzZWQtZnJhbWUvYmFja2VuZC9nb2xhbmcvaW50ZXJuYWwvZ2VuL2NvbW1vbi92MTtjb21tb252MWIGcHJvdG8z");
 
 /**
  * @generated from message common.v1.Choice
@@ -35,6 +35,61 @@ export type Choice = Message<"common.v1.Choice"> & {
 export const ChoiceSchema: GenMessage<Choice> = /*@__PURE__*/
   messageDesc(file_common_v1_common, 0);
 
+/**
+ * @generated from message common.v1.Award
+ */
+export type Award = Message<"common.v1.Award"> & {
+  /**
+   * @generated from field: common.v1.AwardKind kind = 1;
+   */
+  kind: AwardKind;
+
+  /**
+   * チームの賞の場合は空
+   *
+   * @generated from field: string user_name = 2;
+   */
+  userName: string;
+
+  /**
+   * @generated from field: uint32 team_id = 3;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: string team_color = 4;
+   */
+  teamColor: string;
+
+  /**
+   * 連続正解数、ひっくり返した点差、正解率の分母になった回答数のいずれか
+   *
+   * @generated from field: uint32 count = 5;
+   */
+  count: number;
+
+  /**
+   * 最も謎な人とヒント名人の正解率
+   *
+   * @generated from field: float correct_rate = 6;
+   */
+  correctRate: number;
+
+  /**
+   * 最速回答者の正解した回答の平均回答時間
+   *
+   * @generated from field: int64 response_time_ms = 7;
+   */
+  responseTimeMs: bigint;
+};
+
+/**
+ * Describes the message common.v1.Award.
+ * Use `create(AwardSchema)` to create a new message.
+ */
+export const AwardSchema: GenMessage<Award> = /*@__PURE__*/
+  messageDesc(file_common_v1_common, 1);
+
 /**
  * @generated from enum common.v1.Result
  */
@@ -81,3 +136,46 @@ export enum Result {
 export const ResultSchema: GenEnum<Result> = /*@__PURE__*/
   enumDesc(file_common_v1_common, 0);
 
+/**
+ * 結果発表で贈る賞
+ *
+ * @generated from enum common.v1.AwardKind
+ */
+export enum AwardKind {
+  /**
+   * @generated from enum value: AWARD_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AWARD_KIND_FASTEST_ANSWERER = 1;
+   */
+  FASTEST_ANSWERER = 1,
+
+  /**
+   * @generated from enum value: AWARD_KIND_MOST_MYSTERIOUS = 2;
+   */
+  MOST_MYSTERIOUS = 2,
+
+  /**
+   * @generated from enum value: AWARD_KIND_BEST_HINT_GIVER = 3;
+   */
+  BEST_HINT_GIVER = 3,
+
+  /**
+   * @generated from enum value: AWARD_KIND_COMEBACK_TEAM = 4;
+   */
+  COMEBACK_TEAM = 4,
+
+  /**
+   * @generated from enum value: AWARD_KIND_PERFECT_STREAK = 5;
+   */
+  PERFECT_STREAK = 5,
+}
+
+/**
+ * Describes the enum common.v1.AwardKind.
+ */
+export const AwardKindSchema: GenEnum<AwardKind> = /*@__PURE__*/
+  enumDesc(file_common_v1_common, 1);
+
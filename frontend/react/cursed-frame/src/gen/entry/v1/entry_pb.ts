@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file entry/v1/entry.proto.
  */
 export const file_entry_v1_entry: GenFile = /*@__PURE__*/
-  fileDesc("ChRlbnRyeS92MS9lbnRyeS5wcm90bxIIZW50cnkudjEiKgoMRW50cnlSZXF1ZXN0EhoKCXVzZXJfbmFtZRgBIAEoCUIHukgEcgIQASI8Cg1FbnRyeVJlc3BvbnNlEhQKDGFjY2Vzc190b2tlbhgCIAEoCRIVCg1yZWNvbm5lY3Rfa2V5GAMgASgJIjIKEFJlY29ubmVjdFJlcXVlc3QSHgoNcmVjb25uZWN0X2tleRgCIAEoCUIHukgEcgIQECIpChFSZWNvbm5lY3RSZXNwb25zZRIUCgxhY2Nlc3NfdG9rZW4YASABKAkyjgEKDEVudHJ5U2VydmljZRI4CgVFbnRyeRIWLmVudHJ5LnYxLkVudHJ5UmVxdWVzdBoXLmVudHJ5LnYxLkVudHJ5UmVzcG9uc2USRAoJUmVjb25uZWN0EhouZW50cnkudjEuUmVjb25uZWN0UmVxdWVzdBobLmVudHJ5LnYxLlJlY29ubmVjdFJlc3BvbnNlQlRaUmdpdGh1Yi5jb20vaXRzdWFidXNoMTAwMy9jdXJzZWQtZnJhbWUvYmFja2VuZC9nb2xhbmcvaW50ZXJuYWwvZ2VuL2VudHJ5L3YxO2VudHJ5djFiBnByb3RvMw", [file_buf_validate_validate]);
+  fileDesc("ChRlbnRyeS92MS9lbnRyeS5wcm90bxIIZW50cnkudjEiPgoMRW50cnlSZXF1ZXN0EhoKCXVzZXJfbmFtZRgBIAEoCUIHukgEcgIQARISCgpwbGF5ZXJfa2V5GAIgASgJIlAKDUVudHJ5UmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAIgASgJEhUKDXJlY29ubmVjdF9rZXkYAyABKAkSEgoKcGxheWVyX2tleRgEIAEoCSIyChBSZWNvbm5lY3RSZXF1ZXN0Eh4KDXJlY29ubmVjdF9rZXkYAiABKAlCB7pIBHICEBAiKQoRUmVjb25uZWN0UmVzcG9uc2USFAoMYWNjZXNzX3Rva2VuGAEgASgJMo4BCgxFbnRyeVNlcnZpY2USOAoFRW50cnkSFi5lbnRyeS52MS5FbnRyeVJlcXVlc3QaFy5lbnRyeS52MS5FbnRyeVJlc3BvbnNlEkQKCVJlY29ubmVjdBIaLmVudHJ5LnYxLlJlY29ubmVjdFJlcXVlc3QaGy5lbnRyeS52MS5SZWNvbm5lY3RSZXNwb25zZUJUWlJnaXRodWIuY29tL2l0c3VhYnVzaDEwMDMvY3Vyc2VkLWZyYW1lL2JhY2tlbmQvZ29sYW5nL2ludGVybmFsL2dlbi9lbnRyeS92MTtlbnRyeXYxYgZwcm90bzM", [file_buf_validate_validate]);
 
 /**
  * @generated from message entry.v1.EntryRequest
@@ -22,6 +22,13 @@ export type EntryRequest = Message<"entry.v1.EntryRequest"> & {
    * @generated from field: string user_name = 1;
    */
   userName: string;
+
+  /**
+   * 以前のゲームで受け取った鍵、送ると同じプレイヤーとして履歴を残す
+   *
+   * @generated from field: string player_key = 2;
+   */
+  playerKey: string;
 };
 
 /**
@@ -44,6 +51,13 @@ export type EntryResponse = Message<"entry.v1.EntryResponse"> & {
    * @generated from field: string reconnect_key = 3;
    */
   reconnectKey: string;
+
+  /**
+   * 次のゲームでも送れるように端末に保存しておく
+   *
+   * @generated from field: string player_key = 4;
+   */
+  playerKey: string;
 };
 
 /**
@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { GetTeamInfoResponse, RegistProfileRequest, RegistProfileResponse } from "./lobby_pb.js";
+import { GetNextQuestionResponse, GetTeamInfoResponse, ListMyProfileResponse, MyProfileAnswer, RegistProfileRequest, RegistProfileResponse, UpdateProfileAnswerRequest } from "./lobby_pb.js";
 
 export const typeName = "lobby.v1.LobbyService";
 
@@ -26,6 +26,57 @@ export const registProfile = createQueryService({
   },
 }).registProfile;
 
+/**
+ * @generated from rpc lobby.v1.LobbyService.GetNextQuestion
+ */
+export const getNextQuestion = createQueryService({
+  service: {
+    methods: {
+      getNextQuestion: {
+        name: "GetNextQuestion",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: GetNextQuestionResponse,
+      },
+    },
+    typeName: "lobby.v1.LobbyService",
+  },
+}).getNextQuestion;
+
+/**
+ * @generated from rpc lobby.v1.LobbyService.ListMyProfile
+ */
+export const listMyProfile = createQueryService({
+  service: {
+    methods: {
+      listMyProfile: {
+        name: "ListMyProfile",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: ListMyProfileResponse,
+      },
+    },
+    typeName: "lobby.v1.LobbyService",
+  },
+}).listMyProfile;
+
+/**
+ * @generated from rpc lobby.v1.LobbyService.UpdateProfileAnswer
+ */
+export const updateProfileAnswer = createQueryService({
+  service: {
+    methods: {
+      updateProfileAnswer: {
+        name: "UpdateProfileAnswer",
+        kind: MethodKind.Unary,
+        I: UpdateProfileAnswerRequest,
+        O: MyProfileAnswer,
+      },
+    },
+    typeName: "lobby.v1.LobbyService",
+  },
+}).updateProfileAnswer;
+
 /**
  * @generated from rpc lobby.v1.LobbyService.IsReady
  */
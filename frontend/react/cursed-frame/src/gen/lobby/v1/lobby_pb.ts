@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file lobby/v1/lobby.proto.
  */
 export const file_lobby_v1_lobby: GenFile = /*@__PURE__*/
-  fileDesc("ChRsb2JieS92MS9sb2JieS5wcm90bxIIbG9iYnkudjEiIwoLTG9iYnlTdGF0dXMSFAoMaXNfYWxsX3JlYWR5GAEgASgIIjsKFFJlZ2lzdFByb2ZpbGVSZXF1ZXN0EhMKC3F1ZXN0aW9uX2lkGAEgASgNEg4KBmFuc3dlchgCIAEoCSJlChVSZWdpc3RQcm9maWxlUmVzcG9uc2USGAoQbmV4dF9xdWVzdGlvbl9pZBgBIAEoDRIaChJuZXh0X3F1ZXN0aW9uX3RleHQYAiABKAkSFgoObm9fbW9yZV9hbnN3ZXIYAyABKAgiSwoTR2V0VGVhbUluZm9SZXNwb25zZRIPCgd0ZWFtX2lkGAEgASgNEhIKCnRlYW1fY29sb3IYAiABKAkSDwoHbWVtYmVycxgDIAMoCTKfAgoMTG9iYnlTZXJ2aWNlEjwKCUpvaW5Mb2JieRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoVLmxvYmJ5LnYxLkxvYmJ5U3RhdHVzMAESUAoNUmVnaXN0UHJvZmlsZRIeLmxvYmJ5LnYxLlJlZ2lzdFByb2ZpbGVSZXF1ZXN0Gh8ubG9iYnkudjEuUmVnaXN0UHJvZmlsZVJlc3BvbnNlEjkKB0lzUmVhZHkSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSRAoLR2V0VGVhbUluZm8SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHS5sb2JieS52MS5HZXRUZWFtSW5mb1Jlc3BvbnNlQlRaUmdpdGh1Yi5jb20vaXRzdWFidXNoMTAwMy9jdXJzZWQtZnJhbWUvYmFja2VuZC9nb2xhbmcvaW50ZXJuYWwvZ2VuL2xvYmJ5L3YxO2xvYmJ5djFiBnByb3RvMw", [file_google_protobuf_empty]);
+  fileDesc("ChRsb2JieS92MS9sb2JieS5wcm90bxIIbG9iYnkudjEiQwoLTG9iYnlTdGF0dXMSFAoMaXNfYWxsX3JlYWR5GAEgASgIEh4KFmltYWdlX3VwbG9hZF9yZXF1ZXN0ZWQYAiABKAgiSQoUUmVnaXN0UHJvZmlsZVJlcXVlc3QSEwoLcXVlc3Rpb25faWQYASABKA0SDgoGYW5zd2VyGAIgASgJEgwKBHNraXAYAyABKAgipwEKFVJlZ2lzdFByb2ZpbGVSZXNwb25zZRIYChBuZXh0X3F1ZXN0aW9uX2lkGAEgASgNEhoKEm5leHRfcXVlc3Rpb25fdGV4dBgCIAEoCRIWCg5ub19tb3JlX2Fuc3dlchgDIAEoCBITCgtpc19vcHRpb25hbBgEIAEoCBIWCg5hbnN3ZXJlZF9jb3VudBgFIAEoDRITCgt0b3RhbF9jb3VudBgGIAEoDSKpAQoXR2V0TmV4dFF1ZXN0aW9uUmVzcG9uc2USGAoQbmV4dF9xdWVzdGlvbl9pZBgBIAEoDRIaChJuZXh0X3F1ZXN0aW9uX3RleHQYAiABKAkSFgoObm9fbW9yZV9hbnN3ZXIYAyABKAgSEwoLaXNfb3B0aW9uYWwYBCABKAgSFgoOYW5zd2VyZWRfY291bnQYBSABKA0SEwoLdG90YWxfY291bnQYBiABKA0iiwEKD015UHJvZmlsZUFuc3dlchITCgtxdWVzdGlvbl9pZBgBIAEoDRIVCg1xdWVzdGlvbl90ZXh0GAIgASgJEg4KBmFuc3dlchgDIAEoCRITCgtpc19hbnN3ZXJlZBgEIAEoCBITCgtpc19vcHRpb25hbBgFIAEoCBISCgppc19za2lwcGVkGAYgASgIIlgKFUxpc3RNeVByb2ZpbGVSZXNwb25zZRIqCgdhbnN3ZXJzGAEgAygLMhkubG9iYnkudjEuTXlQcm9maWxlQW5zd2VyEhMKC2lzX2VkaXRhYmxlGAIgASgIIkEKGlVwZGF0ZVByb2ZpbGVBbnN3ZXJSZXF1ZXN0EhMKC3F1ZXN0aW9uX2lkGAEgASgNEg4KBmFuc3dlchgCIAEoCSIyCgpUZWFtTWVtYmVyEhEKCXVzZXJfbmFtZRgBIAEoCRIRCglpbWFnZV91cmwYAiABKAkieQoTR2V0VGVhbUluZm9SZXNwb25zZRIPCgd0ZWFtX2lkGAEgASgNEhIKCnRlYW1fY29sb3IYAiABKAkSDwoHbWVtYmVycxgDIAMoCRIsCg5tZW1iZXJfZGV0YWlscxgEIAMoCzIULmxvYmJ5LnYxLlRlYW1NZW1iZXIyjwQKDExvYmJ5U2VydmljZRI8CglKb2luTG9iYnkSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFS5sb2JieS52MS5Mb2JieVN0YXR1czABElAKDVJlZ2lzdFByb2ZpbGUSHi5sb2JieS52MS5SZWdpc3RQcm9maWxlUmVxdWVzdBofLmxvYmJ5LnYxLlJlZ2lzdFByb2ZpbGVSZXNwb25zZRJMCg9HZXROZXh0UXVlc3Rpb24SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIS5sb2JieS52MS5HZXROZXh0UXVlc3Rpb25SZXNwb25zZRJICg1MaXN0TXlQcm9maWxlEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh8ubG9iYnkudjEuTGlzdE15UHJvZmlsZVJlc3BvbnNlElYKE1VwZGF0ZVByb2ZpbGVBbnN3ZXISJC5sb2JieS52MS5VcGRhdGVQcm9maWxlQW5zd2VyUmVxdWVzdBoZLmxvYmJ5LnYxLk15UHJvZmlsZUFuc3dlchI5CgdJc1JlYWR5EhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EkQKC0dldFRlYW1JbmZvEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh0ubG9iYnkudjEuR2V0VGVhbUluZm9SZXNwb25zZUJUWlJnaXRodWIuY29tL2l0c3VhYnVzaDEwMDMvY3Vyc2VkLWZyYW1lL2JhY2tlbmQvZ29sYW5nL2ludGVybmFsL2dlbi9sb2JieS92MTtsb2JieXYxYgZwcm90bzM", [file_google_protobuf_empty]);
 
 /**
  * @generated from message lobby.v1.LobbyStatus
@@ -23,6 +23,13 @@ export type LobbyStatus = Message<"lobby.v1.LobbyStatus"> & {
    * @generated from field: bool is_all_ready = 1;
    */
   isAllReady: boolean;
+
+  /**
+   * 管理者に画像を消されたので、撮り直してアップロードする必要がある
+   *
+   * @generated from field: bool image_upload_requested = 2;
+   */
+  imageUploadRequested: boolean;
 };
 
 /**
@@ -45,6 +52,11 @@ export type RegistProfileRequest = Message<"lobby.v1.RegistProfileRequest"> & {
    * @generated from field: string answer = 2;
    */
   answer: string;
+
+  /**
+   * @generated from field: bool skip = 3;
+   */
+  skip: boolean;
 };
 
 /**
@@ -72,6 +84,21 @@ export type RegistProfileResponse = Message<"lobby.v1.RegistProfileResponse"> &
    * @generated from field: bool no_more_answer = 3;
    */
   noMoreAnswer: boolean;
+
+  /**
+   * @generated from field: bool is_optional = 4;
+   */
+  isOptional: boolean;
+
+  /**
+   * @generated from field: uint32 answered_count = 5;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 total_count = 6;
+   */
+  totalCount: number;
 };
 
 /**
@@ -81,6 +108,158 @@ export type RegistProfileResponse = Message<"lobby.v1.RegistProfileResponse"> &
 export const RegistProfileResponseSchema: GenMessage<RegistProfileResponse> = /*@__PURE__*/
   messageDesc(file_lobby_v1_lobby, 2);
 
+/**
+ * @generated from message lobby.v1.GetNextQuestionResponse
+ */
+export type GetNextQuestionResponse = Message<"lobby.v1.GetNextQuestionResponse"> & {
+  /**
+   * @generated from field: uint32 next_question_id = 1;
+   */
+  nextQuestionId: number;
+
+  /**
+   * @generated from field: string next_question_text = 2;
+   */
+  nextQuestionText: string;
+
+  /**
+   * @generated from field: bool no_more_answer = 3;
+   */
+  noMoreAnswer: boolean;
+
+  /**
+   * @generated from field: bool is_optional = 4;
+   */
+  isOptional: boolean;
+
+  /**
+   * @generated from field: uint32 answered_count = 5;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 total_count = 6;
+   */
+  totalCount: number;
+};
+
+/**
+ * Describes the message lobby.v1.GetNextQuestionResponse.
+ * Use `create(GetNextQuestionResponseSchema)` to create a new message.
+ */
+export const GetNextQuestionResponseSchema: GenMessage<GetNextQuestionResponse> = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 3);
+
+/**
+ * @generated from message lobby.v1.MyProfileAnswer
+ */
+export type MyProfileAnswer = Message<"lobby.v1.MyProfileAnswer"> & {
+  /**
+   * @generated from field: uint32 question_id = 1;
+   */
+  questionId: number;
+
+  /**
+   * @generated from field: string question_text = 2;
+   */
+  questionText: string;
+
+  /**
+   * @generated from field: string answer = 3;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: bool is_answered = 4;
+   */
+  isAnswered: boolean;
+
+  /**
+   * @generated from field: bool is_optional = 5;
+   */
+  isOptional: boolean;
+
+  /**
+   * @generated from field: bool is_skipped = 6;
+   */
+  isSkipped: boolean;
+};
+
+/**
+ * Describes the message lobby.v1.MyProfileAnswer.
+ * Use `create(MyProfileAnswerSchema)` to create a new message.
+ */
+export const MyProfileAnswerSchema: GenMessage<MyProfileAnswer> = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 4);
+
+/**
+ * @generated from message lobby.v1.ListMyProfileResponse
+ */
+export type ListMyProfileResponse = Message<"lobby.v1.ListMyProfileResponse"> & {
+  /**
+   * @generated from field: repeated lobby.v1.MyProfileAnswer answers = 1;
+   */
+  answers: MyProfileAnswer[];
+
+  /**
+   * @generated from field: bool is_editable = 2;
+   */
+  isEditable: boolean;
+};
+
+/**
+ * Describes the message lobby.v1.ListMyProfileResponse.
+ * Use `create(ListMyProfileResponseSchema)` to create a new message.
+ */
+export const ListMyProfileResponseSchema: GenMessage<ListMyProfileResponse> = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 5);
+
+/**
+ * @generated from message lobby.v1.UpdateProfileAnswerRequest
+ */
+export type UpdateProfileAnswerRequest = Message<"lobby.v1.UpdateProfileAnswerRequest"> & {
+  /**
+   * @generated from field: uint32 question_id = 1;
+   */
+  questionId: number;
+
+  /**
+   * @generated from field: string answer = 2;
+   */
+  answer: string;
+};
+
+/**
+ * Describes the message lobby.v1.UpdateProfileAnswerRequest.
+ * Use `create(UpdateProfileAnswerRequestSchema)` to create a new message.
+ */
+export const UpdateProfileAnswerRequestSchema: GenMessage<UpdateProfileAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 6);
+
+/**
+ * @generated from message lobby.v1.TeamMember
+ */
+export type TeamMember = Message<"lobby.v1.TeamMember"> & {
+  /**
+   * @generated from field: string user_name = 1;
+   */
+  userName: string;
+
+  /**
+   * サムネイルの署名付きURL、写真が無い場合は代わりに生成した画像のURL
+   *
+   * @generated from field: string image_url = 2;
+   */
+  imageUrl: string;
+};
+
+/**
+ * Describes the message lobby.v1.TeamMember.
+ * Use `create(TeamMemberSchema)` to create a new message.
+ */
+export const TeamMemberSchema: GenMessage<TeamMember> = /*@__PURE__*/
+  messageDesc(file_lobby_v1_lobby, 7);
+
 /**
  * @generated from message lobby.v1.GetTeamInfoResponse
  */
@@ -99,6 +278,11 @@ export type GetTeamInfoResponse = Message<"lobby.v1.GetTeamInfoResponse"> & {
    * @generated from field: repeated string members = 3;
    */
   members: string[];
+
+  /**
+   * @generated from field: repeated lobby.v1.TeamMember member_details = 4;
+   */
+  memberDetails: TeamMember[];
 };
 
 /**
@@ -106,7 +290,7 @@ export type GetTeamInfoResponse = Message<"lobby.v1.GetTeamInfoResponse"> & {
  * Use `create(GetTeamInfoResponseSchema)` to create a new message.
  */
 export const GetTeamInfoResponseSchema: GenMessage<GetTeamInfoResponse> = /*@__PURE__*/
-  messageDesc(file_lobby_v1_lobby, 3);
+  messageDesc(file_lobby_v1_lobby, 8);
 
 /**
  * @generated from service lobby.v1.LobbyService
@@ -128,6 +312,30 @@ export const LobbyService: GenService<{
     input: typeof RegistProfileRequestSchema;
     output: typeof RegistProfileResponseSchema;
   },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.GetNextQuestion
+   */
+  getNextQuestion: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetNextQuestionResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.ListMyProfile
+   */
+  listMyProfile: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListMyProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc lobby.v1.LobbyService.UpdateProfileAnswer
+   */
+  updateProfileAnswer: {
+    methodKind: "unary";
+    input: typeof UpdateProfileAnswerRequestSchema;
+    output: typeof MyProfileAnswerSchema;
+  },
   /**
    * @generated from rpc lobby.v1.LobbyService.IsReady
    */
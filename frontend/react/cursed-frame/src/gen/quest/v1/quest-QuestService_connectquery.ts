@@ -5,7 +5,7 @@
 
 import { createQueryService } from "@bufbuild/connect-query";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { AnswerRequest, AnswerResponse, GetResultResponse, TakeHintRequest } from "./quest_pb.js";
+import { AnswerRequest, AnswerResponse, GetPersonalReportResponse, GetResultResponse, TakeHintRequest, UseLifelineRequest, UseLifelineResponse } from "./quest_pb.js";
 
 export const typeName = "quest.v1.QuestService";
 
@@ -59,3 +59,37 @@ export const getResult = createQueryService({
     typeName: "quest.v1.QuestService",
   },
 }).getResult;
+
+/**
+ * @generated from rpc quest.v1.QuestService.UseLifeline
+ */
+export const useLifeline = createQueryService({
+  service: {
+    methods: {
+      useLifeline: {
+        name: "UseLifeline",
+        kind: MethodKind.Unary,
+        I: UseLifelineRequest,
+        O: UseLifelineResponse,
+      },
+    },
+    typeName: "quest.v1.QuestService",
+  },
+}).useLifeline;
+
+/**
+ * @generated from rpc quest.v1.QuestService.GetPersonalReport
+ */
+export const getPersonalReport = createQueryService({
+  service: {
+    methods: {
+      getPersonalReport: {
+        name: "GetPersonalReport",
+        kind: MethodKind.Unary,
+        I: Empty,
+        O: GetPersonalReportResponse,
+      },
+    },
+    typeName: "quest.v1.QuestService",
+  },
+}).getPersonalReport;
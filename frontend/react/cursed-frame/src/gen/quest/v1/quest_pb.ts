@@ -3,9 +3,10 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { Choice, Result } from "../../common/v1/common_pb";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Award, Choice, Result } from "../../common/v1/common_pb";
 import { file_common_v1_common } from "../../common/v1/common_pb";
 import type { EmptySchema } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
@@ -15,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file quest/v1/quest.proto.
  */
 export const file_quest_v1_quest: GenFile = /*@__PURE__*/
-  fileDesc("ChRxdWVzdC92MS9xdWVzdC5wcm90bxIIcXVlc3QudjEizwEKElN0YXJ0UXVlc3RSZXNwb25zZRIcChR0YXJnZXRfdXNlcl9pbWFnZV9pZBgBIAEoCRIWCg50YXJnZXRfdGVhbV9pZBgCIAEoDRITCgtxdWVzdGlvbl9pZBgDIAEoDRIQCghxdWVzdGlvbhgEIAEoCRIiCgdjaG9pY2VzGAUgAygLMhEuY29tbW9uLnYxLkNob2ljZRISCgpjYW5fYW5zd2VyGAYgASgIEhEKCWlzX3RhcmdldBgHIAEoCBIRCglsYXN0X3RpbWUYCCABKAUiRwoNQW5zd2VyUmVxdWVzdBITCgtxdWVzdGlvbl9pZBgBIAEoDRIhCgZhbnN3ZXIYAiABKAsyES5jb21tb24udjEuQ2hvaWNlImIKDkFuc3dlclJlc3BvbnNlEhIKCmlzX2NvcnJlY3QYASABKAgSJgoLdGVhbV9hbnN3ZXIYAiABKAsyES5jb21tb24udjEuQ2hvaWNlEhQKDGFuc3dlcl9jb3VudBgDIAMoBSIfCg9UYWtlSGludFJlcXVlc3QSDAoEaGludBgBIAEoCSJ5ChFHZXRSZXN1bHRSZXNwb25zZRIhCgZyZXN1bHQYASABKA4yES5jb21tb24udjEuUmVzdWx0EhIKCnRlYW1fb3JkZXIYAiABKA0SFgoOcGVyc29uYWxfb3JkZXIYAyABKA0SFQoNcGVyc29uYWxfcmF0ZRgEIAEoAjKSAgoMUXVlc3RTZXJ2aWNlEkQKClN0YXJ0UXVlc3QSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHC5xdWVzdC52MS5TdGFydFF1ZXN0UmVzcG9uc2UwARI7CgZBbnN3ZXISFy5xdWVzdC52MS5BbnN3ZXJSZXF1ZXN0GhgucXVlc3QudjEuQW5zd2VyUmVzcG9uc2USPQoIVGFrZUhpbnQSGS5xdWVzdC52MS5UYWtlSGludFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSQAoJR2V0UmVzdWx0EhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhsucXVlc3QudjEuR2V0UmVzdWx0UmVzcG9uc2VCVFpSZ2l0aHViLmNvbS9pdHN1YWJ1c2gxMDAzL2N1cnNlZC1mcmFtZS9iYWNrZW5kL2dvbGFuZy9pbnRlcm5hbC9nZW4vcXVlc3QvdjE7cXVlc3R2MWIGcHJvdG8z", [file_common_v1_common, file_google_protobuf_empty]);
+  fileDesc("ChRxdWVzdC92MS9xdWVzdC5wcm90bxIIcXVlc3QudjEinwIKElN0YXJ0UXVlc3RSZXNwb25zZRIcChR0YXJnZXRfdXNlcl9pbWFnZV9pZBgBIAEoCRIWCg50YXJnZXRfdGVhbV9pZBgCIAEoDRITCgtxdWVzdGlvbl9pZBgDIAEoDRIQCghxdWVzdGlvbhgEIAEoCRIiCgdjaG9pY2VzGAUgAygLMhEuY29tbW9uLnYxLkNob2ljZRISCgpjYW5fYW5zd2VyGAYgASgIEhEKCWlzX3RhcmdldBgHIAEoCBIRCglsYXN0X3RpbWUYCCABKAUSLwoTYXZhaWxhYmxlX2xpZmVsaW5lcxgJIAMoDjISLnF1ZXN0LnYxLkxpZmVsaW5lEh0KFXRhcmdldF91c2VyX2ltYWdlX3VybBgKIAEoCSJHCg1BbnN3ZXJSZXF1ZXN0EhMKC3F1ZXN0aW9uX2lkGAEgASgNEiEKBmFuc3dlchgCIAEoCzIRLmNvbW1vbi52MS5DaG9pY2UiYgoOQW5zd2VyUmVzcG9uc2USEgoKaXNfY29ycmVjdBgBIAEoCBImCgt0ZWFtX2Fuc3dlchgCIAEoCzIRLmNvbW1vbi52MS5DaG9pY2USFAoMYW5zd2VyX2NvdW50GAMgAygFIh8KD1Rha2VIaW50UmVxdWVzdBIMCgRoaW50GAEgASgJIlsKElVzZUxpZmVsaW5lUmVxdWVzdBITCgtxdWVzdGlvbl9pZBgBIAEoDRIwCghsaWZlbGluZRgCIAEoDjISLnF1ZXN0LnYxLkxpZmVsaW5lQgq6SAeCAQQQASAAIpoBChNVc2VMaWZlbGluZVJlc3BvbnNlEiIKB2Nob2ljZXMYASADKAsyES5jb21tb24udjEuQ2hvaWNlEhQKDGFuc3dlcl9jb3VudBgCIAMoBRIYChBpc19kb3VibGVfcG9pbnRzGAMgASgIEi8KE2F2YWlsYWJsZV9saWZlbGluZXMYBCADKA4yEi5xdWVzdC52MS5MaWZlbGluZSLAAQoRR2V0UmVzdWx0UmVzcG9uc2USIQoGcmVzdWx0GAEgASgOMhEuY29tbW9uLnYxLlJlc3VsdBISCgp0ZWFtX29yZGVyGAIgASgNEhYKDnBlcnNvbmFsX29yZGVyGAMgASgNEhUKDXBlcnNvbmFsX3JhdGUYBCABKAISIAoGYXdhcmRzGAUgAygLMhAuY29tbW9uLnYxLkF3YXJkEiMKCW15X2F3YXJkcxgGIAMoCzIQLmNvbW1vbi52MS5Bd2FyZCJ9CgxPdGhlcnNBbnN3ZXISEQoJdXNlcl9uYW1lGAEgASgJEg8KB3RlYW1faWQYAiABKA0SEgoKdGVhbV9jb2xvchgDIAEoCRIhCgZhbnN3ZXIYBCABKAsyES5jb21tb24udjEuQ2hvaWNlEhIKCmlzX2NvcnJlY3QYBSABKAgiwAEKC1F1aXpBYm91dE1lEg4KBm51bWJlchgBIAEoDRIQCghxdWVzdGlvbhgCIAEoCRIpCg5jb3JyZWN0X2Nob2ljZRgDIAEoCzIRLmNvbW1vbi52MS5DaG9pY2USDAoEaGludBgEIAEoCRIWCg5hbnN3ZXJlZF9jb3VudBgFIAEoDRIVCg1jb3JyZWN0X2NvdW50GAYgASgNEicKB2Fuc3dlcnMYByADKAsyFi5xdWVzdC52MS5PdGhlcnNBbnN3ZXIiwgEKCE15QW5zd2VyEg4KBm51bWJlchgBIAEoDRIYChB0YXJnZXRfdXNlcl9uYW1lGAIgASgJEhAKCHF1ZXN0aW9uGAMgASgJEiEKBmFuc3dlchgEIAEoCzIRLmNvbW1vbi52MS5DaG9pY2USKQoOY29ycmVjdF9jaG9pY2UYBSABKAsyES5jb21tb24udjEuQ2hvaWNlEhIKCmlzX2NvcnJlY3QYBiABKAgSGAoQcmVzcG9uc2VfdGltZV9tcxgHIAEoAyKKAQoLS25vd25QZXJzb24SEQoJdXNlcl9uYW1lGAEgASgJEg8KB3RlYW1faWQYAiABKA0SEgoKdGVhbV9jb2xvchgDIAEoCRIWCg5hbnN3ZXJlZF9jb3VudBgEIAEoDRIVCg1jb3JyZWN0X2NvdW50GAUgASgNEhQKDGNvcnJlY3RfcmF0ZRgGIAEoAiLyAQoZR2V0UGVyc29uYWxSZXBvcnRSZXNwb25zZRIvChBxdWl6emVzX2Fib3V0X21lGAEgAygLMhUucXVlc3QudjEuUXVpekFib3V0TWUSJgoKbXlfYW5zd2VycxgCIAMoCzISLnF1ZXN0LnYxLk15QW5zd2VyEisKDGtub3duX3Blb3BsZRgDIAMoCzIVLnF1ZXN0LnYxLktub3duUGVyc29uEhYKDmFuc3dlcmVkX2NvdW50GAQgASgNEhUKDWNvcnJlY3RfY291bnQYBSABKA0SIAoYYXZlcmFnZV9yZXNwb25zZV90aW1lX21zGAYgASgDKlUKCExpZmVsaW5lEg8KC1VOU1BFQ0lGSUVEEAASDwoLRklGVFlfRklGVFkQARIUChBBU0tfVEhFX0FVRElFTkNFEAISEQoNRE9VQkxFX1BPSU5UUxADMrADCgxRdWVzdFNlcnZpY2USRAoKU3RhcnRRdWVzdBIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRocLnF1ZXN0LnYxLlN0YXJ0UXVlc3RSZXNwb25zZTABEjsKBkFuc3dlchIXLnF1ZXN0LnYxLkFuc3dlclJlcXVlc3QaGC5xdWVzdC52MS5BbnN3ZXJSZXNwb25zZRI9CghUYWtlSGludBIZLnF1ZXN0LnYxLlRha2VIaW50UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJACglHZXRSZXN1bHQSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGy5xdWVzdC52MS5HZXRSZXN1bHRSZXNwb25zZRJKCgtVc2VMaWZlbGluZRIcLnF1ZXN0LnYxLlVzZUxpZmVsaW5lUmVxdWVzdBodLnF1ZXN0LnYxLlVzZUxpZmVsaW5lUmVzcG9uc2USUAoRR2V0UGVyc29uYWxSZXBvcnQSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIy5xdWVzdC52MS5HZXRQZXJzb25hbFJlcG9ydFJlc3BvbnNlQlRaUmdpdGh1Yi5jb20vaXRzdWFidXNoMTAwMy9jdXJzZWQtZnJhbWUvYmFja2VuZC9nb2xhbmcvaW50ZXJuYWwvZ2VuL3F1ZXN0L3YxO3F1ZXN0djFiBnByb3RvMw", [file_buf_validate_validate, file_common_v1_common, file_google_protobuf_empty]);
 
 /**
  * @generated from message quest.v1.StartQuestResponse
@@ -60,6 +61,18 @@ export type StartQuestResponse = Message<"quest.v1.StartQuestResponse"> & {
    * @generated from field: int32 last_time = 8;
    */
   lastTime: number;
+
+  /**
+   * @generated from field: repeated quest.v1.Lifeline available_lifelines = 9;
+   */
+  availableLifelines: Lifeline[];
+
+  /**
+   * 認証ヘッダ無しで画像を取得できる、期限付きの署名付きURL
+   *
+   * @generated from field: string target_user_image_url = 10;
+   */
+  targetUserImageUrl: string;
 };
 
 /**
@@ -135,6 +148,60 @@ export type TakeHintRequest = Message<"quest.v1.TakeHintRequest"> & {
 export const TakeHintRequestSchema: GenMessage<TakeHintRequest> = /*@__PURE__*/
   messageDesc(file_quest_v1_quest, 3);
 
+/**
+ * @generated from message quest.v1.UseLifelineRequest
+ */
+export type UseLifelineRequest = Message<"quest.v1.UseLifelineRequest"> & {
+  /**
+   * @generated from field: uint32 question_id = 1;
+   */
+  questionId: number;
+
+  /**
+   * @generated from field: quest.v1.Lifeline lifeline = 2;
+   */
+  lifeline: Lifeline;
+};
+
+/**
+ * Describes the message quest.v1.UseLifelineRequest.
+ * Use `create(UseLifelineRequestSchema)` to create a new message.
+ */
+export const UseLifelineRequestSchema: GenMessage<UseLifelineRequest> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 4);
+
+/**
+ * @generated from message quest.v1.UseLifelineResponse
+ */
+export type UseLifelineResponse = Message<"quest.v1.UseLifelineResponse"> & {
+  /**
+   * @generated from field: repeated common.v1.Choice choices = 1;
+   */
+  choices: Choice[];
+
+  /**
+   * @generated from field: repeated int32 answer_count = 2;
+   */
+  answerCount: number[];
+
+  /**
+   * @generated from field: bool is_double_points = 3;
+   */
+  isDoublePoints: boolean;
+
+  /**
+   * @generated from field: repeated quest.v1.Lifeline available_lifelines = 4;
+   */
+  availableLifelines: Lifeline[];
+};
+
+/**
+ * Describes the message quest.v1.UseLifelineResponse.
+ * Use `create(UseLifelineResponseSchema)` to create a new message.
+ */
+export const UseLifelineResponseSchema: GenMessage<UseLifelineResponse> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 5);
+
 /**
  * @generated from message quest.v1.GetResultResponse
  */
@@ -158,6 +225,18 @@ export type GetResultResponse = Message<"quest.v1.GetResultResponse"> & {
    * @generated from field: float personal_rate = 4;
    */
   personalRate: number;
+
+  /**
+   * @generated from field: repeated common.v1.Award awards = 5;
+   */
+  awards: Award[];
+
+  /**
+   * awardsのうち、自分か自分のチームが受賞したもの
+   *
+   * @generated from field: repeated common.v1.Award my_awards = 6;
+   */
+  myAwards: Award[];
 };
 
 /**
@@ -165,7 +244,260 @@ export type GetResultResponse = Message<"quest.v1.GetResultResponse"> & {
  * Use `create(GetResultResponseSchema)` to create a new message.
  */
 export const GetResultResponseSchema: GenMessage<GetResultResponse> = /*@__PURE__*/
-  messageDesc(file_quest_v1_quest, 4);
+  messageDesc(file_quest_v1_quest, 6);
+
+/**
+ * 自分についてのクイズに他の参加者が出した回答
+ *
+ * @generated from message quest.v1.OthersAnswer
+ */
+export type OthersAnswer = Message<"quest.v1.OthersAnswer"> & {
+  /**
+   * @generated from field: string user_name = 1;
+   */
+  userName: string;
+
+  /**
+   * @generated from field: uint32 team_id = 2;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: string team_color = 3;
+   */
+  teamColor: string;
+
+  /**
+   * @generated from field: common.v1.Choice answer = 4;
+   */
+  answer?: Choice;
+
+  /**
+   * @generated from field: bool is_correct = 5;
+   */
+  isCorrect: boolean;
+};
+
+/**
+ * Describes the message quest.v1.OthersAnswer.
+ * Use `create(OthersAnswerSchema)` to create a new message.
+ */
+export const OthersAnswerSchema: GenMessage<OthersAnswer> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 7);
+
+/**
+ * @generated from message quest.v1.QuizAboutMe
+ */
+export type QuizAboutMe = Message<"quest.v1.QuizAboutMe"> & {
+  /**
+   * @generated from field: uint32 number = 1;
+   */
+  number: number;
+
+  /**
+   * @generated from field: string question = 2;
+   */
+  question: string;
+
+  /**
+   * @generated from field: common.v1.Choice correct_choice = 3;
+   */
+  correctChoice?: Choice;
+
+  /**
+   * @generated from field: string hint = 4;
+   */
+  hint: string;
+
+  /**
+   * @generated from field: uint32 answered_count = 5;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 6;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: repeated quest.v1.OthersAnswer answers = 7;
+   */
+  answers: OthersAnswer[];
+};
+
+/**
+ * Describes the message quest.v1.QuizAboutMe.
+ * Use `create(QuizAboutMeSchema)` to create a new message.
+ */
+export const QuizAboutMeSchema: GenMessage<QuizAboutMe> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 8);
+
+/**
+ * @generated from message quest.v1.MyAnswer
+ */
+export type MyAnswer = Message<"quest.v1.MyAnswer"> & {
+  /**
+   * @generated from field: uint32 number = 1;
+   */
+  number: number;
+
+  /**
+   * @generated from field: string target_user_name = 2;
+   */
+  targetUserName: string;
+
+  /**
+   * @generated from field: string question = 3;
+   */
+  question: string;
+
+  /**
+   * @generated from field: common.v1.Choice answer = 4;
+   */
+  answer?: Choice;
+
+  /**
+   * @generated from field: common.v1.Choice correct_choice = 5;
+   */
+  correctChoice?: Choice;
+
+  /**
+   * @generated from field: bool is_correct = 6;
+   */
+  isCorrect: boolean;
+
+  /**
+   * カウントダウンの開始から回答までの時間
+   *
+   * @generated from field: int64 response_time_ms = 7;
+   */
+  responseTimeMs: bigint;
+};
+
+/**
+ * Describes the message quest.v1.MyAnswer.
+ * Use `create(MyAnswerSchema)` to create a new message.
+ */
+export const MyAnswerSchema: GenMessage<MyAnswer> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 9);
+
+/**
+ * @generated from message quest.v1.KnownPerson
+ */
+export type KnownPerson = Message<"quest.v1.KnownPerson"> & {
+  /**
+   * @generated from field: string user_name = 1;
+   */
+  userName: string;
+
+  /**
+   * @generated from field: uint32 team_id = 2;
+   */
+  teamId: number;
+
+  /**
+   * @generated from field: string team_color = 3;
+   */
+  teamColor: string;
+
+  /**
+   * @generated from field: uint32 answered_count = 4;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 5;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: float correct_rate = 6;
+   */
+  correctRate: number;
+};
+
+/**
+ * Describes the message quest.v1.KnownPerson.
+ * Use `create(KnownPersonSchema)` to create a new message.
+ */
+export const KnownPersonSchema: GenMessage<KnownPerson> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 10);
+
+/**
+ * @generated from message quest.v1.GetPersonalReportResponse
+ */
+export type GetPersonalReportResponse = Message<"quest.v1.GetPersonalReportResponse"> & {
+  /**
+   * @generated from field: repeated quest.v1.QuizAboutMe quizzes_about_me = 1;
+   */
+  quizzesAboutMe: QuizAboutMe[];
+
+  /**
+   * @generated from field: repeated quest.v1.MyAnswer my_answers = 2;
+   */
+  myAnswers: MyAnswer[];
+
+  /**
+   * 今のチームのメンバーが、これまでのゲームでよく知っていた人から順に並ぶ
+   * このゲームでは自分のチームのメンバーについてのクイズには答えないので、以前別のチームだった時の回答から数え、記録が無ければ空になる
+   *
+   * @generated from field: repeated quest.v1.KnownPerson known_people = 3;
+   */
+  knownPeople: KnownPerson[];
+
+  /**
+   * @generated from field: uint32 answered_count = 4;
+   */
+  answeredCount: number;
+
+  /**
+   * @generated from field: uint32 correct_count = 5;
+   */
+  correctCount: number;
+
+  /**
+   * @generated from field: int64 average_response_time_ms = 6;
+   */
+  averageResponseTimeMs: bigint;
+};
+
+/**
+ * Describes the message quest.v1.GetPersonalReportResponse.
+ * Use `create(GetPersonalReportResponseSchema)` to create a new message.
+ */
+export const GetPersonalReportResponseSchema: GenMessage<GetPersonalReportResponse> = /*@__PURE__*/
+  messageDesc(file_quest_v1_quest, 11);
+
+/**
+ * @generated from enum quest.v1.Lifeline
+ */
+export enum Lifeline {
+  /**
+   * @generated from enum value: UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FIFTY_FIFTY = 1;
+   */
+  FIFTY_FIFTY = 1,
+
+  /**
+   * @generated from enum value: ASK_THE_AUDIENCE = 2;
+   */
+  ASK_THE_AUDIENCE = 2,
+
+  /**
+   * @generated from enum value: DOUBLE_POINTS = 3;
+   */
+  DOUBLE_POINTS = 3,
+}
+
+/**
+ * Describes the enum quest.v1.Lifeline.
+ */
+export const LifelineSchema: GenEnum<Lifeline> = /*@__PURE__*/
+  enumDesc(file_quest_v1_quest, 0);
 
 /**
  * @generated from service quest.v1.QuestService
@@ -203,6 +535,22 @@ export const QuestService: GenService<{
     input: typeof EmptySchema;
     output: typeof GetResultResponseSchema;
   },
+  /**
+   * @generated from rpc quest.v1.QuestService.UseLifeline
+   */
+  useLifeline: {
+    methodKind: "unary";
+    input: typeof UseLifelineRequestSchema;
+    output: typeof UseLifelineResponseSchema;
+  },
+  /**
+   * @generated from rpc quest.v1.QuestService.GetPersonalReport
+   */
+  getPersonalReport: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetPersonalReportResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_quest_v1_quest, 0);
 